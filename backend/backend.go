@@ -0,0 +1,382 @@
+package backend
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"scow-slurm-adapter/utils"
+)
+
+// 后端类型
+const (
+	TypeCli  = "cli"  // 本地slurm命令行加slurm_acct_db数据库
+	TypeRest = "rest" // slurmrestd的REST接口
+)
+
+// scontrol对作业的控制操作
+type JobAction string
+
+const (
+	JobActionHold    JobAction = "hold"
+	JobActionRelease JobAction = "release"
+	JobActionSuspend JobAction = "suspend"
+	JobActionResume  JobAction = "resume"
+	JobActionRequeue JobAction = "requeue"
+)
+
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrNotSupported  = errors.New("not supported by current backend")
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// 计算分区信息
+type Partition struct {
+	Name          string
+	State         string // UP、DOWN等
+	TotalCpus     int
+	TotalNodes    int
+	Nodes         string // 节点列表, 如cn[01-04]
+	MemMb         int    // 分区TRES中的内存, 为0表示分区没有配置
+	AllowAccounts string // ALL或者逗号分隔的账户列表
+	AllowQos      string // ALL或者逗号分隔的qos列表
+}
+
+// 计算节点信息
+type Node struct {
+	Name         string
+	Partitions   []string
+	State        string // 如IDLE、MIXED、IDLE+DRAIN
+	Cpus         int
+	AllocCpus    int
+	RealMemoryMb int
+	AllocMemMb   int
+	Gpus         int
+	AllocGpus    int
+}
+
+// 计算分区的资源使用状态
+type PartitionStatus struct {
+	Name             string
+	Available        bool
+	NodesAlloc       int
+	NodesIdle        int
+	NodesOther       int
+	NodesTotal       int
+	CpusAlloc        int
+	CpusIdle         int
+	CpusOther        int
+	CpusTotal        int
+	GpusTotal        int
+	GpusNotAvailable int
+}
+
+// 运行时作业的过滤条件, 为空的字段不参与过滤
+type QueueFilter struct {
+	Users      []string
+	Accounts   []string
+	States     []string // PENDING、RUNNING、SUSPENDED等
+	Partitions []string
+	JobIds     []uint32
+	JobName    *string
+	ArrayJobId *uint32 // 作业数组的全部任务
+}
+
+// slurmctld中的作业信息
+type QueueJob struct {
+	JobId              uint32
+	Name               string
+	Account            string
+	User               string
+	Partition          string
+	Qos                string
+	State              string
+	Reason             string // 排队或挂起原因, 去掉了括号
+	Cpus               int32
+	Nodes              int32
+	TimeLimitMinutes   int64 // 0表示不限时, -1表示slurm返回了INVALID
+	ElapsedSeconds     int64
+	SubmitTime         int64
+	WorkingDirectory   string
+	NodeList           string
+	GpusPerNode        int32
+	ScheduledStartTime int64  // 排队作业预计开始的时间, 调度器还没有估计时为0
+	ArrayJobId         uint32 // 不是作业数组时为0
+	ArrayTaskId        string // 还未拆分的排队任务为1-10这样的下标
+	Dependency         string // 如afterok:12(unfulfilled), 没有依赖时为空
+	HetJobId           uint32 // 不是异构作业时为0
+	HetJobOffset       uint32
+	HetComponents      []*QueueJob // 合并后异构作业的全部组件, 只有组件0有
+}
+
+// 作业在scancel等命令中的标识, 作业数组的任务为20_3, 还未拆分的排队任务为20_[2-10]
+func (j *QueueJob) JobSpec() string {
+	if j.ArrayJobId == 0 {
+		return strconv.Itoa(int(j.JobId))
+	}
+	// 去掉同时运行任务数的限制
+	taskId, _, _ := strings.Cut(j.ArrayTaskId, "%")
+	if strings.ContainsAny(taskId, ",-") {
+		return fmt.Sprintf("%d_[%s]", j.ArrayJobId, taskId)
+	}
+	return fmt.Sprintf("%d_%s", j.ArrayJobId, taskId)
+}
+
+// sbatch --test-only的结果
+type SubmitEstimate struct {
+	RejectReason string // 不为空时表示slurm拒绝了作业, 其余字段为空
+	StartTime    int64
+	Cpus         int32
+	NodeList     string
+	Partition    string
+}
+
+// 记账数据库中的作业信息
+type Job struct {
+	JobId             uint32
+	Name              string
+	Account           string
+	User              string
+	Partition         string
+	Qos               string
+	State             string
+	CpusReq           int32
+	MemReqMb          int64
+	NodesReq          int32
+	TimeLimitMinutes  int64
+	SubmitTime        int64
+	StartTime         int64
+	EndTime           int64
+	WorkingDirectory  string
+	NodeList          string
+	NodesAlloc        int32
+	CpusAlloc         int32
+	MemAllocMb        int64
+	GpusAlloc         int32
+	ArrayJobId        uint32 // 不是作业数组时为0
+	ArrayTaskId       string // 还未拆分的排队任务为1-10这样的下标
+	StdoutPath        string // 展开后的绝对路径, slurm版本不支持时为空
+	StderrPath        string
+	ExitCode          int32
+	ExitSignal        int32
+	DerivedExitCode   int32 // 所有作业步中最大的退出码
+	DerivedExitSignal int32
+	HetJobId          uint32 // 不是异构作业时为0
+	HetJobOffset      uint32
+	HetComponents     []*Job // 合并后异构作业的全部组件, 只有组件0有
+	Cursor            string // 作业在查询结果中的位置, 由后端生成, 用于JobQuery.After
+}
+
+// 作业步信息
+type JobStep struct {
+	StepId         string // 0、1、batch、extern等, 不带作业id
+	Name           string
+	State          string
+	NodeList       string
+	Nodes          int32
+	Tasks          int32
+	StartTime      int64
+	EndTime        int64
+	ElapsedSeconds int64 // 不包括挂起的时间
+	ExitCode       int32
+	ExitSignal     int32
+	TresAlloc      map[string]uint64 // 内存单位为MB
+	TresUsageInMax map[string]uint64 // cpu时间单位为毫秒, 内存单位为字节
+	TresUsageInAve map[string]uint64
+	TresUsageInTot map[string]uint64
+	CpuTimeSeconds int64 // 用户态加内核态的cpu时间, 作业步结束后才有
+}
+
+// 作业的输出文件, 都是绝对路径
+type JobOutput struct {
+	User       string // 作业所属用户, 需要以该用户的身份读取文件
+	StdoutPath string
+	StderrPath string
+}
+
+// 记账数据库作业的查询条件
+type JobQuery struct {
+	Users           []string
+	Accounts        []string
+	States          []string
+	SubmitTimeStart int64
+	SubmitTimeEnd   int64
+	EndTimeStart    int64
+	EndTimeEnd      int64
+	RunTimeStart    int64 // 在这个时间范围内运行过的作业, 包括还在运行的作业
+	RunTimeEnd      int64
+	JobId           *uint32
+	JobIds          []uint32 // 按作业id批量查询, 和JobId一样不合并异构作业的其他组件
+	ExcludeJobIds   []uint32 // 排除这些作业
+	JobName         *string
+	ArrayJobId      *uint32
+	Offset          uint64
+	Limit           uint64 // 为0时不分页
+	Order           string // ASC或DESC
+	SortField       string // JobInfo中的字段名, 为空时按作业id排序
+	After           string // 键集分页, 只返回排在这个位置之后的作业, 设置时忽略Offset
+	SkipCount       bool   // 不统计作业总数, 总数返回0
+	Now             int64  // 按elapsed_seconds排序时运行中的作业算到这个时间, 为0时使用当前时间
+}
+
+// 计算运行中作业的elapsed_seconds使用的时间.
+// 翻页时要使用同一个时间, 否则运行中的作业的排序值在两次查询之间会变化, 导致重复或者遗漏
+func (q *JobQuery) ElapsedNow() int64 {
+	if q.Now != 0 {
+		return q.Now
+	}
+	return time.Now().Unix()
+}
+
+// 作业是否符合查询条件
+func (q *JobQuery) Match(job *Job) bool {
+	if len(q.Users) != 0 && !containsString(q.Users, job.User) {
+		return false
+	}
+	if len(q.Accounts) != 0 && !containsString(q.Accounts, job.Account) {
+		return false
+	}
+	if len(q.States) != 0 && !containsString(q.States, job.State) {
+		return false
+	}
+	if q.SubmitTimeStart != 0 && job.SubmitTime < q.SubmitTimeStart {
+		return false
+	}
+	if q.SubmitTimeEnd != 0 && job.SubmitTime > q.SubmitTimeEnd {
+		return false
+	}
+	if q.EndTimeStart != 0 && job.EndTime < q.EndTimeStart {
+		return false
+	}
+	if q.EndTimeEnd != 0 && job.EndTime > q.EndTimeEnd {
+		return false
+	}
+	if q.RunTimeStart != 0 && (job.StartTime == 0 || (job.EndTime != 0 && job.EndTime < q.RunTimeStart)) {
+		return false
+	}
+	if q.RunTimeEnd != 0 && (job.StartTime == 0 || job.StartTime > q.RunTimeEnd) {
+		return false
+	}
+	if q.JobId != nil && *q.JobId != job.JobId {
+		return false
+	}
+	if len(q.JobIds) != 0 && !slices.Contains(q.JobIds, job.JobId) {
+		return false
+	}
+	if slices.Contains(q.ExcludeJobIds, job.JobId) {
+		return false
+	}
+	if q.JobName != nil && *q.JobName != job.Name {
+		return false
+	}
+	if q.ArrayJobId != nil && *q.ArrayJobId != job.ArrayJobId {
+		return false
+	}
+	return true
+}
+
+// 关联关系的过滤条件
+type AssociationFilter struct {
+	User    string
+	Account string
+}
+
+// 用户与账户的关联关系
+type Association struct {
+	User          string // 账户本身的关联关系中为空
+	Account       string
+	Partition     string
+	MaxSubmitJobs *int // 未设置时为nil
+}
+
+// slurm后端, 服务层通过它访问slurm, 不直接执行命令或查询数据库
+type Backend interface {
+	// 计算分区和节点
+	ListPartitions() ([]*Partition, error)
+	GetPartition(name string) (*Partition, error)
+	UpdatePartitionAllowAccounts(partition string, accounts string) error
+	GetPartitionStatus(partition string) (*PartitionStatus, error)
+	ListNodes() ([]*Node, error)
+	GetNode(name string) (*Node, error)
+
+	// slurmctld中的作业
+	ListQueueJobs(filter *QueueFilter) ([]*QueueJob, error)
+	SubmitJob(user string, script string) (uint32, error)
+	TestSubmitJob(user string, script string) (*SubmitEstimate, error)   // 只检查不排队
+	CancelJob(user string, jobId uint32, arrayTaskId *uint32) error      // arrayTaskId不为nil时只取消作业数组中的一个任务
+	CancelJobs(user string, jobSpecs []string) (map[string]error, error) // 按作业取消的结果, 没有出错的作业不在结果中
+	ChangeJobTimeLimit(jobId uint32, deltaMinutes int64) error
+	UpdateJob(jobId uint32, update *JobUpdate) (map[string]error, error)               // 按字段修改的结果, 没有出错的字段不在结果中
+	ControlJob(user string, jobId uint32, arrayTaskId *uint32, action JobAction) error // user为空时以管理员身份操作
+	SetJobAdminComment(jobId uint32, arrayTaskId *uint32, comment string) error
+	GetJobOutput(jobId uint32) (*JobOutput, error)                 // 作业不在slurmctld中时从记账数据库获取或按默认文件名推断
+	ListJobOutputs() (map[uint32]*JobOutput, error)                // slurmctld中全部作业的输出文件
+	ListJobPriorities(partitions []string) ([]*JobPriority, error) // 排队作业在各分区中的优先级, partitions为空时返回全部分区
+
+	// 记账数据库中的作业
+	GetJob(jobId uint32) (*Job, error)
+	QueryJobs(query *JobQuery) ([]*Job, uint32, error)
+	ListJobSteps(jobId uint32) ([]*JobStep, error)                                      // 运行中的作业步合并实时的资源使用量
+	QueryJobSteps(query *JobQuery) (map[uint32][]*JobStep, error)                       // 符合条件的全部作业的作业步, 忽略分页
+	QueryUsage(query *JobQuery, opts *UsageOptions, now int64) ([]*UsageSummary, error) // 符合条件的作业在统计范围内的资源使用量, 未结束的作业运行到now
+
+	// 账户、用户和关联关系
+	UserExists(user string) (bool, error)
+	AccountExists(account string) (bool, error)
+	ListAccounts() ([]string, error)
+	ListAssociations(filter *AssociationFilter) ([]*Association, error)
+	ListQos() ([]string, error)
+	CreateAccount(account string) error
+	DeleteAccount(account string) error
+	AddUserToAccount(user string, account string, partition string) error
+	SetUserQos(user string, qos []string, defaultQos string) error
+	SetUserDefaultAccount(user string, account string) error
+	RemoveUserFromAccount(user string, account string) error
+	DeleteUser(user string) error
+	BlockUserInAccount(user string, account string) error
+	UnblockUserInAccount(user string, account string) error
+}
+
+// 根据配置文件创建后端, cli后端需要传入slurm_acct_db的数据库连接
+func New(config *utils.Config, db *sql.DB) (Backend, error) {
+	switch config.Slurm.Backend {
+	case "", TypeCli:
+		return NewCliBackend(config, db, ""), nil
+	case TypeRest:
+		return NewRestBackend(config)
+	default:
+		return nil, fmt.Errorf("unknown slurm backend: %s", config.Slurm.Backend)
+	}
+}
+
+// 创建同一个slurmdbd下其他集群的后端, rest后端使用该集群自己的slurmrestd
+func NewClusterBackend(config *utils.Config, db *sql.DB, cluster *utils.ClusterConfig) (Backend, error) {
+	switch config.Slurm.Backend {
+	case "", TypeCli:
+		return NewCliBackend(config, db, cluster.Name), nil
+	case TypeRest:
+		if cluster.SlurmRestd == nil {
+			return nil, fmt.Errorf("slurmrestd of cluster %s is not set", cluster.Name)
+		}
+		clusterConfig := *config
+		clusterConfig.SlurmRestd = *cluster.SlurmRestd
+		clusterConfig.MySQLConfig.ClusterName = cluster.Name
+		return NewRestBackend(&clusterConfig)
+	default:
+		return nil, fmt.Errorf("unknown slurm backend: %s", config.Slurm.Backend)
+	}
+}
+
+// 账户与用户的关联关系是否存在
+func AssociationExists(b Backend, user string, account string) (bool, error) {
+	assocs, err := b.ListAssociations(&AssociationFilter{User: user, Account: account})
+	if err != nil {
+		return false, err
+	}
+	return len(assocs) != 0, nil
+}
@@ -0,0 +1,1522 @@
+package backend
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"scow-slurm-adapter/utils"
+)
+
+// squeue输出字段, 作业名放在最后, 避免作业名中的分隔符影响解析
+const (
+	queueFormat    = "--format=%A|%a|%u|%P|%q|%T|%r|%C|%D|%l|%M|%V|%Z|%N|%b|%F|%K|%E|%i|%S|%j"
+	queueFieldsNum = 21
+	// sprio输出字段, tres因子为cpu=10,gres/gpu=5这样的列表
+	sprioFormat    = "--format=%i|%r|%u|%Y|%A|%F|%J|%P|%Q|%N|%T"
+	sprioFieldsNum = 11
+)
+
+// 作业表中的NO_VAL, id_array_task、het_job_offset为该值时表示不是作业数组的任务或异构作业的组件
+const noValue = 0xfffffffe
+
+// sbatch的输出, 多集群时后面还会带上集群名
+var sbatchOutputRegexp = regexp.MustCompile(`Submitted batch job (\d+)`)
+
+// sbatch --test-only的输出, 如sbatch: Job 12 to start at 2024-01-02T03:04:05 using 4 processors on nodes cn01 in partition compute
+var sbatchTestOnlyRegexp = regexp.MustCompile(`Job \d+ to start at (\S+) using (\d+) processors on nodes (\S+) in partition (\S+)`)
+
+// sbatch拒绝作业时的原因
+var sbatchRejectRegexp = regexp.MustCompile(`Batch job submission failed: (.+)`)
+
+// 本地slurm命令行加slurm_acct_db数据库的后端
+type CliBackend struct {
+	db             *sql.DB
+	clusterName    string
+	slurmCluster   string // 不为空时slurm命令通过-M指定集群, 本机所在的默认集群为空
+	databaseEncode string
+	stdioOnce      sync.Once
+	hasStdio       bool // 作业表是否有std_out、std_err字段, slurm 23.02开始才有
+}
+
+// cluster为空时使用配置文件中的默认集群, 否则为同一个slurmdbd下的其他集群
+func NewCliBackend(config *utils.Config, db *sql.DB, cluster string) *CliBackend {
+	c := &CliBackend{
+		db:             db,
+		clusterName:    config.MySQLConfig.ClusterName,
+		databaseEncode: config.MySQLConfig.DatabaseEncode,
+	}
+	if cluster != "" {
+		c.clusterName = cluster
+		c.slurmCluster = cluster
+	}
+	return c
+}
+
+// 执行slurm命令, 多集群时加上-M参数并去掉输出中的CLUSTER: name行
+func (c *CliBackend) runSlurmCommand(name string, args ...string) (string, error) {
+	if c.slurmCluster == "" {
+		return utils.RunSlurmCommand(name, args...)
+	}
+	output, err := utils.RunSlurmCommand(name, append([]string{"-M", c.slurmCluster}, args...)...)
+	var lines []string
+	for _, line := range strings.Split(output, "\n") {
+		if !strings.HasPrefix(line, "CLUSTER: ") {
+			lines = append(lines, line)
+		}
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n")), err
+}
+
+// sacctmgr没有-M参数, 通过cluster=限定只修改该集群的关联关系
+// 默认集群也要指定, 否则会修改同一个slurmdbd下所有集群的关联关系
+func (c *CliBackend) sacctmgrCluster() []string {
+	return []string{"cluster=" + c.clusterName}
+}
+
+func (c *CliBackend) ListPartitions() ([]*Partition, error) {
+	output, err := c.runSlurmCommand("scontrol", "show", "partition", "--oneliner")
+	if err != nil {
+		return nil, err
+	}
+	var partitions []*Partition
+	for _, line := range utils.SplitLines(output) {
+		partition, err := partitionFromConfig(utils.ParseKeyValues(line))
+		if err != nil {
+			return nil, err
+		}
+		partitions = append(partitions, partition)
+	}
+	return partitions, nil
+}
+
+func (c *CliBackend) GetPartition(name string) (*Partition, error) {
+	output, err := c.runSlurmCommand("scontrol", "show", "partition", name, "--oneliner")
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return partitionFromConfig(utils.ParseKeyValues(output))
+}
+
+func partitionFromConfig(partitionConfig map[string]string) (*Partition, error) {
+	partition := &Partition{
+		Name:          partitionConfig["PartitionName"],
+		State:         partitionConfig["State"],
+		Nodes:         partitionConfig["Nodes"],
+		AllowAccounts: partitionConfig["AllowAccounts"],
+		AllowQos:      partitionConfig["AllowQos"],
+	}
+	partition.TotalCpus, _ = strconv.Atoi(partitionConfig["TotalCPUs"])
+	partition.TotalNodes, _ = strconv.Atoi(partitionConfig["TotalNodes"])
+	// 不同slurm版本的问题, 分区的TRES中不一定有内存信息
+	if mem, ok := utils.ParseTres(partitionConfig["TRES"])["mem"]; ok {
+		memMb, err := utils.ConvertMemory(mem)
+		if err != nil {
+			return nil, err
+		}
+		partition.MemMb = memMb
+	}
+	return partition, nil
+}
+
+func (c *CliBackend) UpdatePartitionAllowAccounts(partition string, accounts string) error {
+	_, err := c.runSlurmCommand("scontrol", "update", "partition="+partition, "AllowAccounts="+accounts)
+	return err
+}
+
+func (c *CliBackend) GetPartitionStatus(partition string) (*PartitionStatus, error) {
+	output, err := c.runSlurmCommand("sinfo", "-p", partition, "--noheader", "--format=%P %c %C %G %a %D %F")
+	if err != nil {
+		return nil, err
+	}
+	partitionStatus := &PartitionStatus{Name: partition}
+	// 同一个分区中不同配置的节点会分成多行输出
+	for _, line := range utils.SplitLines(output) {
+		fields := strings.Fields(line)
+		if len(fields) != 7 {
+			continue
+		}
+		nodes := strings.Split(fields[6], "/")
+		cpus := strings.Split(fields[2], "/")
+		if len(nodes) != 4 || len(cpus) != 4 {
+			continue
+		}
+		partitionStatus.Available = fields[4] == "up"
+		nodesAlloc, _ := strconv.Atoi(nodes[0])
+		nodesIdle, _ := strconv.Atoi(nodes[1])
+		nodesOther, _ := strconv.Atoi(nodes[2])
+		nodesTotal, _ := strconv.Atoi(nodes[3])
+		partitionStatus.NodesAlloc += nodesAlloc
+		partitionStatus.NodesIdle += nodesIdle
+		partitionStatus.NodesOther += nodesOther
+		partitionStatus.NodesTotal += nodesTotal
+		cpusAlloc, _ := strconv.Atoi(cpus[0])
+		cpusIdle, _ := strconv.Atoi(cpus[1])
+		cpusOther, _ := strconv.Atoi(cpus[2])
+		cpusTotal, _ := strconv.Atoi(cpus[3])
+		partitionStatus.CpusAlloc += cpusAlloc
+		partitionStatus.CpusIdle += cpusIdle
+		partitionStatus.CpusOther += cpusOther
+		partitionStatus.CpusTotal += cpusTotal
+		gpusPerNode := utils.GetGpusFromGres(fields[3])
+		partitionStatus.GpusTotal += gpusPerNode * nodesTotal
+		partitionStatus.GpusNotAvailable += gpusPerNode * nodesOther
+	}
+	return partitionStatus, nil
+}
+
+func (c *CliBackend) ListNodes() ([]*Node, error) {
+	output, err := c.runSlurmCommand("scontrol", "show", "nodes", "--oneliner")
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*Node
+	for _, line := range utils.SplitLines(output) {
+		nodes = append(nodes, nodeFromConfig(utils.ParseKeyValues(line)))
+	}
+	return nodes, nil
+}
+
+func (c *CliBackend) GetNode(name string) (*Node, error) {
+	output, err := c.runSlurmCommand("scontrol", "show", "node", name, "--oneliner")
+	if err != nil {
+		return nil, err
+	}
+	return nodeFromConfig(utils.ParseKeyValues(output)), nil
+}
+
+func nodeFromConfig(nodeConfig map[string]string) *Node {
+	node := &Node{
+		Name:  nodeConfig["NodeName"],
+		State: nodeConfig["State"],
+		Gpus:  utils.GetGpusFromGres(nodeConfig["Gres"]),
+	}
+	if partitions := nodeConfig["Partitions"]; partitions != "" {
+		node.Partitions = strings.Split(partitions, ",")
+	}
+	node.Cpus, _ = strconv.Atoi(nodeConfig["CPUTot"])
+	node.AllocCpus, _ = strconv.Atoi(nodeConfig["CPUAlloc"])
+	node.RealMemoryMb, _ = strconv.Atoi(nodeConfig["RealMemory"])
+	node.AllocMemMb, _ = strconv.Atoi(nodeConfig["AllocMem"])
+	for key, value := range utils.ParseTres(nodeConfig["AllocTRES"]) {
+		if strings.HasPrefix(key, "gres/gpu") && !strings.Contains(key, ":") {
+			node.AllocGpus, _ = strconv.Atoi(value)
+		}
+	}
+	return node
+}
+
+func (c *CliBackend) ListQueueJobs(filter *QueueFilter) ([]*QueueJob, error) {
+	args := []string{"--noheader", queueFormat}
+	if filter != nil {
+		if len(filter.Users) != 0 {
+			args = append(args, "-u", strings.Join(filter.Users, ","))
+		}
+		if len(filter.Accounts) != 0 {
+			args = append(args, "-A", strings.Join(filter.Accounts, ","))
+		}
+		if len(filter.States) != 0 {
+			args = append(args, "-t", strings.ToLower(strings.Join(filter.States, ",")))
+		}
+		if len(filter.Partitions) != 0 {
+			args = append(args, "-p", strings.Join(filter.Partitions, ","))
+		}
+		// squeue -j指定作业数组id时会返回数组的全部任务
+		if len(filter.JobIds) != 0 {
+			var jobIds []string
+			for _, jobId := range filter.JobIds {
+				jobIds = append(jobIds, strconv.Itoa(int(jobId)))
+			}
+			args = append(args, "-j", strings.Join(jobIds, ","))
+		} else if filter.ArrayJobId != nil {
+			args = append(args, "-j", strconv.Itoa(int(*filter.ArrayJobId)))
+		}
+		if filter.JobName != nil {
+			args = append(args, "-n", *filter.JobName)
+		}
+	}
+	output, err := c.runSlurmCommand("squeue", args...)
+	if err != nil {
+		// 作业已经不在slurmctld中时squeue -j会报错
+		if strings.Contains(err.Error(), "Invalid job id") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var jobs []*QueueJob
+	for _, line := range utils.SplitLines(output) {
+		// 只有作业数组的条件需要再过滤一次, 其余条件squeue已经处理
+		if job := parseQueueJob(line); job != nil && (filter == nil || filter.ArrayJobId == nil || filter.Match(job)) {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+func parseQueueJob(line string) *QueueJob {
+	fields := strings.SplitN(line, "|", queueFieldsNum)
+	if len(fields) != queueFieldsNum {
+		return nil
+	}
+	jobId, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return nil
+	}
+	job := &QueueJob{
+		JobId:            uint32(jobId),
+		Account:          fields[1],
+		User:             fields[2],
+		Partition:        fields[3],
+		Qos:              fields[4],
+		State:            fields[5],
+		Reason:           strings.Trim(fields[6], "()"),
+		WorkingDirectory: fields[12],
+		NodeList:         fields[13],
+		Name:             fields[20],
+	}
+	if job.Reason == "None" {
+		job.Reason = ""
+	}
+	// 没有依赖时%E为(null)
+	if fields[17] != "(null)" {
+		job.Dependency = fields[17]
+	}
+	// 异构作业的组件%i为123+1这样的格式, 123为组件0的作业id
+	if hetJobId, hetJobOffset, ok := strings.Cut(fields[18], "+"); ok {
+		id, _ := strconv.Atoi(hetJobId)
+		offset, _ := strconv.Atoi(hetJobOffset)
+		job.HetJobId, job.HetJobOffset = uint32(id), uint32(offset)
+	}
+	cpus, _ := strconv.Atoi(fields[7])
+	job.Cpus = int32(cpus)
+	nodes, _ := strconv.Atoi(fields[8])
+	job.Nodes = int32(nodes)
+	switch fields[9] {
+	case "UNLIMITED":
+		job.TimeLimitMinutes = 0
+	case "INVALID":
+		job.TimeLimitMinutes = -1
+	default:
+		job.TimeLimitMinutes = utils.GetTimeLimit(fields[9])
+	}
+	job.ElapsedSeconds = utils.GetRunningElapsedSeconds(fields[10])
+	if submitTime, err := time.ParseInLocation("2006-01-02T15:04:05", fields[11], time.Local); err == nil {
+		job.SubmitTime = submitTime.Unix()
+	}
+	// 排队作业的%S是调度器估计的开始时间, 还没有估计时为N/A
+	if job.State == "PENDING" {
+		if startTime, err := time.ParseInLocation("2006-01-02T15:04:05", fields[19], time.Local); err == nil {
+			job.ScheduledStartTime = startTime.Unix()
+		}
+	}
+	if fields[14] != "N/A" {
+		job.GpusPerNode = int32(utils.GetGpusFromGres(fields[14]))
+	}
+	// 不是作业数组时%K为N/A, 还未拆分的排队任务为[1-10%2]这样的下标
+	if fields[16] != "N/A" {
+		arrayJobId, _ := strconv.Atoi(fields[15])
+		job.ArrayJobId = uint32(arrayJobId)
+		job.ArrayTaskId = strings.Trim(fields[16], "[]")
+	}
+	return job
+}
+
+func (c *CliBackend) SubmitJob(user string, script string) (uint32, error) {
+	output, err := utils.LocalSubmitJob(script, user, c.slurmCluster)
+	if err != nil {
+		return 0, err
+	}
+	// 输出格式为Submitted batch job 123, 作业数组返回的是数组id
+	match := sbatchOutputRegexp.FindStringSubmatch(output)
+	if match == nil {
+		return 0, fmt.Errorf("unexpected sbatch output: %s", output)
+	}
+	jobId, err := strconv.ParseUint(match[1], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected sbatch output: %s", output)
+	}
+	return uint32(jobId), nil
+}
+
+// 作业id或者作业数组中的一个任务
+func jobSpec(jobId uint32, arrayTaskId *uint32) string {
+	if arrayTaskId != nil {
+		return fmt.Sprintf("%d_%d", jobId, *arrayTaskId)
+	}
+	return strconv.Itoa(int(jobId))
+}
+
+func (c *CliBackend) TestSubmitJob(user string, script string) (*SubmitEstimate, error) {
+	output, err := utils.LocalTestSubmitJob(script, user, c.slurmCluster)
+	if err != nil {
+		var commandErr *utils.CommandError
+		if errors.As(err, &commandErr) {
+			if match := sbatchRejectRegexp.FindStringSubmatch(commandErr.Stderr); match != nil {
+				return &SubmitEstimate{RejectReason: strings.TrimSpace(match[1])}, nil
+			}
+		}
+		return nil, err
+	}
+	match := sbatchTestOnlyRegexp.FindStringSubmatch(output)
+	if match == nil {
+		return nil, fmt.Errorf("unexpected sbatch output: %s", output)
+	}
+	estimate := &SubmitEstimate{NodeList: match[3], Partition: match[4]}
+	if startTime, err := time.ParseInLocation("2006-01-02T15:04:05", match[1], time.Local); err == nil {
+		estimate.StartTime = startTime.Unix()
+	}
+	cpus, _ := strconv.Atoi(match[2])
+	estimate.Cpus = int32(cpus)
+	return estimate, nil
+}
+
+func (c *CliBackend) CancelJob(user string, jobId uint32, arrayTaskId *uint32) error {
+	_, err := utils.LocalCancelJob(user, c.slurmCluster, jobSpec(jobId, arrayTaskId))
+	return err
+}
+
+// 每次调用scancel取消的作业数, 避免命令行过长
+const scancelBatchSize = 200
+
+// scancel取消多个作业时每个作业的错误, 如scancel: error: Kill job error on job id 123_4: Invalid job id specified
+var scancelErrorRegexp = regexp.MustCompile(`(?m)job id (\S+): (.+)$`)
+
+// user为空时以管理员身份取消
+func (c *CliBackend) CancelJobs(user string, jobSpecs []string) (map[string]error, error) {
+	results := make(map[string]error)
+	for start := 0; start < len(jobSpecs); start += scancelBatchSize {
+		batch := jobSpecs[start:min(start+scancelBatchSize, len(jobSpecs))]
+		var err error
+		if user == "" {
+			_, err = c.runSlurmCommand("scancel", batch...)
+		} else {
+			_, err = utils.LocalCancelJob(user, c.slurmCluster, batch...)
+		}
+		if err == nil {
+			continue
+		}
+		var matches [][]string
+		var commandErr *utils.CommandError
+		if errors.As(err, &commandErr) {
+			matches = scancelErrorRegexp.FindAllStringSubmatch(commandErr.Stderr, -1)
+		}
+		// 命令执行失败或者无法区分是哪个作业出错时整批都视为失败
+		if len(matches) == 0 {
+			for _, jobSpec := range batch {
+				results[jobSpec] = err
+			}
+			continue
+		}
+		for _, match := range matches {
+			results[match[1]] = errors.New(strings.TrimSpace(match[2]))
+		}
+	}
+	return results, nil
+}
+
+// 以用户身份执行时slurm按用户的权限处理, 如hold的作业只能由用户自己release
+func (c *CliBackend) ControlJob(user string, jobId uint32, arrayTaskId *uint32, action JobAction) error {
+	if user == "" {
+		_, err := c.runSlurmCommand("scontrol", string(action), jobSpec(jobId, arrayTaskId))
+		return err
+	}
+	_, err := utils.LocalControlJob(user, c.slurmCluster, string(action), jobSpec(jobId, arrayTaskId))
+	return err
+}
+
+func (c *CliBackend) SetJobAdminComment(jobId uint32, arrayTaskId *uint32, comment string) error {
+	_, err := c.runSlurmCommand("scontrol", "update", "job="+jobSpec(jobId, arrayTaskId), "AdminComment="+comment)
+	return err
+}
+
+func (c *CliBackend) ChangeJobTimeLimit(jobId uint32, deltaMinutes int64) error {
+	timeLimit := fmt.Sprintf("TimeLimit+=%d", deltaMinutes)
+	if deltaMinutes < 0 {
+		timeLimit = fmt.Sprintf("TimeLimit-=%d", -deltaMinutes)
+	}
+	_, err := c.runSlurmCommand("scontrol", "update", fmt.Sprintf("job=%d", jobId), timeLimit)
+	return err
+}
+
+// scontrol update的参数, 和JobUpdate.Fields中的字段对应
+func jobUpdateArgs(update *JobUpdate) map[string]string {
+	args := make(map[string]string)
+	if update.Partition != nil {
+		args[JobUpdatePartition] = "Partition=" + *update.Partition
+	}
+	if update.Qos != nil {
+		args[JobUpdateQos] = "QOS=" + *update.Qos
+	}
+	if update.NodeCount != nil {
+		args[JobUpdateNodeCount] = fmt.Sprintf("NumNodes=%d", *update.NodeCount)
+	}
+	if update.Cpus != nil {
+		args[JobUpdateCpus] = fmt.Sprintf("NumCPUs=%d", *update.Cpus)
+	}
+	if update.Gpus != nil {
+		args[JobUpdateGpus] = fmt.Sprintf("Gres=gpu:%d", *update.Gpus)
+	}
+	if update.MemMb != nil {
+		args[JobUpdateMemMb] = fmt.Sprintf("MinMemoryNode=%d", *update.MemMb)
+	}
+	if update.Name != nil {
+		args[JobUpdateName] = "JobName=" + *update.Name
+	}
+	if update.Comment != nil {
+		args[JobUpdateComment] = "Comment=" + *update.Comment
+	}
+	return args
+}
+
+// 每个字段单独执行scontrol update, slurm拒绝一个字段时不影响其他字段
+func (c *CliBackend) UpdateJob(jobId uint32, update *JobUpdate) (map[string]error, error) {
+	args := jobUpdateArgs(update)
+	results := make(map[string]error)
+	for _, field := range update.Fields() {
+		_, err := c.runSlurmCommand("scontrol", "update", fmt.Sprintf("job=%d", jobId), args[field])
+		if err == nil {
+			continue
+		}
+		var commandErr *utils.CommandError
+		if !errors.As(err, &commandErr) {
+			return nil, err
+		}
+		if strings.Contains(commandErr.Stderr, "Invalid job id") {
+			return nil, ErrNotFound
+		}
+		results[field] = errors.New(scontrolErrorMessage(commandErr.Stderr))
+	}
+	return results, nil
+}
+
+// 去掉scontrol错误输出中的前缀, 如slurm_update error: Invalid qos specification
+func scontrolErrorMessage(stderr string) string {
+	lines := utils.SplitLines(stderr)
+	if len(lines) == 0 {
+		return "rejected by slurm"
+	}
+	line := lines[len(lines)-1]
+	if _, message, found := strings.Cut(line, "error: "); found {
+		return strings.TrimSpace(message)
+	}
+	return line
+}
+
+func (c *CliBackend) ListJobPriorities(partitions []string) ([]*JobPriority, error) {
+	args := []string{"--noheader", sprioFormat}
+	if len(partitions) != 0 {
+		args = append(args, "-p", strings.Join(partitions, ","))
+	}
+	output, err := c.runSlurmCommand("sprio", args...)
+	if err != nil {
+		// 没有使用priority/multifactor插件时sprio不可用
+		if strings.Contains(err.Error(), "priority plugin") {
+			return nil, fmt.Errorf("%w: sprio without priority/multifactor", ErrNotSupported)
+		}
+		return nil, err
+	}
+	var priorities []*JobPriority
+	for _, line := range utils.SplitLines(output) {
+		if priority := parseJobPriority(line); priority != nil {
+			priorities = append(priorities, priority)
+		}
+	}
+	return priorities, nil
+}
+
+// sprio中的因子在配置了较小的权重时可能是小数, 统一取整
+func parsePriorityFactor(value string) int64 {
+	factor, _ := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	return int64(math.Round(factor))
+}
+
+func parseJobPriority(line string) *JobPriority {
+	fields := strings.Split(line, "|")
+	if len(fields) != sprioFieldsNum {
+		return nil
+	}
+	jobId, err := strconv.Atoi(strings.TrimSpace(fields[0]))
+	if err != nil {
+		return nil
+	}
+	priority := &JobPriority{
+		JobId:           uint32(jobId),
+		Partition:       strings.TrimSpace(fields[1]),
+		User:            strings.TrimSpace(fields[2]),
+		Priority:        parsePriorityFactor(fields[3]),
+		Age:             parsePriorityFactor(fields[4]),
+		Fairshare:       parsePriorityFactor(fields[5]),
+		JobSize:         parsePriorityFactor(fields[6]),
+		PartitionFactor: parsePriorityFactor(fields[7]),
+		Qos:             parsePriorityFactor(fields[8]),
+		Nice:            parsePriorityFactor(fields[9]),
+	}
+	for name, value := range utils.ParseTres(strings.TrimSpace(fields[10])) {
+		if priority.Tres == nil {
+			priority.Tres = make(map[string]int64)
+		}
+		priority.Tres[name] = parsePriorityFactor(value)
+	}
+	return priority
+}
+
+func (c *CliBackend) GetJobOutput(jobId uint32) (*JobOutput, error) {
+	output, err := c.runSlurmCommand("scontrol", "show", "job", "--oneliner", strconv.Itoa(int(jobId)))
+	if err != nil {
+		if !strings.Contains(err.Error(), "Invalid job id") {
+			return nil, err
+		}
+		job, err := c.GetJob(jobId)
+		if err != nil {
+			return nil, err
+		}
+		return jobOutputFromJob(job), nil
+	}
+	// 指定作业数组id时会输出数组的全部任务
+	jobOutputs := parseJobOutputs(output)
+	if jobOutput, ok := jobOutputs[jobId]; ok {
+		return jobOutput, nil
+	}
+	return nil, ErrNotFound
+}
+
+func (c *CliBackend) ListJobOutputs() (map[uint32]*JobOutput, error) {
+	output, err := c.runSlurmCommand("scontrol", "show", "job", "--oneliner")
+	if err != nil {
+		return nil, err
+	}
+	return parseJobOutputs(output), nil
+}
+
+// 解析scontrol show job的输出, 其中的StdOut、StdErr已经展开
+func parseJobOutputs(output string) map[uint32]*JobOutput {
+	jobOutputs := make(map[uint32]*JobOutput)
+	for _, line := range utils.SplitLines(output) {
+		jobConfig := utils.ParseKeyValues(line)
+		jobId, err := strconv.Atoi(jobConfig["JobId"])
+		if err != nil {
+			continue // 没有作业时输出No jobs in the system
+		}
+		// UserId的格式为alice(1000)
+		user, _, _ := strings.Cut(jobConfig["UserId"], "(")
+		jobOutputs[uint32(jobId)] = &JobOutput{User: user, StdoutPath: jobConfig["StdOut"], StderrPath: jobConfig["StdErr"]}
+	}
+	return jobOutputs
+}
+
+// 作业表中tres_alloc、tres_req使用的tres id
+type tresIds struct {
+	cpu       int
+	mem       int
+	node      int
+	gpus      []int
+	countGpus bool // SelectType为cons_tres或cons_res时才统计gpu
+}
+
+func (c *CliBackend) getTresIds() (*tresIds, error) {
+	ids := &tresIds{}
+	c.db.QueryRow("SELECT id FROM tres_table WHERE type = 'cpu'").Scan(&ids.cpu)
+	c.db.QueryRow("SELECT id FROM tres_table WHERE type = 'mem'").Scan(&ids.mem)
+	c.db.QueryRow("SELECT id FROM tres_table WHERE type = 'node'").Scan(&ids.node)
+
+	output, err := c.runSlurmCommand("scontrol", "show", "config")
+	if err != nil {
+		return nil, err
+	}
+	selectType := utils.SelectTypePluginName(utils.ParseSlurmConfigValue(output, "SelectType"))
+	ids.countGpus = selectType == "cons_tres" || selectType == "cons_res"
+
+	rows, err := c.db.Query("SELECT id FROM tres_table WHERE type = 'gres' AND deleted = 0")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var gpuId int
+		if err := rows.Scan(&gpuId); err != nil {
+			return nil, err
+		}
+		ids.gpus = append(ids.gpus, gpuId)
+	}
+	return ids, rows.Err()
+}
+
+// 检查作业表是否有std_out、std_err字段
+func (c *CliBackend) stdioColumnsExist() bool {
+	c.stdioOnce.Do(func() {
+		var count int
+		err := c.db.QueryRow("SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = ? AND column_name IN ('std_out', 'std_err')",
+			c.clusterName+"_job_table").Scan(&count)
+		c.hasStdio = err == nil && count == 2
+	})
+	return c.hasStdio
+}
+
+// 作业表的查询字段, 数据库编码为utf8时需要转换作业名和工作目录
+func (c *CliBackend) jobColumns() string {
+	jobName, workDir := "job_name", "work_dir"
+	// 正常情况下，数据库编码格式是latin1，环境部署时config.yaml中databaseEncode也会配成latin1。 但是为了防止config.yaml中databaseEncode配成utf8，查询时需要做这样的转换。
+	if strings.Contains(c.databaseEncode, "utf8") {
+		jobName = "CONVERT(CAST(job_name AS BINARY) USING utf8) AS job_name"
+		workDir = "CONVERT(CAST(work_dir AS BINARY) USING utf8) AS work_dir"
+	}
+	// 不支持的slurm版本查询NULL, 扫描时不用区分
+	stdio := "NULL, NULL"
+	if c.stdioColumnsExist() {
+		stdio = "std_out, std_err"
+	}
+	return fmt.Sprintf("account, id_user, cpus_req, %s, id_job, id_qos, mem_req, nodelist, nodes_alloc, `partition`, state, timelimit, time_submit, time_start, time_end, %s, tres_alloc, tres_req, id_array_job, id_array_task, array_task_str, exit_code, derived_ec, het_job_id, het_job_offset, %s", jobName, workDir, stdio)
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func (c *CliBackend) scanJob(row rowScanner, ids *tresIds, qosNames map[int]string) (*Job, error) {
+	var (
+		job          Job
+		idUser       int
+		idQos        int
+		state        int
+		memReq       uint64
+		tresAlloc    string
+		tresReq      string
+		idArrayJob   uint32
+		idArrayTask  uint32
+		arrayTaskStr sql.NullString
+		exitCode     int64
+		derivedEc    int64
+		hetJobId     uint32
+		hetJobOffset uint32
+		stdOut       sql.NullString
+		stdErr       sql.NullString
+	)
+	err := row.Scan(&job.Account, &idUser, &job.CpusReq, &job.Name, &job.JobId, &idQos, &memReq, &job.NodeList, &job.NodesAlloc, &job.Partition,
+		&state, &job.TimeLimitMinutes, &job.SubmitTime, &job.StartTime, &job.EndTime, &job.WorkingDirectory, &tresAlloc, &tresReq,
+		&idArrayJob, &idArrayTask, &arrayTaskStr, &exitCode, &derivedEc, &hetJobId, &hetJobOffset, &stdOut, &stdErr)
+	if err != nil {
+		return nil, err
+	}
+	job.State = utils.ChangeState(state)
+	// username 转换，需要从ldap中拿数据
+	job.User, _ = utils.GetUserNameByUid(idUser)
+	if _, ok := qosNames[idQos]; !ok {
+		var qosName string
+		c.db.QueryRow("SELECT name FROM qos_table WHERE id = ?", idQos).Scan(&qosName)
+		qosNames[idQos] = qosName
+	}
+	job.Qos = qosNames[idQos]
+	// 低版本slurm mem_req 默认值转换为0
+	if memReq <= 4000000000 {
+		job.MemReqMb = int64(memReq)
+	}
+	job.NodesReq = int32(utils.GetResInfoNumFromTresInfo(tresReq, ids.node))
+	if job.NodesReq == 0 {
+		job.NodesReq = job.NodesAlloc
+	}
+	job.CpusAlloc = int32(utils.GetResInfoNumFromTresInfo(tresAlloc, ids.cpu))
+	job.MemAllocMb = int64(utils.GetResInfoNumFromTresInfo(tresAlloc, ids.mem))
+	if ids.countGpus && len(ids.gpus) != 0 {
+		job.GpusAlloc = utils.GetGpuAllocsFromGpuIdList(tresAlloc, ids.gpus)
+	}
+	// 作业数组中还未拆分的排队任务只有一条记录, 下标保存在array_task_str中
+	if idArrayJob != 0 {
+		job.ArrayJobId = idArrayJob
+		if idArrayTask != noValue {
+			job.ArrayTaskId = strconv.FormatUint(uint64(idArrayTask), 10)
+		} else if arrayTaskStr.Valid {
+			job.ArrayTaskId = utils.ArrayTaskIdFromBitmap(arrayTaskStr.String)
+		}
+	}
+	// 不是异构作业时het_job_offset为NO_VAL
+	if hetJobId != 0 && hetJobOffset != noValue {
+		job.HetJobId, job.HetJobOffset = hetJobId, hetJobOffset
+	}
+	job.ExitCode, job.ExitSignal = utils.DecodeExitStatus(exitCode)
+	job.DerivedExitCode, job.DerivedExitSignal = utils.DecodeExitStatus(derivedEc)
+	setJobOutputPaths(&job, stdOut.String, stdErr.String)
+	return &job, nil
+}
+
+func (c *CliBackend) GetJob(jobId uint32) (*Job, error) {
+	ids, err := c.getTresIds()
+	if err != nil {
+		return nil, err
+	}
+	jobSqlConfig := fmt.Sprintf("SELECT %s FROM %s_job_table WHERE id_job = ?", c.jobColumns(), c.clusterName)
+	qosNames := make(map[int]string)
+	job, err := c.scanJob(c.db.QueryRow(jobSqlConfig, jobId), ids, qosNames)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil || job.HetJobId == 0 || job.HetJobOffset != 0 {
+		return job, err
+	}
+	components, err := c.queryHetComponents([]uint32{job.HetJobId}, ids, qosNames)
+	if err != nil {
+		return nil, err
+	}
+	return MergeHetJobs(append([]*Job{job}, components...))[0], nil
+}
+
+// 查询异构作业除组件0以外的其他组件
+func (c *CliBackend) queryHetComponents(hetJobIds []uint32, ids *tresIds, qosNames map[int]string) ([]*Job, error) {
+	var params []interface{}
+	for _, hetJobId := range hetJobIds {
+		params = append(params, hetJobId)
+	}
+	jobSqlConfig := fmt.Sprintf("SELECT %s FROM %s_job_table WHERE het_job_id IN (?%s) AND het_job_offset <> 0 AND het_job_offset <> ?",
+		c.jobColumns(), c.clusterName, strings.Repeat(", ?", len(hetJobIds)-1))
+	rows, err := c.db.Query(jobSqlConfig, append(params, noValue)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var components []*Job
+	for rows.Next() {
+		component, err := c.scanJob(rows, ids, qosNames)
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, component)
+	}
+	return components, rows.Err()
+}
+
+// 作业表的查询条件, 返回WHERE子句和参数
+func jobConditions(query *JobQuery) (string, []interface{}) {
+	var (
+		conditions []string
+		params     []interface{}
+	)
+	if len(query.Users) != 0 {
+		var uidList []string
+		for _, user := range query.Users {
+			uid, _, _ := utils.GetUserUidGid(user)
+			uidList = append(uidList, strconv.Itoa(uid))
+		}
+		conditions = append(conditions, fmt.Sprintf("id_user IN (%s)", strings.Join(uidList, ",")))
+	}
+	if len(query.States) != 0 {
+		var stateIdList []string
+		for _, state := range query.States {
+			stateIdList = append(stateIdList, strconv.Itoa(utils.GetStateId(state)))
+		}
+		conditions = append(conditions, fmt.Sprintf("state IN (%s)", strings.Join(stateIdList, ",")))
+	}
+	if len(query.Accounts) != 0 {
+		conditions = append(conditions, fmt.Sprintf("account IN (?%s)", strings.Repeat(", ?", len(query.Accounts)-1)))
+		for _, account := range query.Accounts {
+			params = append(params, account)
+		}
+	}
+	if query.EndTimeStart != 0 {
+		conditions = append(conditions, "time_end >= ?")
+		params = append(params, query.EndTimeStart)
+	}
+	if query.EndTimeEnd != 0 {
+		conditions = append(conditions, "time_end <= ?")
+		params = append(params, query.EndTimeEnd)
+	}
+	if query.RunTimeStart != 0 {
+		conditions = append(conditions, "time_start != 0 AND (time_end = 0 OR time_end >= ?)")
+		params = append(params, query.RunTimeStart)
+	}
+	if query.RunTimeEnd != 0 {
+		conditions = append(conditions, "time_start != 0 AND time_start <= ?")
+		params = append(params, query.RunTimeEnd)
+	}
+	if query.SubmitTimeStart != 0 {
+		conditions = append(conditions, "time_submit >= ?")
+		params = append(params, query.SubmitTimeStart)
+	}
+	if query.SubmitTimeEnd != 0 {
+		conditions = append(conditions, "time_submit <= ?")
+		params = append(params, query.SubmitTimeEnd)
+	}
+	// 按作业名和作业id来搜索作业
+	if query.JobId != nil {
+		conditions = append(conditions, "id_job = ?")
+		params = append(params, *query.JobId)
+	}
+	if len(query.JobIds) != 0 {
+		conditions = append(conditions, fmt.Sprintf("id_job IN (?%s)", strings.Repeat(", ?", len(query.JobIds)-1)))
+		for _, jobId := range query.JobIds {
+			params = append(params, jobId)
+		}
+	}
+	if len(query.ExcludeJobIds) != 0 {
+		conditions = append(conditions, fmt.Sprintf("id_job NOT IN (?%s)", strings.Repeat(", ?", len(query.ExcludeJobIds)-1)))
+		for _, jobId := range query.ExcludeJobIds {
+			params = append(params, jobId)
+		}
+	}
+	if query.JobName != nil {
+		conditions = append(conditions, "CONVERT(CAST(job_name AS BINARY) USING utf8) = ?")
+		params = append(params, *query.JobName)
+	}
+	if query.ArrayJobId != nil {
+		conditions = append(conditions, "id_array_job = ?")
+		params = append(params, *query.ArrayJobId)
+	}
+	whereStr := ""
+	if len(conditions) != 0 {
+		whereStr = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	return whereStr, params
+}
+
+func (c *CliBackend) QueryJobs(query *JobQuery) ([]*Job, uint32, error) {
+	var count uint32
+	ids, err := c.getTresIds()
+	if err != nil {
+		return nil, 0, err
+	}
+	whereStr, params := jobConditions(query)
+	// 异构作业的其他组件合并到组件0中, 不单独分页和计数, 按作业id查询时除外
+	if query.JobId == nil && len(query.JobIds) == 0 {
+		if whereStr == "" {
+			whereStr = "WHERE (het_job_id = 0 OR het_job_offset = 0)"
+		} else {
+			whereStr += " AND (het_job_id = 0 OR het_job_offset = 0)"
+		}
+	}
+	sortExpr, sortParams, err := c.jobSortExpr(query.SortField, ids, whereStr, params, query.ElapsedNow())
+	if err != nil {
+		return nil, 0, err
+	}
+	direction, compare := "ASC", ">" // 默认就是升序排序
+	if query.Order == "DESC" {
+		direction, compare = "DESC", "<"
+	}
+	columns := c.jobColumns()
+	var jobParams []interface{}
+	orderStr := fmt.Sprintf("ORDER BY id_job %s", direction)
+	if sortExpr != "" {
+		// 排序字段相同时按作业id排序, 和其他后端以及slurmctld中的作业一致
+		columns += ", " + sortExpr + " AS sort_value"
+		jobParams = append(jobParams, sortParams...)
+		orderStr = fmt.Sprintf("ORDER BY sort_value %s, id_job %s", direction, direction)
+	}
+	// 键集分页的条件不影响总数
+	pageStr := whereStr
+	jobParams = append(jobParams, params...)
+	if query.After != "" {
+		after, err := parseJobPosition(query.After, query.SortField)
+		if err != nil {
+			return nil, 0, err
+		}
+		cond := fmt.Sprintf("id_job %s ?", compare)
+		afterParams := []interface{}{after.jobId}
+		if sortExpr != "" {
+			cond = fmt.Sprintf("(%s %s ? OR (%s = ? AND id_job %s ?))", sortExpr, compare, sortExpr, compare)
+			// 排序表达式出现两次, 表达式中的参数也要传两次
+			afterParams = append(append([]interface{}{}, sortParams...), after.value)
+			afterParams = append(afterParams, sortParams...)
+			afterParams = append(afterParams, after.value, after.jobId)
+		}
+		if pageStr == "" {
+			pageStr = "WHERE " + cond
+		} else {
+			pageStr += " AND " + cond
+		}
+		jobParams = append(jobParams, afterParams...)
+	}
+	jobSqlConfig := fmt.Sprintf("SELECT %s FROM %s_job_table %s %s", columns, c.clusterName, pageStr, orderStr)
+	if query.Limit != 0 {
+		jobSqlConfig += " LIMIT ?"
+		jobParams = append(jobParams, query.Limit)
+		if query.After == "" {
+			jobSqlConfig += " OFFSET ?"
+			jobParams = append(jobParams, query.Offset)
+		}
+	}
+	rows, err := c.db.Query(jobSqlConfig, jobParams...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	var jobs []*Job
+	qosNames := make(map[int]string)
+	for rows.Next() {
+		var (
+			job *Job
+			err error
+		)
+		position := jobPosition{}
+		if sortExpr == "" {
+			job, err = c.scanJob(rows, ids, qosNames)
+		} else {
+			// 分页位置带上排序字段的值
+			var sortValue sql.NullString
+			job, err = c.scanJob(sortValueScanner{rows, &sortValue}, ids, qosNames)
+			position.value = sortValue.String
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		position.jobId = job.JobId
+		job.Cursor = position.cursor()
+		jobs = append(jobs, job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	var hetJobIds []uint32
+	for _, job := range jobs {
+		if job.HetJobId != 0 && job.HetJobOffset == 0 {
+			hetJobIds = append(hetJobIds, job.HetJobId)
+		}
+	}
+	if len(hetJobIds) != 0 {
+		components, err := c.queryHetComponents(hetJobIds, ids, qosNames)
+		if err != nil {
+			return nil, 0, err
+		}
+		jobs = MergeHetJobs(append(jobs, components...))
+	}
+	if query.SkipCount {
+		return jobs, 0, nil
+	}
+	// 获取总的作业条数
+	totalSqlConfig := fmt.Sprintf("SELECT count(*) FROM %s_job_table %s", c.clusterName, whereStr)
+	if err := c.db.QueryRow(totalSqlConfig, params...).Scan(&count); err != nil {
+		return nil, 0, err
+	}
+	return jobs, count, nil
+}
+
+// 查询结果最后多一列排序字段的值
+type sortValueScanner struct {
+	rowScanner
+	value *sql.NullString
+}
+
+func (s sortValueScanner) Scan(dest ...interface{}) error {
+	return s.rowScanner.Scan(append(dest, s.value)...)
+}
+
+// tres_alloc中指定tres的数量, 如"1=4,2=4096,1001=2"中id为1的4, 没有时为0
+func tresAllocExpr(id int) string {
+	return fmt.Sprintf("CAST(SUBSTRING_INDEX(SUBSTRING_INDEX(CONCAT(',', tres_alloc, ','), ',%d=', -1), ',', 1) AS UNSIGNED)", id)
+}
+
+// tres_alloc中的gpu数量, 和GetGpuAllocsFromGpuIdList一样取第一个出现的gpu tres
+func gpusAllocExpr(ids *tresIds) string {
+	if !ids.countGpus || len(ids.gpus) == 0 {
+		return "0"
+	}
+	expr := "0"
+	for i := len(ids.gpus) - 1; i >= 0; i-- {
+		expr = fmt.Sprintf("IF(LOCATE(',%d=', CONCAT(',', tres_alloc)) > 0, %s, %s)", ids.gpus[i], tresAllocExpr(ids.gpus[i]), expr)
+	}
+	return expr
+}
+
+// 排序字段在作业表中对应的表达式和表达式中的参数, 和scanJob得到的值一致, 默认顺序时为空.
+// 字符串按二进制比较, 和内存中排序的结果一致. 运行中的作业的elapsed_seconds算到now, 不使用数据库的当前时间
+func (c *CliBackend) jobSortExpr(field string, ids *tresIds, whereStr string, params []interface{}, now int64) (string, []interface{}, error) {
+	switch field {
+	case "":
+		return "", nil, nil
+	case "job_id":
+		return "id_job", nil, nil
+	case "submit_time":
+		return "time_submit", nil, nil
+	case "start_time":
+		return "time_start", nil, nil
+	case "end_time":
+		return "time_end", nil, nil
+	case "account":
+		return "CAST(account AS BINARY)", nil, nil
+	case "partition":
+		return "CAST(`partition` AS BINARY)", nil, nil
+	case "elapsed_seconds":
+		return "(CASE WHEN time_start = 0 THEN 0 WHEN time_end = 0 THEN ? - time_start ELSE time_end - time_start END)", []interface{}{now}, nil
+	case "cpus_alloc":
+		return tresAllocExpr(ids.cpu), nil, nil
+	case "gpus_alloc":
+		return gpusAllocExpr(ids), nil, nil
+	case "state":
+		// 按状态名排序, 和utils.ChangeState的转换一致
+		var cases []string
+		for state := 0; state <= 7; state++ {
+			cases = append(cases, fmt.Sprintf("WHEN %d THEN '%s'", state, utils.ChangeState(state)))
+		}
+		return fmt.Sprintf("(CASE state %s ELSE '%s' END)", strings.Join(cases, " "), utils.ChangeState(-1)), nil, nil
+	case "user":
+		return c.userSortExpr(whereStr, params)
+	}
+	return "", nil, fmt.Errorf("unsupported sort field %s", field)
+}
+
+// 作业表中只有uid, 用CASE把uid转换为用户名后排序, 分页位置中保存用户名, 不受用户增减的影响
+func (c *CliBackend) userSortExpr(whereStr string, params []interface{}) (string, []interface{}, error) {
+	rows, err := c.db.Query(fmt.Sprintf("SELECT DISTINCT id_user FROM %s_job_table %s", c.clusterName, whereStr), params...)
+	if err != nil {
+		return "", nil, err
+	}
+	defer rows.Close()
+	var (
+		cases     []string
+		uidParams []interface{}
+	)
+	for rows.Next() {
+		var uid int
+		if err := rows.Scan(&uid); err != nil {
+			return "", nil, err
+		}
+		// 和scanJob一样, 查不到用户名时为空
+		name, _ := utils.GetUserNameByUid(uid)
+		cases = append(cases, "WHEN ? THEN ?")
+		uidParams = append(uidParams, uid, name)
+	}
+	if err := rows.Err(); err != nil {
+		return "", nil, err
+	}
+	if len(cases) == 0 {
+		return "''", nil, nil
+	}
+	return fmt.Sprintf("CAST((CASE id_user %s ELSE '' END) AS BINARY)", strings.Join(cases, " ")), uidParams, nil
+}
+
+// 在数据库中按时间段和分组维度汇总资源使用量, 跨越时间段边界的作业截取到时间段内.
+// 时间段作为派生表和作业表连接, 条件和SummarizeUsage一致; 用户和qos按id分组后再转换为名称
+func (c *CliBackend) QueryUsage(query *JobQuery, opts *UsageOptions, now int64) ([]*UsageSummary, error) {
+	buckets := usageBuckets(opts)
+	if len(buckets) == 0 {
+		return nil, nil
+	}
+	ids, err := c.getTresIds()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		bucketRows   []string
+		bucketParams []interface{}
+	)
+	for _, bucket := range buckets {
+		bucketRows = append(bucketRows, "SELECT ? AS bucket_start, ? AS bucket_end")
+		bucketParams = append(bucketParams, bucket[0], bucket[1])
+	}
+	// 不分组的维度查询常量
+	columns := []string{"-1", "''", "''", "-1"}
+	groupBy := []string{"b.bucket_start"}
+	for i, group := range []struct {
+		enabled bool
+		column  string
+	}{
+		{opts.GroupByUser, "j.id_user"},
+		{opts.GroupByAccount, "j.account"},
+		{opts.GroupByPartition, "j.`partition`"},
+		{opts.GroupByQos, "j.id_qos"},
+	} {
+		if group.enabled {
+			columns[i] = group.column
+			groupBy = append(groupBy, group.column)
+		}
+	}
+	// 异构作业的各组件分别统计, 作业数只计组件0
+	whereStr, params := jobConditions(query)
+	if whereStr == "" {
+		whereStr = "WHERE time_start != 0"
+	} else {
+		whereStr += " AND time_start != 0"
+	}
+	seconds := "(LEAST(j.time_end, b.bucket_end) - GREATEST(j.time_start, b.bucket_start))"
+	usageSqlConfig := fmt.Sprintf("SELECT b.bucket_start, %s, "+
+		"CAST(SUM(j.het_job_id = 0 OR j.het_job_offset = 0) AS SIGNED), "+
+		"CAST(SUM(j.cpus * %s) AS SIGNED), CAST(SUM(j.gpus * %s) AS SIGNED), CAST(SUM(j.mem * %s) AS SIGNED) "+
+		"FROM (%s) b JOIN (SELECT id_user, account, `partition`, id_qos, het_job_id, het_job_offset, "+
+		"CAST(time_start AS SIGNED) AS time_start, IF(time_end = 0, ?, CAST(time_end AS SIGNED)) AS time_end, "+
+		"%s AS cpus, %s AS gpus, %s AS mem FROM %s_job_table %s) j "+
+		// 时间段不包括结束时间, 刚好在开始时间结束的作业不属于这个时间段
+		"ON j.time_start < b.bucket_end AND (j.time_end > b.bucket_start OR (j.time_end = b.bucket_start AND j.time_start = j.time_end)) "+
+		"GROUP BY %s",
+		strings.Join(columns, ", "), seconds, seconds, seconds, strings.Join(bucketRows, " UNION ALL "),
+		tresAllocExpr(ids.cpu), gpusAllocExpr(ids), tresAllocExpr(ids.mem), c.clusterName, whereStr, strings.Join(groupBy, ", "))
+	usageParams := append(append(bucketParams, now), params...)
+	rows, err := c.db.Query(usageSqlConfig, usageParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	summaryMap := make(map[UsageKey]*UsageSummary)
+	qosNames := make(map[int]string)
+	for rows.Next() {
+		var (
+			bucketStart int64
+			uid         int
+			account     string
+			partition   string
+			idQos       int
+			usage       UsageSummary
+		)
+		if err := rows.Scan(&bucketStart, &uid, &account, &partition, &idQos, &usage.JobCount, &usage.CpuSeconds, &usage.GpuSeconds, &usage.MemMbSeconds); err != nil {
+			return nil, err
+		}
+		var user, qos string
+		if opts.GroupByUser {
+			user, _ = utils.GetUserNameByUid(uid)
+		}
+		if opts.GroupByQos {
+			if _, ok := qosNames[idQos]; !ok {
+				var qosName string
+				c.db.QueryRow("SELECT name FROM qos_table WHERE id = ?", idQos).Scan(&qosName)
+				qosNames[idQos] = qosName
+			}
+			qos = qosNames[idQos]
+		}
+		// 不同的id可能对应同一个名称, 按名称合并
+		key := opts.usageKey(bucketStart, user, account, partition, qos)
+		summary, ok := summaryMap[key]
+		if !ok {
+			summary = &UsageSummary{UsageKey: key}
+			summaryMap[key] = summary
+		}
+		summary.JobCount += usage.JobCount
+		summary.CpuSeconds += usage.CpuSeconds
+		summary.GpuSeconds += usage.GpuSeconds
+		summary.MemMbSeconds += usage.MemMbSeconds
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return sortedUsage(summaryMap), nil
+}
+
+// 作业步表中特殊作业步的id, slurm 20.11前后的取值不同
+func stepIdName(stepId int64) string {
+	switch stepId {
+	case -2, -5:
+		return "batch"
+	case -1, -4:
+		return "extern"
+	case -6:
+		return "interactive"
+	case -3:
+		return "pending"
+	}
+	return strconv.FormatInt(stepId, 10)
+}
+
+// tres id对应的名称, 如cpu、mem、gres/gpu
+func (c *CliBackend) tresNames() (map[int]string, error) {
+	rows, err := c.db.Query("SELECT id, type, name FROM tres_table")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	names := make(map[int]string)
+	for rows.Next() {
+		var (
+			id       int
+			tresType string
+			name     string
+		)
+		if err := rows.Scan(&id, &tresType, &name); err != nil {
+			return nil, err
+		}
+		if name != "" {
+			tresType += "/" + name
+		}
+		names[id] = tresType
+	}
+	return names, rows.Err()
+}
+
+// 把1=4,2=4096这种以tres id为key的字符串转换为以tres名称为key
+func tresByName(tres string, names map[int]string) map[string]uint64 {
+	m := make(map[string]uint64)
+	for key, value := range utils.ParseTres(tres) {
+		id, err := strconv.Atoi(key)
+		if err != nil {
+			continue
+		}
+		number, err := strconv.ParseUint(value, 10, 64)
+		if name, ok := names[id]; ok && err == nil {
+			m[name] = number
+		}
+	}
+	return m
+}
+
+func (c *CliBackend) ListJobSteps(jobId uint32) ([]*JobStep, error) {
+	// 重新排队的作业有多条记录, 只取最新的一条
+	var jobDbInx sql.NullInt64
+	err := c.db.QueryRow(fmt.Sprintf("SELECT MAX(job_db_inx) FROM %s_job_table WHERE id_job = ?", c.clusterName), jobId).Scan(&jobDbInx)
+	if err != nil {
+		return nil, err
+	}
+	if !jobDbInx.Valid {
+		return nil, ErrNotFound
+	}
+	names, err := c.tresNames()
+	if err != nil {
+		return nil, err
+	}
+	stepSqlConfig := fmt.Sprintf("SELECT %s FROM %s_step_table WHERE job_db_inx = ? AND deleted = 0 ORDER BY time_start, id_step", stepColumns, c.clusterName)
+	rows, err := c.db.Query(stepSqlConfig, jobDbInx.Int64)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		steps   []*JobStep
+		running bool
+	)
+	for rows.Next() {
+		step, err := scanStep(rows, names)
+		if err != nil {
+			return nil, err
+		}
+		if step.State == "RUNNING" {
+			running = true
+		}
+		steps = append(steps, step)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// sstat只能查询本机所在集群, 其他集群的同号作业不是这个作业
+	if running && c.slurmCluster == "" {
+		mergeStepUsage(jobId, steps)
+	}
+	return steps, nil
+}
+
+// 作业步表的查询字段
+const stepColumns = "id_step, step_name, state, nodelist, nodes_alloc, task_cnt, time_start, time_end, time_suspended, exit_code, " +
+	"user_sec, user_usec, sys_sec, sys_usec, tres_alloc, tres_usage_in_max, tres_usage_in_ave, tres_usage_in_tot"
+
+func scanStep(row rowScanner, names map[int]string, extra ...interface{}) (*JobStep, error) {
+	var (
+		step      JobStep
+		stepId    int64
+		state     int
+		suspended int64
+		exitCode  int64
+		userSec   int64
+		userUsec  int64
+		sysSec    int64
+		sysUsec   int64
+		tresAlloc string
+		usageMax  string
+		usageAve  string
+		usageTot  string
+	)
+	dest := append(extra, &stepId, &step.Name, &state, &step.NodeList, &step.Nodes, &step.Tasks, &step.StartTime, &step.EndTime, &suspended,
+		&exitCode, &userSec, &userUsec, &sysSec, &sysUsec, &tresAlloc, &usageMax, &usageAve, &usageTot)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	step.StepId = stepIdName(stepId)
+	step.State = utils.ChangeState(state)
+	if step.StartTime != 0 {
+		endTime := step.EndTime
+		if endTime == 0 {
+			endTime = time.Now().Unix()
+		}
+		step.ElapsedSeconds = max(endTime-step.StartTime-suspended, 0)
+	}
+	step.ExitCode, step.ExitSignal = utils.DecodeExitStatus(exitCode)
+	step.CpuTimeSeconds = userSec + sysSec + (userUsec+sysUsec)/1000000
+	step.TresAlloc = tresByName(tresAlloc, names)
+	step.TresUsageInMax = tresByName(usageMax, names)
+	step.TresUsageInAve = tresByName(usageAve, names)
+	step.TresUsageInTot = tresByName(usageTot, names)
+	return &step, nil
+}
+
+func (c *CliBackend) QueryJobSteps(query *JobQuery) (map[uint32][]*JobStep, error) {
+	names, err := c.tresNames()
+	if err != nil {
+		return nil, err
+	}
+	// 查询条件放在子查询中, 避免和作业步表的字段重名
+	whereStr, params := jobConditions(query)
+	stepSqlConfig := fmt.Sprintf("SELECT j.id_job, %s FROM %s_step_table s JOIN (SELECT job_db_inx, id_job FROM %s_job_table %s) j ON s.job_db_inx = j.job_db_inx WHERE s.deleted = 0",
+		stepColumns, c.clusterName, c.clusterName, whereStr)
+	rows, err := c.db.Query(stepSqlConfig, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	steps := make(map[uint32][]*JobStep)
+	for rows.Next() {
+		var jobId uint32
+		step, err := scanStep(rows, names, &jobId)
+		if err != nil {
+			return nil, err
+		}
+		steps[jobId] = append(steps[jobId], step)
+	}
+	return steps, rows.Err()
+}
+
+// 作业步结束前作业步表中没有资源使用量, 运行中的作业步从sstat获取实时数据, 获取失败时保留作业步表中的数据
+func mergeStepUsage(jobId uint32, steps []*JobStep) {
+	// sstat没有-M参数, 只能查询本机所在集群的作业
+	output, err := utils.RunSlurmCommand("sstat", "-j", strconv.Itoa(int(jobId)), "--allsteps", "--noheader", "--parsable2", "--noconvert",
+		"--format=JobID,TRESUsageInMax,TRESUsageInAve,TRESUsageInTot")
+	if err != nil {
+		return
+	}
+	for _, line := range utils.SplitLines(output) {
+		fields := strings.Split(line, "|")
+		if len(fields) != 4 {
+			continue
+		}
+		// JobID的格式为123.0、123.batch
+		_, stepId, ok := strings.Cut(fields[0], ".")
+		if !ok {
+			continue
+		}
+		for _, step := range steps {
+			if step.StepId == stepId && step.State == "RUNNING" {
+				step.TresUsageInMax = utils.ParseTresUsage(fields[1])
+				step.TresUsageInAve = utils.ParseTresUsage(fields[2])
+				step.TresUsageInTot = utils.ParseTresUsage(fields[3])
+			}
+		}
+	}
+}
+
+func (c *CliBackend) UserExists(user string) (bool, error) {
+	return c.nameExists("SELECT name FROM user_table WHERE name = ? AND deleted = 0", user)
+}
+
+func (c *CliBackend) AccountExists(account string) (bool, error) {
+	return c.nameExists("SELECT name FROM acct_table WHERE name = ? AND deleted = 0", account)
+}
+
+func (c *CliBackend) nameExists(sqlConfig string, name string) (bool, error) {
+	var result string
+	err := c.db.QueryRow(sqlConfig, name).Scan(&result)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *CliBackend) ListAccounts() ([]string, error) {
+	return c.queryNames("SELECT name FROM acct_table WHERE deleted = 0")
+}
+
+func (c *CliBackend) ListQos() ([]string, error) {
+	return c.queryNames("SELECT name FROM qos_table WHERE deleted = 0")
+}
+
+func (c *CliBackend) queryNames(sqlConfig string) ([]string, error) {
+	rows, err := c.db.Query(sqlConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+func (c *CliBackend) ListAssociations(filter *AssociationFilter) ([]*Association, error) {
+	var params []interface{}
+	assocSqlConfig := fmt.Sprintf("SELECT DISTINCT user, acct, `partition`, max_submit_jobs FROM %s_assoc_table WHERE deleted = 0", c.clusterName)
+	if filter != nil && filter.User != "" {
+		assocSqlConfig += " AND user = ?"
+		params = append(params, filter.User)
+	}
+	if filter != nil && filter.Account != "" {
+		assocSqlConfig += " AND acct = ?"
+		params = append(params, filter.Account)
+	}
+	rows, err := c.db.Query(assocSqlConfig, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var assocs []*Association
+	for rows.Next() {
+		var (
+			assoc         Association
+			maxSubmitJobs sql.NullInt64
+		)
+		if err := rows.Scan(&assoc.User, &assoc.Account, &assoc.Partition, &maxSubmitJobs); err != nil {
+			return nil, err
+		}
+		// 最大提交作业数为NULL表示没被封锁
+		if maxSubmitJobs.Valid {
+			value := int(maxSubmitJobs.Int64)
+			assoc.MaxSubmitJobs = &value
+		}
+		assocs = append(assocs, &assoc)
+	}
+	return assocs, rows.Err()
+}
+
+func (c *CliBackend) CreateAccount(account string) error {
+	_, err := utils.RunSlurmCommand("sacctmgr", append([]string{"-i", "create", "account", "name=" + account}, c.sacctmgrCluster()...)...)
+	return err
+}
+
+func (c *CliBackend) DeleteAccount(account string) error {
+	_, err := utils.RunSlurmCommand("sacctmgr", append([]string{"-i", "delete", "account", "name=" + account}, c.sacctmgrCluster()...)...)
+	return err
+}
+
+func (c *CliBackend) AddUserToAccount(user string, account string, partition string) error {
+	_, err := utils.RunSlurmCommand("sacctmgr", append([]string{"-i", "create", "user", "name=" + user, "partition=" + partition, "account=" + account}, c.sacctmgrCluster()...)...)
+	return err
+}
+
+func (c *CliBackend) SetUserQos(user string, qos []string, defaultQos string) error {
+	args := append([]string{"-i", "modify", "user", user}, c.sacctmgrCluster()...)
+	_, err := utils.RunSlurmCommand("sacctmgr", append(args, "set", "qos="+strings.Join(qos, ","), "DefaultQOS="+defaultQos)...)
+	return err
+}
+
+func (c *CliBackend) SetUserDefaultAccount(user string, account string) error {
+	_, err := utils.RunSlurmCommand("sacctmgr", append([]string{"-i", "update", "user", "set", "DefaultAccount=" + account, "where", "user=" + user}, c.sacctmgrCluster()...)...)
+	return err
+}
+
+func (c *CliBackend) RemoveUserFromAccount(user string, account string) error {
+	_, err := utils.RunSlurmCommand("sacctmgr", append([]string{"-i", "delete", "user", "name=" + user, "account=" + account}, c.sacctmgrCluster()...)...)
+	return err
+}
+
+func (c *CliBackend) DeleteUser(user string) error {
+	_, err := utils.RunSlurmCommand("sacctmgr", append([]string{"-i", "delete", "user", "name=" + user}, c.sacctmgrCluster()...)...)
+	return err
+}
+
+func (c *CliBackend) BlockUserInAccount(user string, account string) error {
+	args := append([]string{"-i", "-Q", "modify", "user", "where", "name=" + user, "account=" + account}, c.sacctmgrCluster()...)
+	_, err := utils.RunSlurmCommand("sacctmgr", append(args,
+		"set", "MaxSubmitJobs=0", "MaxJobs=0", "GrpJobs=0", "GrpSubmit=0", "GrpSubmitJobs=0", "MaxSubmitJobs=0")...)
+	return err
+}
+
+func (c *CliBackend) UnblockUserInAccount(user string, account string) error {
+	args := append([]string{"-i", "-Q", "modify", "user", "where", "name=" + user, "account=" + account}, c.sacctmgrCluster()...)
+	_, err := utils.RunSlurmCommand("sacctmgr", append(args,
+		"set", "MaxSubmitJobs=-1", "MaxJobs=-1", "GrpJobs=-1", "GrpSubmit=-1", "GrpSubmitJobs=-1", "MaxSubmitJobs=-1")...)
+	return err
+}
@@ -0,0 +1,111 @@
+package backend
+
+import "time"
+
+// 作业的资源使用效率, 计算方式和seff一致
+type JobEfficiency struct {
+	Job              *Job
+	CpuUsedSeconds   int64 // 所有作业步的cpu时间之和
+	CpuAllocSeconds  int64 // 分配的cpu数乘以运行时间
+	MemReqMb         int64 // 分配给作业的内存
+	MemPeakMb        int64 // 作业步中各任务内存峰值之和的最大值
+	MemAveMb         int64 // 作业步中任务平均内存乘以任务数的最大值
+	ElapsedSeconds   int64
+	TimeLimitSeconds int64 // 0表示不限时
+}
+
+// 百分比, 分母为0时返回0
+func percent(used int64, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(used) * 100 / float64(total)
+}
+
+func (e *JobEfficiency) CpuEfficiency() float64 {
+	return percent(e.CpuUsedSeconds, e.CpuAllocSeconds)
+}
+
+func (e *JobEfficiency) MemPeakEfficiency() float64 {
+	return percent(e.MemPeakMb, e.MemReqMb)
+}
+
+func (e *JobEfficiency) MemAveEfficiency() float64 {
+	return percent(e.MemAveMb, e.MemReqMb)
+}
+
+func (e *JobEfficiency) TimeEfficiency() float64 {
+	return percent(e.ElapsedSeconds, e.TimeLimitSeconds)
+}
+
+// 根据作业和作业步的记账数据计算作业的资源使用效率
+func NewJobEfficiency(job *Job, steps []*JobStep) *JobEfficiency {
+	e := &JobEfficiency{
+		Job:              job,
+		MemReqMb:         job.MemAllocMb,
+		TimeLimitSeconds: job.TimeLimitMinutes * 60,
+	}
+	// 作业表中分配的内存为空时使用申请的内存
+	if e.MemReqMb == 0 {
+		e.MemReqMb = job.MemReqMb
+	}
+	switch {
+	case job.StartTime == 0:
+	case job.EndTime != 0:
+		e.ElapsedSeconds = job.EndTime - job.StartTime
+	default:
+		e.ElapsedSeconds = time.Now().Unix() - job.StartTime
+	}
+	e.CpuAllocSeconds = int64(job.CpusAlloc) * e.ElapsedSeconds
+	for _, step := range steps {
+		// 运行中的作业步还没有cpu时间, 使用sstat中的累计cpu使用量
+		if step.CpuTimeSeconds != 0 {
+			e.CpuUsedSeconds += step.CpuTimeSeconds
+		} else {
+			e.CpuUsedSeconds += int64(step.TresUsageInTot["cpu"] / 1000)
+		}
+		peak, ok := step.TresUsageInTot["mem"]
+		if !ok {
+			peak = step.TresUsageInMax["mem"]
+		}
+		e.MemPeakMb = max(e.MemPeakMb, int64(peak>>20))
+		e.MemAveMb = max(e.MemAveMb, int64(step.TresUsageInAve["mem"]*uint64(max(step.Tasks, 1))>>20))
+	}
+	return e
+}
+
+// 按用户或账户汇总的资源使用效率
+type EfficiencySummary struct {
+	Name             string
+	JobCount         uint32
+	CpuUsedSeconds   int64
+	CpuAllocSeconds  int64
+	MemPeakMb        int64
+	MemReqMb         int64
+	ElapsedSeconds   int64 // 只统计有时间限制的作业
+	TimeLimitSeconds int64
+}
+
+func (s *EfficiencySummary) Add(e *JobEfficiency) {
+	s.JobCount++
+	s.CpuUsedSeconds += e.CpuUsedSeconds
+	s.CpuAllocSeconds += e.CpuAllocSeconds
+	s.MemPeakMb += e.MemPeakMb
+	s.MemReqMb += e.MemReqMb
+	if e.TimeLimitSeconds > 0 {
+		s.ElapsedSeconds += e.ElapsedSeconds
+		s.TimeLimitSeconds += e.TimeLimitSeconds
+	}
+}
+
+func (s *EfficiencySummary) CpuEfficiency() float64 {
+	return percent(s.CpuUsedSeconds, s.CpuAllocSeconds)
+}
+
+func (s *EfficiencySummary) MemEfficiency() float64 {
+	return percent(s.MemPeakMb, s.MemReqMb)
+}
+
+func (s *EfficiencySummary) TimeEfficiency() float64 {
+	return percent(s.ElapsedSeconds, s.TimeLimitSeconds)
+}
@@ -0,0 +1,126 @@
+package backend
+
+import (
+	"sort"
+	"strings"
+)
+
+// 异构作业的组件在slurm中是各自独立的作业, 查询结果中把其他组件合并到组件0,
+// 资源为全部组件之和, 组件0不在结果中时其他组件保持不变
+
+// 合并记账数据库中异构作业的组件
+func MergeHetJobs(jobs []*Job) []*Job {
+	components := make(map[uint32][]*Job)
+	for _, job := range jobs {
+		if job.HetJobId != 0 {
+			components[job.HetJobId] = append(components[job.HetJobId], job)
+		}
+	}
+	var merged []*Job
+	for _, job := range jobs {
+		if job.HetJobId == 0 {
+			merged = append(merged, job)
+			continue
+		}
+		group := components[job.HetJobId]
+		leader := hetLeader(group, func(j *Job) uint32 { return j.HetJobOffset })
+		if leader < 0 {
+			merged = append(merged, job)
+			continue
+		}
+		if job != group[leader] {
+			continue
+		}
+		sort.SliceStable(group, func(i, j int) bool { return group[i].HetJobOffset < group[j].HetJobOffset })
+		total := *job
+		total.HetComponents = group
+		total.CpusReq, total.MemReqMb, total.NodesReq = 0, 0, 0
+		total.NodesAlloc, total.CpusAlloc, total.MemAllocMb, total.GpusAlloc = 0, 0, 0, 0
+		var partitions, nodeLists []string
+		for _, component := range group {
+			total.CpusReq += component.CpusReq
+			total.MemReqMb += component.MemReqMb
+			total.NodesReq += component.NodesReq
+			total.NodesAlloc += component.NodesAlloc
+			total.CpusAlloc += component.CpusAlloc
+			total.MemAllocMb += component.MemAllocMb
+			total.GpusAlloc += component.GpusAlloc
+			partitions = appendUnique(partitions, component.Partition)
+			nodeLists = appendUnique(nodeLists, component.NodeList)
+		}
+		total.Partition = strings.Join(partitions, ",")
+		total.NodeList = strings.Join(nodeLists, ",")
+		merged = append(merged, &total)
+	}
+	return merged
+}
+
+// 合并slurmctld中异构作业的组件, 各组件每个节点的gpu数不同, 需要从HetComponents中计算gpu总数
+func MergeHetQueueJobs(jobs []*QueueJob) []*QueueJob {
+	components := make(map[uint32][]*QueueJob)
+	for _, job := range jobs {
+		if job.HetJobId != 0 {
+			components[job.HetJobId] = append(components[job.HetJobId], job)
+		}
+	}
+	var merged []*QueueJob
+	for _, job := range jobs {
+		if job.HetJobId == 0 {
+			merged = append(merged, job)
+			continue
+		}
+		group := components[job.HetJobId]
+		leader := hetLeader(group, func(j *QueueJob) uint32 { return j.HetJobOffset })
+		if leader < 0 {
+			merged = append(merged, job)
+			continue
+		}
+		if job != group[leader] {
+			continue
+		}
+		sort.SliceStable(group, func(i, j int) bool { return group[i].HetJobOffset < group[j].HetJobOffset })
+		total := *job
+		total.HetComponents = group
+		total.Cpus, total.Nodes = 0, 0
+		var partitions, nodeLists []string
+		for _, component := range group {
+			total.Cpus += component.Cpus
+			total.Nodes += component.Nodes
+			partitions = appendUnique(partitions, component.Partition)
+			nodeLists = appendUnique(nodeLists, component.NodeList)
+		}
+		total.Partition = strings.Join(partitions, ",")
+		total.NodeList = strings.Join(nodeLists, ",")
+		merged = append(merged, &total)
+	}
+	return merged
+}
+
+// 组件0在组件列表中的下标, 没有时返回-1
+func hetLeader[T any](group []T, offset func(T) uint32) int {
+	for i, component := range group {
+		if offset(component) == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+func appendUnique(list []string, s string) []string {
+	if s == "" || containsString(list, s) {
+		return list
+	}
+	return append(list, s)
+}
+
+// 作业的gpu总数, 异构作业为全部组件之和
+func (j *QueueJob) GpusAlloc() int32 {
+	if len(j.HetComponents) == 0 {
+		return j.GpusPerNode * j.Nodes
+	}
+	var gpus int32
+	for _, component := range j.HetComponents {
+		gpus += component.GpusPerNode * component.Nodes
+	}
+	return gpus
+}
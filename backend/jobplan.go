@@ -0,0 +1,352 @@
+package backend
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"sort"
+	"time"
+)
+
+// 作业查询同时使用记账数据库和slurmctld: 数据库中的作业按条件查询和分页,
+// slurmctld中的作业覆盖数据库中过时的状态, 刚提交还没写入数据库的作业也会出现在结果中.
+// 查询条件按覆盖后的状态判断, 数据库中的状态过时、只有覆盖后才符合条件的作业和未写入数据库的作业一样处理,
+// 这些作业按排序字段和作业id插入到数据库的查询结果中
+
+// 查询计划得到的作业, 不在slurmctld中时Queue为nil
+type PlannedJob struct {
+	*Job
+	Queue *QueueJob
+}
+
+// slurmctld中的作业转换为Job, 用于还没写入数据库的作业
+func JobFromQueueJob(queueJob *QueueJob) *Job {
+	job := &Job{
+		JobId:            queueJob.JobId,
+		Name:             queueJob.Name,
+		Account:          queueJob.Account,
+		User:             queueJob.User,
+		Partition:        queueJob.Partition,
+		Qos:              queueJob.Qos,
+		State:            queueJob.State,
+		CpusReq:          queueJob.Cpus,
+		NodesReq:         queueJob.Nodes,
+		TimeLimitMinutes: max(queueJob.TimeLimitMinutes, 0),
+		SubmitTime:       queueJob.SubmitTime,
+		WorkingDirectory: queueJob.WorkingDirectory,
+		ArrayJobId:       queueJob.ArrayJobId,
+		ArrayTaskId:      queueJob.ArrayTaskId,
+		HetJobId:         queueJob.HetJobId,
+		HetJobOffset:     queueJob.HetJobOffset,
+	}
+	if job.SubmitTime == 0 {
+		job.SubmitTime = time.Now().Unix()
+	}
+	// 排队的作业还没有分配资源
+	if queueJob.State != "PENDING" {
+		job.NodeList = queueJob.NodeList
+		job.NodesAlloc = queueJob.Nodes
+		job.CpusAlloc = queueJob.Cpus
+		job.GpusAlloc = queueJob.GpusAlloc()
+		job.StartTime = time.Now().Unix() - queueJob.ElapsedSeconds
+	}
+	for _, component := range queueJob.HetComponents {
+		job.HetComponents = append(job.HetComponents, JobFromQueueJob(component))
+	}
+	return job
+}
+
+// 用slurmctld中的状态覆盖数据库中的作业, 数据库还没更新的分配信息也从slurmctld中补上
+func OverlayQueueJob(job *Job, queueJob *QueueJob) *Job {
+	live := JobFromQueueJob(queueJob)
+	overlaid := *job
+	overlaid.State = live.State
+	if overlaid.NodeList == "" || overlaid.NodeList == "None assigned" {
+		overlaid.NodeList = live.NodeList
+	}
+	if overlaid.NodesAlloc == 0 {
+		overlaid.NodesAlloc = live.NodesAlloc
+	}
+	if overlaid.CpusAlloc == 0 {
+		overlaid.CpusAlloc = live.CpusAlloc
+	}
+	if overlaid.GpusAlloc == 0 {
+		overlaid.GpusAlloc = live.GpusAlloc
+	}
+	if overlaid.StartTime == 0 {
+		overlaid.StartTime = live.StartTime
+	}
+	return &overlaid
+}
+
+// 键集分页的位置, 为上一页最后一个作业的排序字段的值和作业id, 数据库和slurmctld中的作业使用同一个位置.
+// Now为第一页计算elapsed_seconds使用的时间, 之后的页使用同一个时间
+type JobCursor struct {
+	After string `json:"after,omitempty"`
+	Now   int64  `json:"now,omitempty"`
+}
+
+// 编码为不透明的分页令牌
+func (c *JobCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// 解析分页令牌, 格式不对时返回ErrInvalidCursor
+func DecodeJobCursor(token string) (*JobCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor JobCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+// slurmctld中的作业, 以及需要单独加到数据库查询结果中的作业:
+// 还没写入数据库的作业, 和数据库中的状态不符合条件、覆盖slurmctld中的状态后才符合条件的作业.
+// 反过来覆盖后不再符合条件的作业放在excluded中, 查询数据库时排除
+func liveJobs(b Backend, query *JobQuery) (map[uint32]*QueueJob, []*Job, []uint32, error) {
+	queueFilter := &QueueFilter{
+		Users:      query.Users,
+		Accounts:   query.Accounts,
+		JobName:    query.JobName,
+		ArrayJobId: query.ArrayJobId,
+	}
+	if query.JobId != nil {
+		queueFilter.JobIds = []uint32{*query.JobId}
+	}
+	queueJobs, err := b.ListQueueJobs(queueFilter)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// 只有状态和运行时间的条件会因为覆盖slurmctld中的数据而改变结果,
+	// 没有这些条件时只需要检查slurmctld中符合条件的作业是否已经写入数据库
+	overlayDependent := len(query.States) != 0 || query.RunTimeStart != 0 || query.RunTimeEnd != 0
+	queueMap := make(map[uint32]*QueueJob)
+	var candidates []uint32
+	for _, queueJob := range MergeHetQueueJobs(queueJobs) {
+		queueMap[queueJob.JobId] = queueJob
+		if overlayDependent || query.Match(JobFromQueueJob(queueJob)) {
+			candidates = append(candidates, queueJob.JobId)
+		}
+	}
+	if len(candidates) == 0 {
+		return queueMap, nil, nil, nil
+	}
+	existing, _, err := b.QueryJobs(&JobQuery{JobIds: candidates, SkipCount: true})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	dbJobs := make(map[uint32]*Job, len(existing))
+	for _, job := range existing {
+		dbJobs[job.JobId] = job
+	}
+	var (
+		missing  []*Job
+		excluded []uint32
+	)
+	for _, jobId := range candidates {
+		dbJob, ok := dbJobs[jobId]
+		if !ok {
+			if job := JobFromQueueJob(queueMap[jobId]); query.Match(job) {
+				missing = append(missing, job)
+			}
+			continue
+		}
+		// 数据库中的作业按覆盖后的状态重新判断是否符合条件
+		dbMatch := query.Match(dbJob)
+		job := OverlayQueueJob(dbJob, queueMap[jobId])
+		switch liveMatch := query.Match(job); {
+		case liveMatch && !dbMatch:
+			missing = append(missing, job)
+		case !liveMatch && dbMatch:
+			excluded = append(excluded, jobId)
+		}
+	}
+	return queueMap, missing, excluded, nil
+}
+
+// 查询数据库时排除覆盖slurmctld中的状态后不再符合条件的作业
+func excludeJobs(query *JobQuery, excluded []uint32) *JobQuery {
+	if len(excluded) == 0 {
+		return query
+	}
+	dbQuery := *query
+	dbQuery.ExcludeJobIds = append(slices.Clone(query.ExcludeJobIds), excluded...)
+	return &dbQuery
+}
+
+// 数据库中的作业覆盖slurmctld中的状态
+func plannedDbJob(job *Job, queueMap map[uint32]*QueueJob) *PlannedJob {
+	queueJob, ok := queueMap[job.JobId]
+	if ok {
+		job = OverlayQueueJob(job, queueJob)
+	}
+	return &PlannedJob{Job: job, Queue: queueJob}
+}
+
+// 合并时的作业, 数据库中的作业使用后端生成的位置, 和数据库中的排序一致
+type positionedJob struct {
+	job      *Job
+	position jobPosition
+	db       bool
+}
+
+// 按排序方向合并数据库中的作业和需要单独加入的作业
+func mergeJobs(query *JobQuery, dbJobs []*Job, missing []*Job, now int64) ([]positionedJob, error) {
+	merged := make([]positionedJob, 0, len(dbJobs)+len(missing))
+	for _, job := range dbJobs {
+		position, err := parseJobPosition(job.Cursor, query.SortField)
+		if err != nil {
+			return nil, err
+		}
+		merged = append(merged, positionedJob{job: job, position: position, db: true})
+	}
+	for _, job := range missing {
+		merged = append(merged, positionedJob{job: job, position: newJobPosition(job, query.SortField, now)})
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].position.compareIn(query, merged[j].position) < 0
+	})
+	return merged, nil
+}
+
+func plannedJobs(merged []positionedJob, queueMap map[uint32]*QueueJob) []*PlannedJob {
+	planned := make([]*PlannedJob, 0, len(merged))
+	for _, item := range merged {
+		if item.db {
+			planned = append(planned, plannedDbJob(item.job, queueMap))
+		} else {
+			planned = append(planned, &PlannedJob{Job: item.job, Queue: queueMap[item.job.JobId]})
+		}
+	}
+	return planned
+}
+
+// 按查询条件合并数据库和slurmctld中的作业, 返回当前页的作业和符合条件的作业总数
+func PlanJobs(b Backend, query *JobQuery) ([]*PlannedJob, uint32, error) {
+	queueMap, missing, excluded, err := liveJobs(b, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	query = excludeJobs(query, excluded)
+	// 数据库和内存中的作业用同一个时间计算elapsed_seconds
+	dbQuery := *query
+	dbQuery.Now = query.ElapsedNow()
+	merged, dbCount, err := pageJobs(b, &dbQuery, missing, dbQuery.Now)
+	if err != nil {
+		return nil, 0, err
+	}
+	return plannedJobs(merged, queueMap), plannedTotal(query, dbCount, missing), nil
+}
+
+// 按键集分页查询一页作业, cursor为nil时从第一页开始, 没有更多作业时返回的下一页位置为nil.
+// 翻页期间写入数据库的作业位置不变, 不会重复返回
+func PlanJobsAfter(b Backend, query *JobQuery, cursor *JobCursor) ([]*PlannedJob, *JobCursor, uint32, error) {
+	queueMap, missing, excluded, err := liveJobs(b, query)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	query = excludeJobs(query, excluded)
+	now := query.ElapsedNow()
+	if cursor != nil && cursor.Now != 0 {
+		now = cursor.Now
+	}
+	dbQuery := *query
+	dbQuery.Offset = 0
+	dbQuery.After = ""
+	dbQuery.Now = now
+	// 当前位置之后需要单独加入的作业
+	pending := missing
+	if cursor != nil && cursor.After != "" {
+		after, err := parseJobPosition(cursor.After, query.SortField)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		dbQuery.After = cursor.After
+		pending = nil
+		for _, job := range missing {
+			if newJobPosition(job, query.SortField, now).compareIn(query, after) > 0 {
+				pending = append(pending, job)
+			}
+		}
+	}
+	dbJobs, dbCount, err := b.QueryJobs(&dbQuery)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	merged, err := mergeJobs(query, dbJobs, pending, now)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	total := plannedTotal(query, dbCount, missing)
+	limit := int(query.Limit)
+	// 数据库中的作业取满一页时数据库中可能还有作业
+	if limit == 0 || (len(merged) <= limit && len(dbJobs) < limit) {
+		return plannedJobs(merged, queueMap), nil, total, nil
+	}
+	merged = merged[:min(limit, len(merged))]
+	next := &JobCursor{After: merged[len(merged)-1].position.cursor(), Now: now}
+	return plannedJobs(merged, queueMap), next, total, nil
+}
+
+// 符合条件的作业总数, 不需要统计时返回0
+func plannedTotal(query *JobQuery, dbCount uint32, missing []*Job) uint32 {
+	if query.SkipCount {
+		return 0
+	}
+	return dbCount + uint32(len(missing))
+}
+
+// 按偏移分页查询数据库中的作业, 并和需要单独加入的作业合并后截取当前页.
+// 当前页之前最多有len(missing)个单独加入的作业, 所以从offset-len(missing)开始查询数据库,
+// 再按排在查询到的第一个作业之前的单独加入的作业数确定它在合并结果中的位置
+func pageJobs(b Backend, query *JobQuery, missing []*Job, now int64) ([]positionedJob, uint32, error) {
+	if query.Limit == 0 {
+		dbJobs, dbCount, err := b.QueryJobs(query)
+		if err != nil {
+			return nil, 0, err
+		}
+		merged, err := mergeJobs(query, dbJobs, missing, now)
+		return merged, dbCount, err
+	}
+	dbQuery := *query
+	dbQuery.Offset = query.Offset - min(query.Offset, uint64(len(missing)))
+	dbQuery.Limit = query.Offset + query.Limit - dbQuery.Offset
+	dbJobs, dbCount, err := b.QueryJobs(&dbQuery)
+	if err != nil {
+		return nil, 0, err
+	}
+	base := uint64(0)
+	if dbQuery.Offset != 0 {
+		// 数据库中的作业不够时偏移超过了作业总数
+		if len(dbJobs) == 0 {
+			return nil, dbCount, nil
+		}
+		first, err := parseJobPosition(dbJobs[0].Cursor, query.SortField)
+		if err != nil {
+			return nil, 0, err
+		}
+		// 排在第一个作业之前的作业不在当前页
+		var after []*Job
+		for _, job := range missing {
+			if newJobPosition(job, query.SortField, now).compareIn(query, first) < 0 {
+				base++
+			} else {
+				after = append(after, job)
+			}
+		}
+		base += dbQuery.Offset
+		missing = after
+	}
+	merged, err := mergeJobs(query, dbJobs, missing, now)
+	if err != nil {
+		return nil, 0, err
+	}
+	start := min(query.Offset-base, uint64(len(merged)))
+	end := min(start+query.Limit, uint64(len(merged)))
+	return merged[start:end], dbCount, nil
+}
@@ -0,0 +1,156 @@
+package backend
+
+import (
+	"cmp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 可以排序的作业字段, 和JobInfo中的字段名一致, 值为int64或string.
+// 排序字段相同时按作业id排序, 数据库、slurmrestd和slurmctld中的作业使用同一个顺序, 保证分页结果稳定
+var jobSortFields = map[string]func(job *Job, now int64) any{
+	"job_id":          func(job *Job, now int64) any { return int64(job.JobId) },
+	"submit_time":     func(job *Job, now int64) any { return job.SubmitTime },
+	"start_time":      func(job *Job, now int64) any { return job.StartTime },
+	"end_time":        func(job *Job, now int64) any { return job.EndTime },
+	"state":           func(job *Job, now int64) any { return job.State },
+	"user":            func(job *Job, now int64) any { return job.User },
+	"account":         func(job *Job, now int64) any { return job.Account },
+	"partition":       func(job *Job, now int64) any { return job.Partition },
+	"elapsed_seconds": func(job *Job, now int64) any { return job.ElapsedSeconds(now) },
+	"cpus_alloc":      func(job *Job, now int64) any { return int64(job.CpusAlloc) },
+	"gpus_alloc":      func(job *Job, now int64) any { return int64(job.GpusAlloc) },
+}
+
+// 是否支持按这个字段排序, 空字段表示默认顺序
+func IsJobSortField(field string) bool {
+	_, ok := jobSortFields[field]
+	return field == "" || ok
+}
+
+// 作业的运行时间, 排队的作业为0, 未结束的作业算到now
+func (j *Job) ElapsedSeconds(now int64) int64 {
+	switch {
+	case j.StartTime == 0:
+		return 0
+	case j.EndTime == 0:
+		return now - j.StartTime
+	}
+	return j.EndTime - j.StartTime
+}
+
+// 作业排序字段的值, 默认顺序时为nil
+func jobSortValue(job *Job, field string, now int64) any {
+	if value, ok := jobSortFields[field]; ok {
+		return value(job, now)
+	}
+	return nil
+}
+
+func compareSortValues(a, b any) int {
+	switch a := a.(type) {
+	case int64:
+		return cmp.Compare(a, b.(int64))
+	case string:
+		return cmp.Compare(a, b.(string))
+	}
+	return 0
+}
+
+// 作业在排序结果中的位置, 由排序字段的值和作业id组成
+type jobPosition struct {
+	value any
+	jobId uint32
+}
+
+func newJobPosition(job *Job, field string, now int64) jobPosition {
+	return jobPosition{value: jobSortValue(job, field, now), jobId: job.JobId}
+}
+
+func (p jobPosition) compare(o jobPosition) int {
+	if c := compareSortValues(p.value, o.value); c != 0 {
+		return c
+	}
+	return cmp.Compare(p.jobId, o.jobId)
+}
+
+// 按查询的排序方向比较, 小于0表示p排在o之前
+func (p jobPosition) compareIn(query *JobQuery, o jobPosition) int {
+	if query.Order == "DESC" {
+		return o.compare(p)
+	}
+	return p.compare(o)
+}
+
+// 分页位置由作业id和排序字段的值组成
+func (p jobPosition) cursor() string {
+	id := strconv.FormatUint(uint64(p.jobId), 10)
+	switch value := p.value.(type) {
+	case int64:
+		return id + "|" + strconv.FormatInt(value, 10)
+	case string:
+		return id + "|" + value
+	}
+	return id
+}
+
+// 解析分页位置, 格式不对时返回ErrInvalidCursor
+func parseJobPosition(cursor string, field string) (jobPosition, error) {
+	var position jobPosition
+	id, str, found := strings.Cut(cursor, "|")
+	if value, ok := jobSortFields[field]; ok {
+		if !found {
+			return position, ErrInvalidCursor
+		}
+		position.value = str
+		if _, numeric := value(&Job{}, 0).(int64); numeric {
+			num, err := strconv.ParseInt(str, 10, 64)
+			if err != nil {
+				return position, ErrInvalidCursor
+			}
+			position.value = num
+		}
+	} else if found {
+		return position, ErrInvalidCursor
+	}
+	jobId, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return position, ErrInvalidCursor
+	}
+	position.jobId = uint32(jobId)
+	return position, nil
+}
+
+// 在内存中排序和分页, 用于不能在数据库中排序的后端, 分页位置和数据库中的一致
+func PageJobsInMemory(jobs []*Job, query *JobQuery, now int64) ([]*Job, uint32, error) {
+	positions := make(map[*Job]jobPosition, len(jobs))
+	for _, job := range jobs {
+		position := newJobPosition(job, query.SortField, now)
+		positions[job] = position
+		job.Cursor = position.cursor()
+	}
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return positions[jobs[i]].compareIn(query, positions[jobs[j]]) < 0
+	})
+	total := uint32(len(jobs))
+	offset := query.Offset
+	if query.After != "" {
+		after, err := parseJobPosition(query.After, query.SortField)
+		if err != nil {
+			return nil, 0, err
+		}
+		// 从位置之后的第一个作业开始
+		jobs = jobs[sort.Search(len(jobs), func(i int) bool {
+			return positions[jobs[i]].compareIn(query, after) > 0
+		}):]
+		offset = 0
+	}
+	if query.Limit != 0 {
+		if offset >= uint64(len(jobs)) {
+			return nil, total, nil
+		}
+		jobs = jobs[offset:min(offset+query.Limit, uint64(len(jobs)))]
+	}
+	return jobs, total, nil
+}
@@ -0,0 +1,106 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// 可以修改的排队作业参数, 为nil的字段不修改
+type JobUpdate struct {
+	Partition *string
+	Qos       *string
+	NodeCount *uint32
+	Cpus      *uint32 // 作业的总核数
+	Gpus      *uint32 // 每个节点的gpu卡数
+	MemMb     *int64  // 每个节点的内存
+	Name      *string
+	Comment   *string
+}
+
+// 修改结果中的字段名, 和UpdateJobRequest中的字段名一致
+const (
+	JobUpdatePartition = "partition"
+	JobUpdateQos       = "qos"
+	JobUpdateNodeCount = "node_count"
+	JobUpdateCpus      = "cpus"
+	JobUpdateGpus      = "gpus"
+	JobUpdateMemMb     = "memory_mb"
+	JobUpdateName      = "name"
+	JobUpdateComment   = "comment"
+)
+
+// 要修改的字段, 按slurm中应用的顺序排列, 先改分区再改依赖分区配置的其他字段
+func (u *JobUpdate) Fields() []string {
+	var fields []string
+	for _, field := range []struct {
+		name string
+		set  bool
+	}{
+		{JobUpdatePartition, u.Partition != nil},
+		{JobUpdateQos, u.Qos != nil},
+		{JobUpdateNodeCount, u.NodeCount != nil},
+		{JobUpdateCpus, u.Cpus != nil},
+		{JobUpdateGpus, u.Gpus != nil},
+		{JobUpdateMemMb, u.MemMb != nil},
+		{JobUpdateName, u.Name != nil},
+		{JobUpdateComment, u.Comment != nil},
+	} {
+		if field.set {
+			fields = append(fields, field.name)
+		}
+	}
+	return fields
+}
+
+// 逗号分隔的列表是否允许value, ALL或者为空表示不限制
+func allowedIn(list string, value string) bool {
+	return list == "" || list == "ALL" || slices.Contains(strings.Split(list, ","), value)
+}
+
+// 根据作业修改后所在分区的配置检查修改内容, 返回不合法的字段和原因.
+// job为修改前的作业, node为分区中的节点, 用于检查每个节点的gpu和内存, 为nil时不检查
+func ValidateJobUpdate(update *JobUpdate, job *QueueJob, partition *Partition, node *Node) map[string]error {
+	invalid := make(map[string]error)
+	if !allowedIn(partition.AllowAccounts, job.Account) {
+		invalid[JobUpdatePartition] = fmt.Errorf("account %s is not allowed to use partition %s", job.Account, partition.Name)
+	}
+	qos := job.Qos
+	if update.Qos != nil {
+		qos = *update.Qos
+	}
+	if !allowedIn(partition.AllowQos, qos) {
+		// 没有修改qos时是分区不允许作业原来的qos
+		field := JobUpdateQos
+		if update.Qos == nil {
+			field = JobUpdatePartition
+		}
+		invalid[field] = fmt.Errorf("qos %s is not allowed in partition %s", qos, partition.Name)
+	}
+	if update.NodeCount != nil {
+		if *update.NodeCount == 0 || int(*update.NodeCount) > partition.TotalNodes {
+			invalid[JobUpdateNodeCount] = fmt.Errorf("node count should be between 1 and %d", partition.TotalNodes)
+		}
+	}
+	if update.Cpus != nil {
+		if *update.Cpus == 0 || int(*update.Cpus) > partition.TotalCpus {
+			invalid[JobUpdateCpus] = fmt.Errorf("cpus should be between 1 and %d", partition.TotalCpus)
+		}
+	}
+	if node != nil {
+		if update.Gpus != nil && int(*update.Gpus) > node.Gpus {
+			invalid[JobUpdateGpus] = fmt.Errorf("gpus should not exceed %d per node", node.Gpus)
+		}
+		if update.MemMb != nil && node.RealMemoryMb != 0 && *update.MemMb > int64(node.RealMemoryMb) {
+			invalid[JobUpdateMemMb] = fmt.Errorf("memory should not exceed %d MB per node", node.RealMemoryMb)
+		}
+	}
+	if update.MemMb != nil && *update.MemMb <= 0 {
+		invalid[JobUpdateMemMb] = errors.New("memory should be positive")
+	}
+	if update.Name != nil && *update.Name == "" {
+		invalid[JobUpdateName] = errors.New("name should not be empty")
+	}
+	return invalid
+}
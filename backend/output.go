@@ -0,0 +1,101 @@
+package backend
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+)
+
+// sbatch --output、--error中的文件名模式, 如%j、%A_%a、%3a
+var outputPatternRegexp = regexp.MustCompile(`%(\d*)([%AajJux])`)
+
+// 展开输出文件名模式时用到的作业信息
+type outputPathVars struct {
+	jobId       uint32
+	arrayJobId  uint32
+	arrayTaskId string
+	user        string
+	name        string
+	workDir     string
+}
+
+// 展开输出文件名中的模式, 相对路径基于作业的工作目录
+func expandOutputPath(pattern string, vars *outputPathVars) string {
+	path := outputPatternRegexp.ReplaceAllStringFunc(pattern, func(match string) string {
+		groups := outputPatternRegexp.FindStringSubmatch(match)
+		width, _ := strconv.Atoi(groups[1])
+		number := func(value string) string {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return value
+			}
+			return fmt.Sprintf("%0*d", width, n)
+		}
+		switch groups[2] {
+		case "%":
+			return "%"
+		case "A":
+			if vars.arrayJobId == 0 {
+				return number(strconv.Itoa(int(vars.jobId)))
+			}
+			return number(strconv.Itoa(int(vars.arrayJobId)))
+		case "a":
+			if vars.arrayJobId == 0 {
+				return number("4294967294")
+			}
+			return number(vars.arrayTaskId)
+		case "j", "J":
+			return number(strconv.Itoa(int(vars.jobId)))
+		case "u":
+			return vars.user
+		default:
+			return vars.name
+		}
+	})
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(vars.workDir, path)
+	}
+	return path
+}
+
+// 没有指定--output时slurm使用的输出文件名
+func defaultOutputPattern(arrayJobId uint32) string {
+	if arrayJobId != 0 {
+		return "slurm-%A_%a.out"
+	}
+	return "slurm-%j.out"
+}
+
+func jobOutputPathVars(job *Job) *outputPathVars {
+	return &outputPathVars{
+		jobId:       job.JobId,
+		arrayJobId:  job.ArrayJobId,
+		arrayTaskId: job.ArrayTaskId,
+		user:        job.User,
+		name:        job.Name,
+		workDir:     job.WorkingDirectory,
+	}
+}
+
+// 记账数据库中保存的是未展开的文件名模式, 没有指定--error时和标准输出相同
+func setJobOutputPaths(job *Job, stdoutPattern string, stderrPattern string) {
+	if stdoutPattern == "" {
+		return
+	}
+	if stderrPattern == "" {
+		stderrPattern = stdoutPattern
+	}
+	vars := jobOutputPathVars(job)
+	job.StdoutPath = expandOutputPath(stdoutPattern, vars)
+	job.StderrPath = expandOutputPath(stderrPattern, vars)
+}
+
+// 作业已经不在slurmctld中时, 记账数据库中没有输出文件的只能按默认文件名推断
+func jobOutputFromJob(job *Job) *JobOutput {
+	if job.StdoutPath != "" {
+		return &JobOutput{User: job.User, StdoutPath: job.StdoutPath, StderrPath: job.StderrPath}
+	}
+	path := expandOutputPath(defaultOutputPattern(job.ArrayJobId), jobOutputPathVars(job))
+	return &JobOutput{User: job.User, StdoutPath: path, StderrPath: path}
+}
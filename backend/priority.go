@@ -0,0 +1,44 @@
+package backend
+
+import (
+	"cmp"
+	"slices"
+)
+
+// sprio中排队作业在一个分区中的优先级, 各因子都是乘以权重后的值
+type JobPriority struct {
+	JobId           uint32
+	Partition       string
+	User            string
+	Priority        int64
+	Age             int64
+	Fairshare       int64
+	JobSize         int64
+	PartitionFactor int64
+	Qos             int64
+	Nice            int64
+	Tres            map[string]int64 // 如cpu、gres/gpu, 没有配置PriorityWeightTRES时为空
+}
+
+// 作业在分区排队作业中的名次, 从1开始, 优先级相同时先提交(作业id小)的在前.
+// 作业不在priorities中时返回0
+func JobPriorityRank(priorities []*JobPriority, jobId uint32, partition string) (rank uint32, pendingCount uint32) {
+	var inPartition []*JobPriority
+	for _, priority := range priorities {
+		if priority.Partition == partition {
+			inPartition = append(inPartition, priority)
+		}
+	}
+	slices.SortFunc(inPartition, func(a, b *JobPriority) int {
+		if c := cmp.Compare(b.Priority, a.Priority); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.JobId, b.JobId)
+	})
+	for i, priority := range inPartition {
+		if priority.JobId == jobId {
+			rank = uint32(i + 1)
+		}
+	}
+	return rank, uint32(len(inPartition))
+}
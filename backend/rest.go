@@ -0,0 +1,1495 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"scow-slurm-adapter/utils"
+)
+
+const (
+	defaultApiVersion    = "v0.0.40"
+	defaultTokenLifespan = 1800
+	defaultTimeout       = 30
+	// 通过unix socket访问时使用的占位主机名
+	unixSocketHost = "http://slurmrestd"
+)
+
+// slurmrestd的REST接口后端
+type RestBackend struct {
+	client        *http.Client
+	baseUrl       string
+	apiVersion    string
+	user          string
+	token         string
+	tokenFile     string
+	jwtKey        []byte
+	tokenLifespan int64
+	clusterName   string
+}
+
+func NewRestBackend(config *utils.Config) (*RestBackend, error) {
+	restConfig := config.SlurmRestd
+	if restConfig.Url == "" {
+		return nil, fmt.Errorf("slurmrestd url is not set")
+	}
+	r := &RestBackend{
+		apiVersion:    restConfig.ApiVersion,
+		user:          restConfig.User,
+		token:         restConfig.Token,
+		tokenFile:     restConfig.TokenFile,
+		tokenLifespan: int64(restConfig.TokenLifespan),
+		clusterName:   config.MySQLConfig.ClusterName,
+	}
+	if r.apiVersion == "" {
+		r.apiVersion = defaultApiVersion
+	}
+	if r.tokenLifespan == 0 {
+		r.tokenLifespan = defaultTokenLifespan
+	}
+	if restConfig.JwtKeyFile != "" {
+		key, err := os.ReadFile(restConfig.JwtKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		r.jwtKey = key
+	}
+	timeout := restConfig.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	transport := &http.Transport{}
+	if socketPath, ok := strings.CutPrefix(restConfig.Url, "unix://"); ok {
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "unix", socketPath)
+		}
+		r.baseUrl = unixSocketHost
+	} else {
+		r.baseUrl = strings.TrimRight(restConfig.Url, "/")
+	}
+	r.client = &http.Client{Transport: transport, Timeout: time.Duration(timeout) * time.Second}
+	return r, nil
+}
+
+// slurmrestd中的数值字段, 新版本为{set, infinite, number}结构, 老版本为数字
+type restNumber struct {
+	Set      bool  `json:"set"`
+	Infinite bool  `json:"infinite"`
+	Number   int64 `json:"number"`
+}
+
+func (n *restNumber) UnmarshalJSON(data []byte) error {
+	if len(data) != 0 && data[0] == '{' {
+		type plain restNumber
+		return json.Unmarshal(data, (*plain)(n))
+	}
+	if string(data) == "null" {
+		return nil
+	}
+	n.Set = true
+	return json.Unmarshal(data, &n.Number)
+}
+
+func setNumber(number int64) *restNumber {
+	return &restNumber{Set: true, Number: number}
+}
+
+// slurmrestd中的状态字段, 新版本为数组, 老版本为字符串
+type restStrings []string
+
+func (s *restStrings) UnmarshalJSON(data []byte) error {
+	if len(data) != 0 && data[0] == '"' {
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		*s = []string{value}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(s))
+}
+
+func (s restStrings) first() string {
+	if len(s) == 0 {
+		return ""
+	}
+	return s[0]
+}
+
+type restError struct {
+	Error       string `json:"error"`
+	ErrorNumber int    `json:"error_number"`
+	Description string `json:"description"`
+}
+
+type restResponse struct {
+	Errors []restError `json:"errors"`
+}
+
+// slurmrestd转发的slurm错误, 如参数校验失败, 区别于访问slurmrestd本身失败
+type restSlurmError struct {
+	ErrorNumber int
+	Message     string
+}
+
+func (e *restSlurmError) Error() string {
+	return e.Message
+}
+
+// slurmrestd的token, 配置了jwt key时为指定用户签发token
+func (r *RestBackend) getToken(user string) (string, error) {
+	if r.jwtKey != nil {
+		return signJwt(r.jwtKey, user, r.tokenLifespan)
+	}
+	if r.tokenFile != "" {
+		token, err := os.ReadFile(r.tokenFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(token)), nil
+	}
+	return r.token, nil
+}
+
+// 按slurm auth/jwt插件的格式签发HS256的token
+func signJwt(key []byte, user string, lifespan int64) (string, error) {
+	now := time.Now().Unix()
+	header, _ := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	payload, err := json.Marshal(map[string]interface{}{"iat": now, "exp": now + lifespan, "sun": user})
+	if err != nil {
+		return "", err
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// 发送请求, user不为空时以该用户的身份访问slurmrestd
+func (r *RestBackend) request(method string, path string, query url.Values, body interface{}, user string, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	requestUrl := r.baseUrl + path
+	if len(query) != 0 {
+		requestUrl += "?" + query.Encode()
+	}
+	req, err := http.NewRequest(method, requestUrl, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if user == "" {
+		user = r.user
+	}
+	token, err := r.getToken(user)
+	if err != nil {
+		return err
+	}
+	if user != "" {
+		req.Header.Set("X-SLURM-USER-NAME", user)
+	}
+	if token != "" {
+		req.Header.Set("X-SLURM-USER-TOKEN", token)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	var errResp restResponse
+	json.Unmarshal(data, &errResp)
+	if resp.StatusCode >= 300 || len(errResp.Errors) != 0 {
+		message := fmt.Sprintf("slurmrestd %s %s returned %d", method, path, resp.StatusCode)
+		if len(errResp.Errors) != 0 {
+			restErr := errResp.Errors[0]
+			message = restErr.Description
+			if message == "" {
+				message = restErr.Error
+			}
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("%w: %s", ErrNotFound, message)
+		}
+		// 认证失败时slurmrestd也可能返回错误信息, 但不是slurm的错误
+		authFailed := resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden
+		if len(errResp.Errors) != 0 && errResp.Errors[0].ErrorNumber != 0 && !authFailed {
+			return &restSlurmError{ErrorNumber: errResp.Errors[0].ErrorNumber, Message: message}
+		}
+		return fmt.Errorf("%s", message)
+	}
+	if result != nil {
+		return json.Unmarshal(data, result)
+	}
+	return nil
+}
+
+func (r *RestBackend) slurmPath(format string, args ...interface{}) string {
+	return fmt.Sprintf("/slurm/%s/", r.apiVersion) + fmt.Sprintf(format, args...)
+}
+
+func (r *RestBackend) slurmdbPath(format string, args ...interface{}) string {
+	return fmt.Sprintf("/slurmdb/%s/", r.apiVersion) + fmt.Sprintf(format, args...)
+}
+
+type restPartition struct {
+	Name  string `json:"name"`
+	Nodes struct {
+		Configured string `json:"configured"`
+		Total      int    `json:"total"`
+	} `json:"nodes"`
+	Cpus struct {
+		Total int `json:"total"`
+	} `json:"cpus"`
+	Accounts struct {
+		Allowed string `json:"allowed"`
+	} `json:"accounts"`
+	Qos struct {
+		Allowed string `json:"allowed"`
+	} `json:"qos"`
+	Partition struct {
+		State restStrings `json:"state"`
+	} `json:"partition"`
+	Tres struct {
+		Configured string `json:"configured"`
+	} `json:"tres"`
+}
+
+func (p *restPartition) toPartition() (*Partition, error) {
+	partition := &Partition{
+		Name:          p.Name,
+		State:         p.Partition.State.first(),
+		TotalCpus:     p.Cpus.Total,
+		TotalNodes:    p.Nodes.Total,
+		Nodes:         p.Nodes.Configured,
+		AllowAccounts: p.Accounts.Allowed,
+		AllowQos:      p.Qos.Allowed,
+	}
+	// slurmrestd中没有限制时为空字符串, 与scontrol的ALL保持一致
+	if partition.AllowAccounts == "" {
+		partition.AllowAccounts = "ALL"
+	}
+	if partition.AllowQos == "" {
+		partition.AllowQos = "ALL"
+	}
+	if mem, ok := utils.ParseTres(p.Tres.Configured)["mem"]; ok {
+		memMb, err := utils.ConvertMemory(mem)
+		if err != nil {
+			return nil, err
+		}
+		partition.MemMb = memMb
+	}
+	return partition, nil
+}
+
+func (r *RestBackend) ListPartitions() ([]*Partition, error) {
+	var resp struct {
+		Partitions []restPartition `json:"partitions"`
+	}
+	if err := r.request(http.MethodGet, r.slurmPath("partitions"), nil, nil, "", &resp); err != nil {
+		return nil, err
+	}
+	var partitions []*Partition
+	for i := range resp.Partitions {
+		partition, err := resp.Partitions[i].toPartition()
+		if err != nil {
+			return nil, err
+		}
+		partitions = append(partitions, partition)
+	}
+	return partitions, nil
+}
+
+func (r *RestBackend) GetPartition(name string) (*Partition, error) {
+	var resp struct {
+		Partitions []restPartition `json:"partitions"`
+	}
+	if err := r.request(http.MethodGet, r.slurmPath("partition/%s", url.PathEscape(name)), nil, nil, "", &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Partitions) == 0 {
+		return nil, ErrNotFound
+	}
+	return resp.Partitions[0].toPartition()
+}
+
+// slurmrestd没有修改分区的接口
+func (r *RestBackend) UpdatePartitionAllowAccounts(partition string, accounts string) error {
+	return fmt.Errorf("%w: update partition %s", ErrNotSupported, partition)
+}
+
+// 根据分区中节点的状态统计分区的资源使用情况, 统计口径与sinfo一致
+func (r *RestBackend) GetPartitionStatus(partition string) (*PartitionStatus, error) {
+	partitionInfo, err := r.GetPartition(partition)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := r.ListNodes()
+	if err != nil {
+		return nil, err
+	}
+	partitionStatus := &PartitionStatus{Name: partition, Available: partitionInfo.State == "UP"}
+	for _, node := range nodes {
+		inPartition := false
+		for _, p := range node.Partitions {
+			if p == partition {
+				inPartition = true
+				break
+			}
+		}
+		if !inPartition {
+			continue
+		}
+		partitionStatus.NodesTotal++
+		partitionStatus.CpusTotal += node.Cpus
+		partitionStatus.GpusTotal += node.Gpus
+		switch nodeAvailability(node.State) {
+		case "alloc":
+			partitionStatus.NodesAlloc++
+			partitionStatus.CpusAlloc += node.AllocCpus
+			partitionStatus.CpusIdle += node.Cpus - node.AllocCpus
+		case "idle":
+			partitionStatus.NodesIdle++
+			partitionStatus.CpusIdle += node.Cpus
+		default:
+			partitionStatus.NodesOther++
+			partitionStatus.CpusOther += node.Cpus
+			partitionStatus.GpusNotAvailable += node.Gpus
+		}
+	}
+	return partitionStatus, nil
+}
+
+// 将节点状态归类为alloc、idle和other三类
+func nodeAvailability(state string) string {
+	flags := strings.Split(state, "+")
+	for _, flag := range flags[1:] {
+		switch flag {
+		case "DRAIN", "DRAINING", "DRAINED", "NOT_RESPONDING", "FAIL", "INVALID_REG", "MAINTENANCE", "REBOOT_REQUESTED", "REBOOT_ISSUED", "POWERED_DOWN", "POWERING_DOWN":
+			return "other"
+		}
+	}
+	switch flags[0] {
+	case "ALLOCATED", "MIXED", "COMPLETING":
+		return "alloc"
+	case "IDLE":
+		return "idle"
+	default:
+		return "other"
+	}
+}
+
+type restNode struct {
+	Name        string      `json:"name"`
+	Partitions  []string    `json:"partitions"`
+	State       restStrings `json:"state"`
+	Cpus        int         `json:"cpus"`
+	AllocCpus   int         `json:"alloc_cpus"`
+	RealMemory  int         `json:"real_memory"`
+	AllocMemory int         `json:"alloc_memory"`
+	Gres        string      `json:"gres"`
+	TresUsed    string      `json:"tres_used"`
+}
+
+func (n *restNode) toNode() *Node {
+	return &Node{
+		Name:         n.Name,
+		Partitions:   n.Partitions,
+		State:        strings.Join(n.State, "+"),
+		Cpus:         n.Cpus,
+		AllocCpus:    n.AllocCpus,
+		RealMemoryMb: n.RealMemory,
+		AllocMemMb:   n.AllocMemory,
+		Gpus:         utils.GetGpusFromGres(n.Gres),
+		AllocGpus:    gpusFromTres(n.TresUsed),
+	}
+}
+
+// 从tres字符串中取gpu数, 支持gres/gpu=2和gres/gpu:2两种格式
+func gpusFromTres(tres string) int {
+	for _, item := range strings.Split(tres, ",") {
+		if !strings.HasPrefix(item, "gres/gpu") {
+			continue
+		}
+		fields := strings.FieldsFunc(item, func(r rune) bool { return r == '=' || r == ':' })
+		if gpus, err := strconv.Atoi(fields[len(fields)-1]); err == nil {
+			return gpus
+		}
+	}
+	return 0
+}
+
+func (r *RestBackend) ListNodes() ([]*Node, error) {
+	var resp struct {
+		Nodes []restNode `json:"nodes"`
+	}
+	if err := r.request(http.MethodGet, r.slurmPath("nodes"), nil, nil, "", &resp); err != nil {
+		return nil, err
+	}
+	var nodes []*Node
+	for i := range resp.Nodes {
+		nodes = append(nodes, resp.Nodes[i].toNode())
+	}
+	return nodes, nil
+}
+
+func (r *RestBackend) GetNode(name string) (*Node, error) {
+	var resp struct {
+		Nodes []restNode `json:"nodes"`
+	}
+	if err := r.request(http.MethodGet, r.slurmPath("node/%s", url.PathEscape(name)), nil, nil, "", &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Nodes) == 0 {
+		return nil, ErrNotFound
+	}
+	return resp.Nodes[0].toNode(), nil
+}
+
+type restQueueJob struct {
+	JobId                   uint32      `json:"job_id"`
+	Name                    string      `json:"name"`
+	Account                 string      `json:"account"`
+	UserName                string      `json:"user_name"`
+	Partition               string      `json:"partition"`
+	Qos                     string      `json:"qos"`
+	JobState                restStrings `json:"job_state"`
+	StateReason             string      `json:"state_reason"`
+	Cpus                    restNumber  `json:"cpus"`
+	NodeCount               restNumber  `json:"node_count"`
+	TimeLimit               restNumber  `json:"time_limit"`
+	SubmitTime              restNumber  `json:"submit_time"`
+	StartTime               restNumber  `json:"start_time"`
+	CurrentWorkingDirectory string      `json:"current_working_directory"`
+	Nodes                   string      `json:"nodes"`
+	TresPerNode             string      `json:"tres_per_node"`
+	ArrayJobId              restNumber  `json:"array_job_id"`
+	ArrayTaskId             restNumber  `json:"array_task_id"`
+	ArrayTaskString         string      `json:"array_task_string"`
+	StandardOutput          string      `json:"standard_output"`
+	StandardError           string      `json:"standard_error"`
+	Dependency              string      `json:"dependency"`
+	HetJobId                restNumber  `json:"het_job_id"`
+	HetJobOffset            restNumber  `json:"het_job_offset"`
+}
+
+func (j *restQueueJob) toQueueJob() *QueueJob {
+	job := &QueueJob{
+		JobId:            j.JobId,
+		Name:             j.Name,
+		Account:          j.Account,
+		User:             j.UserName,
+		Partition:        j.Partition,
+		Qos:              j.Qos,
+		State:            j.JobState.first(),
+		Cpus:             int32(j.Cpus.Number),
+		Nodes:            int32(j.NodeCount.Number),
+		SubmitTime:       j.SubmitTime.Number,
+		WorkingDirectory: j.CurrentWorkingDirectory,
+		NodeList:         j.Nodes,
+		GpusPerNode:      int32(gpusFromTres(j.TresPerNode)),
+		Dependency:       j.Dependency,
+	}
+	if j.StateReason != "None" {
+		job.Reason = j.StateReason
+	}
+	if j.HetJobId.Number != 0 && j.HetJobOffset.Set {
+		job.HetJobId = uint32(j.HetJobId.Number)
+		job.HetJobOffset = uint32(j.HetJobOffset.Number)
+	}
+	switch {
+	case j.TimeLimit.Infinite:
+		job.TimeLimitMinutes = 0
+	case !j.TimeLimit.Set:
+		job.TimeLimitMinutes = -1
+	default:
+		job.TimeLimitMinutes = j.TimeLimit.Number
+	}
+	if job.State == "RUNNING" && j.StartTime.Number != 0 {
+		job.ElapsedSeconds = time.Now().Unix() - j.StartTime.Number
+	}
+	// 排队作业的start_time是调度器估计的开始时间
+	if job.State == "PENDING" {
+		job.ScheduledStartTime = j.StartTime.Number
+	}
+	// 还未拆分的排队任务没有task id, 下标在array_task_string中
+	if j.ArrayJobId.Number != 0 {
+		job.ArrayJobId = uint32(j.ArrayJobId.Number)
+		if j.ArrayTaskId.Set && !j.ArrayTaskId.Infinite {
+			job.ArrayTaskId = strconv.FormatInt(j.ArrayTaskId.Number, 10)
+		} else {
+			job.ArrayTaskId = j.ArrayTaskString
+		}
+	}
+	return job
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// 作业是否符合过滤条件, nil表示不过滤
+func (f *QueueFilter) Match(job *QueueJob) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.Users) != 0 && !containsString(f.Users, job.User) {
+		return false
+	}
+	if len(f.Accounts) != 0 && !containsString(f.Accounts, job.Account) {
+		return false
+	}
+	if len(f.States) != 0 {
+		matched := false
+		for _, state := range f.States {
+			if strings.EqualFold(state, job.State) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(f.Partitions) != 0 && !containsString(f.Partitions, job.Partition) {
+		return false
+	}
+	// 和squeue -j一样, 作业数组id匹配数组的全部任务
+	if len(f.JobIds) != 0 {
+		matched := false
+		for _, jobId := range f.JobIds {
+			if jobId == job.JobId || jobId == job.ArrayJobId {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if f.JobName != nil && *f.JobName != job.Name {
+		return false
+	}
+	if f.ArrayJobId != nil && *f.ArrayJobId != job.ArrayJobId {
+		return false
+	}
+	return true
+}
+
+func (r *RestBackend) ListQueueJobs(filter *QueueFilter) ([]*QueueJob, error) {
+	var resp struct {
+		Jobs []restQueueJob `json:"jobs"`
+	}
+	if err := r.request(http.MethodGet, r.slurmPath("jobs"), nil, nil, "", &resp); err != nil {
+		return nil, err
+	}
+	var jobs []*QueueJob
+	for i := range resp.Jobs {
+		job := resp.Jobs[i].toQueueJob()
+		if filter.Match(job) {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+// 提交作业时的作业描述
+type restJobDesc struct {
+	Account                 string      `json:"account,omitempty"`
+	Partition               string      `json:"partition,omitempty"`
+	Qos                     string      `json:"qos,omitempty"`
+	Name                    string      `json:"name,omitempty"`
+	MinimumNodes            int         `json:"minimum_nodes,omitempty"`
+	CpusPerTask             int         `json:"cpus_per_task,omitempty"`
+	TimeLimit               *restNumber `json:"time_limit,omitempty"`
+	CurrentWorkingDirectory string      `json:"current_working_directory,omitempty"`
+	StandardOutput          string      `json:"standard_output,omitempty"`
+	StandardError           string      `json:"standard_error,omitempty"`
+	TresPerNode             string      `json:"tres_per_node,omitempty"`
+	MemoryPerNode           *restNumber `json:"memory_per_node,omitempty"`
+	Array                   string      `json:"array,omitempty"`
+	Dependency              string      `json:"dependency,omitempty"`
+	Environment             []string    `json:"environment"`
+}
+
+// slurmrestd不解析脚本中的#SBATCH选项, 需要转换成作业描述, 不认识的选项返回ErrNotSupported
+func parseSbatchOptions(script string) (*restJobDesc, error) {
+	desc := &restJobDesc{Environment: []string{"PATH=/bin:/usr/bin:/usr/local/bin"}}
+	for _, line := range strings.Split(script, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#!") {
+			continue
+		}
+		// 和sbatch一样, 遇到第一条命令后不再解析#SBATCH选项
+		if !strings.HasPrefix(line, "#") {
+			break
+		}
+		option, ok := strings.CutPrefix(line, "#SBATCH")
+		if !ok {
+			continue
+		}
+		option = strings.TrimSpace(option)
+		var key, value string
+		if strings.HasPrefix(option, "--") {
+			index := strings.IndexAny(option, "= ")
+			if index == -1 {
+				key = option
+			} else {
+				key, value = option[:index], option[index+1:]
+			}
+		} else if len(option) >= 2 {
+			key, value = option[:2], option[2:]
+		} else {
+			key = option
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		if err := desc.setOption(key, value); err != nil {
+			return nil, err
+		}
+	}
+	return desc, nil
+}
+
+func (d *restJobDesc) setOption(key string, value string) error {
+	var err error
+	switch key {
+	case "-A", "--account":
+		d.Account = value
+	case "-p", "--partition":
+		d.Partition = value
+	case "-q", "--qos":
+		d.Qos = value
+	case "-J", "--job-name":
+		d.Name = value
+	case "-N", "--nodes":
+		d.MinimumNodes, err = strconv.Atoi(value)
+	case "-c", "--cpus-per-task":
+		d.CpusPerTask, err = strconv.Atoi(value)
+	case "-t", "--time":
+		var minutes int64
+		minutes, err = parseTimeOption(value)
+		d.TimeLimit = setNumber(minutes)
+	case "-D", "--chdir":
+		d.CurrentWorkingDirectory = value
+	case "-o", "--output":
+		d.StandardOutput = value
+	case "-e", "--error":
+		d.StandardError = value
+	case "--gres":
+		d.TresPerNode = "gres/" + value
+	case "--mem":
+		var memMb int
+		memMb, err = parseMemoryOption(value)
+		d.MemoryPerNode = setNumber(int64(memMb))
+	case "-a", "--array":
+		d.Array = value
+	case "-d", "--dependency":
+		d.Dependency = value
+	default:
+		return fmt.Errorf("%w: #SBATCH %s", ErrNotSupported, key)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid #SBATCH %s %s: %v", key, value, err)
+	}
+	return nil
+}
+
+// 解析--time的值, 支持分钟数、MM:SS、HH:MM:SS和D-HH:MM:SS
+func parseTimeOption(value string) (int64, error) {
+	if !strings.Contains(value, ":") {
+		if days, hours, ok := strings.Cut(value, "-"); ok {
+			d, err := strconv.Atoi(days)
+			if err != nil {
+				return 0, err
+			}
+			h, err := strconv.Atoi(hours)
+			if err != nil {
+				return 0, err
+			}
+			return int64(d*24*60 + h*60), nil
+		}
+		minutes, err := strconv.Atoi(value)
+		return int64(minutes), err
+	}
+	return utils.GetTimeLimit(value), nil
+}
+
+// 解析--mem的值, 没有单位时为MB
+func parseMemoryOption(value string) (int, error) {
+	value = strings.ToUpper(strings.TrimSuffix(strings.ToUpper(value), "B"))
+	if _, err := strconv.Atoi(value); err == nil {
+		value += "M"
+	}
+	return utils.ConvertMemory(value)
+}
+
+func (r *RestBackend) SubmitJob(user string, script string) (uint32, error) {
+	desc, err := parseSbatchOptions(script)
+	if err != nil {
+		return 0, err
+	}
+	body := map[string]interface{}{"script": script, "job": desc}
+	var resp struct {
+		JobId uint32 `json:"job_id"`
+	}
+	if err := r.request(http.MethodPost, r.slurmPath("job/submit"), nil, body, user, &resp); err != nil {
+		return 0, err
+	}
+	return resp.JobId, nil
+}
+
+// 作业或者作业数组中一个任务的路径
+func (r *RestBackend) restJobPath(jobId uint32, arrayTaskId *uint32) string {
+	if arrayTaskId != nil {
+		return r.slurmPath("job/%d_%d", jobId, *arrayTaskId)
+	}
+	return r.slurmPath("job/%d", jobId)
+}
+
+// slurmrestd没有--test-only对应的接口
+func (r *RestBackend) TestSubmitJob(user string, script string) (*SubmitEstimate, error) {
+	return nil, fmt.Errorf("%w: sbatch --test-only", ErrNotSupported)
+}
+
+func (r *RestBackend) CancelJob(user string, jobId uint32, arrayTaskId *uint32) error {
+	return r.request(http.MethodDelete, r.restJobPath(jobId, arrayTaskId), nil, nil, user, nil)
+}
+
+// slurmrestd一次只能取消一个作业
+func (r *RestBackend) CancelJobs(user string, jobSpecs []string) (map[string]error, error) {
+	results := make(map[string]error)
+	for _, jobSpec := range jobSpecs {
+		if err := r.request(http.MethodDelete, r.slurmPath("job/%s", url.PathEscape(jobSpec)), nil, nil, user, nil); err != nil {
+			results[jobSpec] = err
+		}
+	}
+	return results, nil
+}
+
+// slurmrestd只能通过修改作业的hold属性来hold和release, 没有suspend、resume和requeue的接口
+func (r *RestBackend) ControlJob(user string, jobId uint32, arrayTaskId *uint32, action JobAction) error {
+	var hold bool
+	switch action {
+	case JobActionHold:
+		hold = true
+	case JobActionRelease:
+		hold = false
+	default:
+		return fmt.Errorf("%w: %s job", ErrNotSupported, action)
+	}
+	return r.request(http.MethodPost, r.restJobPath(jobId, arrayTaskId), nil, map[string]interface{}{"hold": hold}, user, nil)
+}
+
+func (r *RestBackend) SetJobAdminComment(jobId uint32, arrayTaskId *uint32, comment string) error {
+	return r.request(http.MethodPost, r.restJobPath(jobId, arrayTaskId), nil, map[string]interface{}{"admin_comment": comment}, "", nil)
+}
+
+func (r *RestBackend) ChangeJobTimeLimit(jobId uint32, deltaMinutes int64) error {
+	var resp struct {
+		Jobs []restQueueJob `json:"jobs"`
+	}
+	if err := r.request(http.MethodGet, r.slurmPath("job/%d", jobId), nil, nil, "", &resp); err != nil {
+		return err
+	}
+	if len(resp.Jobs) == 0 {
+		return ErrNotFound
+	}
+	timeLimit := resp.Jobs[0].TimeLimit
+	if timeLimit.Infinite || !timeLimit.Set {
+		return fmt.Errorf("job %d has no time limit to change", jobId)
+	}
+	body := map[string]interface{}{"time_limit": setNumber(timeLimit.Number + deltaMinutes)}
+	return r.request(http.MethodPost, r.slurmPath("job/%d", jobId), nil, body, "", nil)
+}
+
+// slurmrestd作业描述中对应的字段, 和JobUpdate.Fields中的字段对应
+func jobUpdateBodies(update *JobUpdate) map[string]map[string]interface{} {
+	bodies := make(map[string]map[string]interface{})
+	if update.Partition != nil {
+		bodies[JobUpdatePartition] = map[string]interface{}{"partition": *update.Partition}
+	}
+	if update.Qos != nil {
+		bodies[JobUpdateQos] = map[string]interface{}{"qos": *update.Qos}
+	}
+	if update.NodeCount != nil {
+		bodies[JobUpdateNodeCount] = map[string]interface{}{"minimum_nodes": *update.NodeCount, "maximum_nodes": *update.NodeCount}
+	}
+	if update.Cpus != nil {
+		bodies[JobUpdateCpus] = map[string]interface{}{"minimum_cpus": *update.Cpus}
+	}
+	if update.Gpus != nil {
+		bodies[JobUpdateGpus] = map[string]interface{}{"tres_per_node": fmt.Sprintf("gres/gpu:%d", *update.Gpus)}
+	}
+	if update.MemMb != nil {
+		bodies[JobUpdateMemMb] = map[string]interface{}{"memory_per_node": setNumber(*update.MemMb)}
+	}
+	if update.Name != nil {
+		bodies[JobUpdateName] = map[string]interface{}{"name": *update.Name}
+	}
+	if update.Comment != nil {
+		bodies[JobUpdateComment] = map[string]interface{}{"comment": *update.Comment}
+	}
+	return bodies
+}
+
+// 和cli后端一样逐个字段修改, 以便知道slurm拒绝了哪些字段
+func (r *RestBackend) UpdateJob(jobId uint32, update *JobUpdate) (map[string]error, error) {
+	bodies := jobUpdateBodies(update)
+	results := make(map[string]error)
+	for _, field := range update.Fields() {
+		err := r.request(http.MethodPost, r.slurmPath("job/%d", jobId), nil, bodies[field], "", nil)
+		if err == nil {
+			continue
+		}
+		// 只有slurm拒绝的字段放在结果中, 访问slurmrestd失败时直接返回错误
+		var slurmErr *restSlurmError
+		if !errors.As(err, &slurmErr) {
+			return nil, err
+		}
+		if strings.Contains(slurmErr.Message, "Invalid job id") {
+			return nil, ErrNotFound
+		}
+		results[field] = err
+	}
+	return results, nil
+}
+
+// slurmrestd只返回作业的总优先级, 没有sprio中的各个因子
+func (r *RestBackend) ListJobPriorities(partitions []string) ([]*JobPriority, error) {
+	return nil, fmt.Errorf("%w: sprio", ErrNotSupported)
+}
+
+func (r *RestBackend) GetJobOutput(jobId uint32) (*JobOutput, error) {
+	var resp struct {
+		Jobs []restQueueJob `json:"jobs"`
+	}
+	err := r.request(http.MethodGet, r.slurmPath("job/%d", jobId), nil, nil, "", &resp)
+	if err != nil && !errors.Is(err, ErrNotFound) && !strings.Contains(err.Error(), "Invalid job id") {
+		return nil, err
+	}
+	for i := range resp.Jobs {
+		if resp.Jobs[i].JobId == jobId {
+			return resp.Jobs[i].toJobOutput(), nil
+		}
+	}
+	// 作业已经不在slurmctld中
+	job, err := r.GetJob(jobId)
+	if err != nil {
+		return nil, err
+	}
+	return jobOutputFromJob(job), nil
+}
+
+func (r *RestBackend) ListJobOutputs() (map[uint32]*JobOutput, error) {
+	var resp struct {
+		Jobs []restQueueJob `json:"jobs"`
+	}
+	if err := r.request(http.MethodGet, r.slurmPath("jobs"), nil, nil, "", &resp); err != nil {
+		return nil, err
+	}
+	jobOutputs := make(map[uint32]*JobOutput, len(resp.Jobs))
+	for i := range resp.Jobs {
+		jobOutputs[resp.Jobs[i].JobId] = resp.Jobs[i].toJobOutput()
+	}
+	return jobOutputs, nil
+}
+
+// slurmrestd返回的是未展开的文件名模式, 没有指定--error时和标准输出相同
+func (j *restQueueJob) toJobOutput() *JobOutput {
+	job := j.toQueueJob()
+	vars := &outputPathVars{
+		jobId:       job.JobId,
+		arrayJobId:  job.ArrayJobId,
+		arrayTaskId: job.ArrayTaskId,
+		user:        job.User,
+		name:        job.Name,
+		workDir:     job.WorkingDirectory,
+	}
+	stdoutPattern := j.StandardOutput
+	if stdoutPattern == "" {
+		stdoutPattern = defaultOutputPattern(job.ArrayJobId)
+	}
+	stderrPattern := j.StandardError
+	if stderrPattern == "" {
+		stderrPattern = stdoutPattern
+	}
+	return &JobOutput{
+		User:       job.User,
+		StdoutPath: expandOutputPath(stdoutPattern, vars),
+		StderrPath: expandOutputPath(stderrPattern, vars),
+	}
+}
+
+// 作业的退出状态
+type restExitCode struct {
+	ReturnCode restNumber `json:"return_code"`
+	Signal     struct {
+		Id restNumber `json:"id"`
+	} `json:"signal"`
+}
+
+type restTres struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+type restDbJob struct {
+	JobId     uint32 `json:"job_id"`
+	Name      string `json:"name"`
+	Account   string `json:"account"`
+	User      string `json:"user"`
+	Partition string `json:"partition"`
+	Qos       string `json:"qos"`
+	State     struct {
+		Current restStrings `json:"current"`
+	} `json:"state"`
+	Time struct {
+		Submission int64      `json:"submission"`
+		Start      int64      `json:"start"`
+		End        int64      `json:"end"`
+		Limit      restNumber `json:"limit"`
+	} `json:"time"`
+	Required struct {
+		CPUs          int32      `json:"CPUs"`
+		MemoryPerNode restNumber `json:"memory_per_node"`
+		MemoryPerCpu  restNumber `json:"memory_per_cpu"`
+	} `json:"required"`
+	Tres struct {
+		Allocated []restTres `json:"allocated"`
+		Requested []restTres `json:"requested"`
+	} `json:"tres"`
+	WorkingDirectory string `json:"working_directory"`
+	Nodes            string `json:"nodes"`
+	AllocationNodes  int32  `json:"allocation_nodes"`
+	Array            struct {
+		JobId  uint32     `json:"job_id"`
+		TaskId restNumber `json:"task_id"`
+		Task   string     `json:"task"`
+	} `json:"array"`
+	Het struct {
+		JobId     uint32     `json:"job_id"`
+		JobOffset restNumber `json:"job_offset"`
+	} `json:"het"`
+	ExitCode        restExitCode `json:"exit_code"`
+	DerivedExitCode restExitCode `json:"derived_exit_code"`
+	Stdout          string       `json:"stdout"`
+	Stderr          string       `json:"stderr"`
+	Steps           []restStep   `json:"steps"`
+}
+
+func tresCount(tres []restTres, tresType string, name string) int64 {
+	for _, t := range tres {
+		if t.Type == tresType && t.Name == name {
+			return t.Count
+		}
+	}
+	return 0
+}
+
+// slurmdbd中的作业状态转换为适配器使用的状态
+func normalizeJobState(state string) string {
+	if state == "CANCELLED" {
+		return "CANCELED"
+	}
+	return utils.ChangeState(utils.GetStateId(state))
+}
+
+func (j *restDbJob) toJob() *Job {
+	job := &Job{
+		JobId:            j.JobId,
+		Name:             j.Name,
+		Account:          j.Account,
+		User:             j.User,
+		Partition:        j.Partition,
+		Qos:              j.Qos,
+		State:            normalizeJobState(j.State.Current.first()),
+		CpusReq:          j.Required.CPUs,
+		TimeLimitMinutes: j.Time.Limit.Number,
+		SubmitTime:       j.Time.Submission,
+		StartTime:        j.Time.Start,
+		EndTime:          j.Time.End,
+		WorkingDirectory: j.WorkingDirectory,
+		NodeList:         j.Nodes,
+		NodesAlloc:       j.AllocationNodes,
+		NodesReq:         int32(tresCount(j.Tres.Requested, "node", "")),
+		CpusAlloc:        int32(tresCount(j.Tres.Allocated, "cpu", "")),
+		MemAllocMb:       tresCount(j.Tres.Allocated, "mem", ""),
+		GpusAlloc:        int32(tresCount(j.Tres.Allocated, "gres", "gpu")),
+	}
+	if j.Required.MemoryPerNode.Set {
+		job.MemReqMb = j.Required.MemoryPerNode.Number
+	} else if j.Required.MemoryPerCpu.Set {
+		job.MemReqMb = j.Required.MemoryPerCpu.Number * int64(j.Required.CPUs)
+	}
+	if job.NodesReq == 0 {
+		job.NodesReq = job.NodesAlloc
+	}
+	if j.Array.JobId != 0 {
+		job.ArrayJobId = j.Array.JobId
+		if j.Array.TaskId.Set && !j.Array.TaskId.Infinite {
+			job.ArrayTaskId = strconv.FormatInt(j.Array.TaskId.Number, 10)
+		} else {
+			job.ArrayTaskId = j.Array.Task
+		}
+	}
+	if j.Het.JobId != 0 && j.Het.JobOffset.Set {
+		job.HetJobId = j.Het.JobId
+		job.HetJobOffset = uint32(j.Het.JobOffset.Number)
+	}
+	job.ExitCode = int32(j.ExitCode.ReturnCode.Number)
+	job.ExitSignal = int32(j.ExitCode.Signal.Id.Number)
+	job.DerivedExitCode = int32(j.DerivedExitCode.ReturnCode.Number)
+	job.DerivedExitSignal = int32(j.DerivedExitCode.Signal.Id.Number)
+	setJobOutputPaths(job, j.Stdout, j.Stderr)
+	return job
+}
+
+func (r *RestBackend) GetJob(jobId uint32) (*Job, error) {
+	var resp struct {
+		Jobs []restDbJob `json:"jobs"`
+	}
+	if err := r.request(http.MethodGet, r.slurmdbPath("job/%d", jobId), nil, nil, "", &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Jobs) == 0 {
+		return nil, ErrNotFound
+	}
+	// 异构作业会返回全部组件
+	var jobs []*Job
+	for i := range resp.Jobs {
+		jobs = append(jobs, resp.Jobs[i].toJob())
+	}
+	for _, job := range MergeHetJobs(jobs) {
+		if job.JobId == jobId {
+			return job, nil
+		}
+	}
+	return jobs[0], nil
+}
+
+type restStep struct {
+	Step struct {
+		Id   string `json:"id"` // 123.batch这样的格式
+		Name string `json:"name"`
+	} `json:"step"`
+	State restStrings `json:"state"`
+	Nodes struct {
+		Count int32  `json:"count"`
+		Range string `json:"range"`
+	} `json:"nodes"`
+	Tasks struct {
+		Count int32 `json:"count"`
+	} `json:"tasks"`
+	Time struct {
+		Start   restNumber `json:"start"`
+		End     restNumber `json:"end"`
+		Elapsed int64      `json:"elapsed"`
+		User    struct {
+			Seconds      int64 `json:"seconds"`
+			Microseconds int64 `json:"microseconds"`
+		} `json:"user"`
+		System struct {
+			Seconds      int64 `json:"seconds"`
+			Microseconds int64 `json:"microseconds"`
+		} `json:"system"`
+	} `json:"time"`
+	ExitCode restExitCode `json:"exit_code"`
+	Tres     struct {
+		Allocated []restTres `json:"allocated"`
+		Requested struct {
+			Max     []restTres `json:"max"`
+			Average []restTres `json:"average"`
+			Total   []restTres `json:"total"`
+		} `json:"requested"`
+	} `json:"tres"`
+}
+
+// tres列表转换为以tres名称为key
+func restTresByName(tres []restTres) map[string]uint64 {
+	m := make(map[string]uint64, len(tres))
+	for _, t := range tres {
+		name := t.Type
+		if t.Name != "" {
+			name += "/" + t.Name
+		}
+		m[name] = uint64(t.Count)
+	}
+	return m
+}
+
+func (s *restStep) toJobStep() *JobStep {
+	stepId := s.Step.Id
+	if index := strings.LastIndex(stepId, "."); index != -1 {
+		stepId = stepId[index+1:]
+	}
+	return &JobStep{
+		StepId:         stepId,
+		Name:           s.Step.Name,
+		State:          normalizeJobState(s.State.first()),
+		NodeList:       s.Nodes.Range,
+		Nodes:          s.Nodes.Count,
+		Tasks:          s.Tasks.Count,
+		StartTime:      s.Time.Start.Number,
+		EndTime:        s.Time.End.Number,
+		ElapsedSeconds: s.Time.Elapsed,
+		ExitCode:       int32(s.ExitCode.ReturnCode.Number),
+		ExitSignal:     int32(s.ExitCode.Signal.Id.Number),
+		TresAlloc:      restTresByName(s.Tres.Allocated),
+		TresUsageInMax: restTresByName(s.Tres.Requested.Max),
+		TresUsageInAve: restTresByName(s.Tres.Requested.Average),
+		TresUsageInTot: restTresByName(s.Tres.Requested.Total),
+		CpuTimeSeconds: s.Time.User.Seconds + s.Time.System.Seconds + (s.Time.User.Microseconds+s.Time.System.Microseconds)/1000000,
+	}
+}
+
+// slurmrestd没有sstat对应的接口, 运行中的作业步只有slurmdbd中的数据
+func (r *RestBackend) ListJobSteps(jobId uint32) ([]*JobStep, error) {
+	var resp struct {
+		Jobs []restDbJob `json:"jobs"`
+	}
+	if err := r.request(http.MethodGet, r.slurmdbPath("job/%d", jobId), nil, nil, "", &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Jobs) == 0 {
+		return nil, ErrNotFound
+	}
+	var steps []*JobStep
+	for i := range resp.Jobs[0].Steps {
+		steps = append(steps, resp.Jobs[0].Steps[i].toJobStep())
+	}
+	return steps, nil
+}
+
+// slurmdbd的查询条件有限, 用户和账户在服务端过滤, 其余条件在本地处理
+func (r *RestBackend) queryDbJobs(query *JobQuery) ([]*Job, []*restDbJob, error) {
+	params := url.Values{}
+	if len(query.Users) != 0 {
+		params.Set("users", strings.Join(query.Users, ","))
+	}
+	if len(query.Accounts) != 0 {
+		params.Set("account", strings.Join(query.Accounts, ","))
+	}
+	// 不指定开始时间时slurmdbd只返回当天的作业
+	startTime := query.SubmitTimeStart
+	if startTime == 0 {
+		startTime = max(query.RunTimeStart, 1)
+	}
+	params.Set("start_time", strconv.FormatInt(startTime, 10))
+	var resp struct {
+		Jobs []restDbJob `json:"jobs"`
+	}
+	if err := r.request(http.MethodGet, r.slurmdbPath("jobs"), params, nil, "", &resp); err != nil {
+		return nil, nil, err
+	}
+	var (
+		jobs   []*Job
+		dbJobs []*restDbJob
+	)
+	for i := range resp.Jobs {
+		job := resp.Jobs[i].toJob()
+		if query.Match(job) {
+			jobs = append(jobs, job)
+			dbJobs = append(dbJobs, &resp.Jobs[i])
+		}
+	}
+	return jobs, dbJobs, nil
+}
+
+// 排序和分页在本地处理
+func (r *RestBackend) QueryJobs(query *JobQuery) ([]*Job, uint32, error) {
+	jobs, _, err := r.queryDbJobs(query)
+	if err != nil {
+		return nil, 0, err
+	}
+	// slurmrestd返回全部作业, 排序和分页都在内存中完成
+	return PageJobsInMemory(MergeHetJobs(jobs), query, query.ElapsedNow())
+}
+
+// slurmrestd不能汇总, 查询全部作业后在内存中统计
+func (r *RestBackend) QueryUsage(query *JobQuery, opts *UsageOptions, now int64) ([]*UsageSummary, error) {
+	jobs, _, err := r.QueryJobs(query)
+	if err != nil {
+		return nil, err
+	}
+	return SummarizeUsage(jobs, opts, now), nil
+}
+
+type restName struct {
+	Name string `json:"name"`
+}
+
+func (r *RestBackend) QueryJobSteps(query *JobQuery) (map[uint32][]*JobStep, error) {
+	_, dbJobs, err := r.queryDbJobs(query)
+	if err != nil {
+		return nil, err
+	}
+	steps := make(map[uint32][]*JobStep)
+	for _, dbJob := range dbJobs {
+		for i := range dbJob.Steps {
+			steps[dbJob.JobId] = append(steps[dbJob.JobId], dbJob.Steps[i].toJobStep())
+		}
+	}
+	return steps, nil
+}
+
+func (r *RestBackend) UserExists(user string) (bool, error) {
+	var resp struct {
+		Users []restName `json:"users"`
+	}
+	return r.exists(r.slurmdbPath("user/%s", url.PathEscape(user)), &resp, func() int { return len(resp.Users) })
+}
+
+func (r *RestBackend) AccountExists(account string) (bool, error) {
+	var resp struct {
+		Accounts []restName `json:"accounts"`
+	}
+	return r.exists(r.slurmdbPath("account/%s", url.PathEscape(account)), &resp, func() int { return len(resp.Accounts) })
+}
+
+func (r *RestBackend) exists(path string, resp interface{}, count func() int) (bool, error) {
+	err := r.request(http.MethodGet, path, nil, nil, "", resp)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return count() != 0, nil
+}
+
+func (r *RestBackend) ListAccounts() ([]string, error) {
+	var resp struct {
+		Accounts []restName `json:"accounts"`
+	}
+	if err := r.request(http.MethodGet, r.slurmdbPath("accounts"), nil, nil, "", &resp); err != nil {
+		return nil, err
+	}
+	var accounts []string
+	for _, account := range resp.Accounts {
+		accounts = append(accounts, account.Name)
+	}
+	return accounts, nil
+}
+
+func (r *RestBackend) ListQos() ([]string, error) {
+	var resp struct {
+		Qos []restName `json:"qos"`
+	}
+	if err := r.request(http.MethodGet, r.slurmdbPath("qos"), nil, nil, "", &resp); err != nil {
+		return nil, err
+	}
+	var qos []string
+	for _, q := range resp.Qos {
+		qos = append(qos, q.Name)
+	}
+	return qos, nil
+}
+
+type restAssocJobsLimit struct {
+	Submitted *restNumber `json:"submitted,omitempty"` // 对应MaxSubmitJobs
+	Count     *restNumber `json:"count,omitempty"`     // 对应MaxJobs
+}
+
+type restAssoc struct {
+	Account   string            `json:"account"`
+	User      string            `json:"user"`
+	Partition string            `json:"partition,omitempty"`
+	Cluster   string            `json:"cluster,omitempty"`
+	IsDefault *bool             `json:"is_default,omitempty"`
+	Qos       []string          `json:"qos,omitempty"`
+	Default   *restAssocDefault `json:"default,omitempty"`
+	Max       *restAssocMax     `json:"max,omitempty"`
+}
+
+type restAssocDefault struct {
+	Qos string `json:"qos,omitempty"`
+}
+
+type restAssocMax struct {
+	Jobs struct {
+		Per restAssocJobsLimit `json:"per"`
+	} `json:"jobs"`
+}
+
+// 关联关系的查询条件, 只包含本集群的关联关系, 与cli后端sacctmgr的cluster=一致
+func (r *RestBackend) associationParams(filter *AssociationFilter) url.Values {
+	params := url.Values{"cluster": {r.clusterName}}
+	if filter != nil && filter.User != "" {
+		params.Set("user", filter.User)
+	}
+	if filter != nil && filter.Account != "" {
+		params.Set("account", filter.Account)
+	}
+	return params
+}
+
+func (r *RestBackend) listRestAssociations(filter *AssociationFilter) ([]restAssoc, error) {
+	var resp struct {
+		Associations []restAssoc `json:"associations"`
+	}
+	if err := r.request(http.MethodGet, r.slurmdbPath("associations"), r.associationParams(filter), nil, "", &resp); err != nil {
+		return nil, err
+	}
+	return resp.Associations, nil
+}
+
+func (r *RestBackend) ListAssociations(filter *AssociationFilter) ([]*Association, error) {
+	restAssocs, err := r.listRestAssociations(filter)
+	if err != nil {
+		return nil, err
+	}
+	var assocs []*Association
+	for _, restAssoc := range restAssocs {
+		assoc := &Association{User: restAssoc.User, Account: restAssoc.Account, Partition: restAssoc.Partition}
+		if restAssoc.Max != nil {
+			submitted := restAssoc.Max.Jobs.Per.Submitted
+			// 未设置或者为无限制时表示没被封锁
+			if submitted != nil && submitted.Set && !submitted.Infinite {
+				value := int(submitted.Number)
+				assoc.MaxSubmitJobs = &value
+			}
+		}
+		assocs = append(assocs, assoc)
+	}
+	return assocs, nil
+}
+
+func (r *RestBackend) postAssociations(assocs []restAssoc) error {
+	body := map[string]interface{}{"associations": assocs}
+	return r.request(http.MethodPost, r.slurmdbPath("associations"), nil, body, "", nil)
+}
+
+func (r *RestBackend) CreateAccount(account string) error {
+	// 与sacctmgr一致, 描述和组织默认为账户名
+	body := map[string]interface{}{"accounts": []map[string]string{{"name": account, "description": account, "organization": account}}}
+	if err := r.request(http.MethodPost, r.slurmdbPath("accounts"), nil, body, "", nil); err != nil {
+		return err
+	}
+	return r.postAssociations([]restAssoc{{Account: account, Cluster: r.clusterName}})
+}
+
+// 删除账户在本集群的关联关系, 其他集群的不受影响, 与sacctmgr delete account cluster=一致
+func (r *RestBackend) DeleteAccount(account string) error {
+	return r.deleteAssociations(&AssociationFilter{Account: account})
+}
+
+func (r *RestBackend) deleteAssociations(filter *AssociationFilter) error {
+	// 没有条件时会删除本集群所有的关联关系
+	if filter.User == "" && filter.Account == "" {
+		return errors.New("no user or account to delete associations of")
+	}
+	return r.request(http.MethodDelete, r.slurmdbPath("associations"), r.associationParams(filter), nil, "", nil)
+}
+
+func (r *RestBackend) AddUserToAccount(user string, account string, partition string) error {
+	exists, err := r.UserExists(user)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		body := map[string]interface{}{"users": []map[string]interface{}{{"name": user, "default": map[string]string{"account": account}}}}
+		if err := r.request(http.MethodPost, r.slurmdbPath("users"), nil, body, "", nil); err != nil {
+			return err
+		}
+	}
+	return r.postAssociations([]restAssoc{{Account: account, User: user, Partition: partition, Cluster: r.clusterName}})
+}
+
+// 修改用户所有关联关系的qos
+func (r *RestBackend) SetUserQos(user string, qos []string, defaultQos string) error {
+	return r.updateUserAssociations(&AssociationFilter{User: user}, func(assoc *restAssoc) {
+		assoc.Qos = qos
+		assoc.Default = &restAssocDefault{Qos: defaultQos}
+	})
+}
+
+func (r *RestBackend) updateUserAssociations(filter *AssociationFilter, update func(assoc *restAssoc)) error {
+	restAssocs, err := r.listRestAssociations(filter)
+	if err != nil {
+		return err
+	}
+	var assocs []restAssoc
+	for _, restAssoc := range restAssocs {
+		if restAssoc.User == "" {
+			continue
+		}
+		assoc := restAssoc
+		assoc.Cluster = r.clusterName
+		assoc.Max = nil
+		assoc.Qos = nil
+		assoc.Default = nil
+		assoc.IsDefault = nil
+		update(&assoc)
+		assocs = append(assocs, assoc)
+	}
+	if len(assocs) == 0 {
+		return ErrNotFound
+	}
+	return r.postAssociations(assocs)
+}
+
+// 默认账户是每个集群分别设置的, 把用户在本集群和账户的关联关系设为默认, slurmdbd会取消其他关联关系的默认
+func (r *RestBackend) SetUserDefaultAccount(user string, account string) error {
+	isDefault := true
+	return r.updateUserAssociations(&AssociationFilter{User: user, Account: account}, func(assoc *restAssoc) {
+		assoc.IsDefault = &isDefault
+	})
+}
+
+func (r *RestBackend) RemoveUserFromAccount(user string, account string) error {
+	return r.deleteAssociations(&AssociationFilter{User: user, Account: account})
+}
+
+// 删除用户在本集群的关联关系, 与sacctmgr delete user cluster=一致
+func (r *RestBackend) DeleteUser(user string) error {
+	return r.deleteAssociations(&AssociationFilter{User: user})
+}
+
+// 通过MaxSubmitJobs和MaxJobs封锁用户, 与cli后端的判断方式一致
+func (r *RestBackend) setUserJobsLimit(user string, account string, limit *restNumber) error {
+	return r.updateUserAssociations(&AssociationFilter{User: user, Account: account}, func(assoc *restAssoc) {
+		assoc.Max = &restAssocMax{}
+		assoc.Max.Jobs.Per = restAssocJobsLimit{Submitted: limit, Count: limit}
+	})
+}
+
+func (r *RestBackend) BlockUserInAccount(user string, account string) error {
+	return r.setUserJobsLimit(user, account, setNumber(0))
+}
+
+func (r *RestBackend) UnblockUserInAccount(user string, account string) error {
+	return r.setUserJobsLimit(user, account, &restNumber{Set: true, Infinite: true})
+}
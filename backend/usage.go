@@ -0,0 +1,190 @@
+package backend
+
+import (
+	"sort"
+	"time"
+)
+
+// 资源使用量统计的时间粒度
+type UsageBucket string
+
+const (
+	UsageBucketNone  UsageBucket = ""
+	UsageBucketDay   UsageBucket = "day"
+	UsageBucketWeek  UsageBucket = "week" // 从周一开始
+	UsageBucketMonth UsageBucket = "month"
+)
+
+// 资源使用量的统计方式, 时间粒度按Location划分
+type UsageOptions struct {
+	Start            int64
+	End              int64
+	GroupByUser      bool
+	GroupByAccount   bool
+	GroupByPartition bool
+	GroupByQos       bool
+	Bucket           UsageBucket
+	Location         *time.Location
+}
+
+// 统计维度, 没有按其分组的字段为空
+type UsageKey struct {
+	User        string
+	Account     string
+	Partition   string
+	Qos         string
+	BucketStart int64 // 不按时间分组时为统计范围的开始时间
+}
+
+// 一组作业在统计范围内的资源使用量, 跨越范围边界的作业只统计范围内的部分
+type UsageSummary struct {
+	UsageKey
+	JobCount     uint32 // 在范围内运行过的作业数, 跨越多个时间段的作业在每段中都计数
+	CpuSeconds   int64
+	GpuSeconds   int64
+	MemMbSeconds int64
+}
+
+func (s *UsageSummary) CpuHours() float64 {
+	return float64(s.CpuSeconds) / 3600
+}
+
+func (s *UsageSummary) GpuHours() float64 {
+	return float64(s.GpuSeconds) / 3600
+}
+
+func (s *UsageSummary) MemGbHours() float64 {
+	return float64(s.MemMbSeconds) / 1024 / 3600
+}
+
+// 时间所在时间段的开始时间
+func bucketStart(t time.Time, bucket UsageBucket) time.Time {
+	year, month, day := t.Date()
+	switch bucket {
+	case UsageBucketDay:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	case UsageBucketWeek:
+		// 周日的Weekday为0
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-offset, 0, 0, 0, 0, t.Location())
+	case UsageBucketMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	}
+	return t
+}
+
+func nextBucket(t time.Time, bucket UsageBucket) time.Time {
+	switch bucket {
+	case UsageBucketDay:
+		return t.AddDate(0, 0, 1)
+	case UsageBucketWeek:
+		return t.AddDate(0, 0, 7)
+	}
+	return t.AddDate(0, 1, 0)
+}
+
+// 把统计范围划分为时间段, 第一段和最后一段截取到范围内
+func usageBuckets(opts *UsageOptions) [][2]int64 {
+	if opts.Bucket == UsageBucketNone {
+		return [][2]int64{{opts.Start, opts.End}}
+	}
+	location := opts.Location
+	if location == nil {
+		location = time.Local
+	}
+	var buckets [][2]int64
+	for t := bucketStart(time.Unix(opts.Start, 0).In(location), opts.Bucket); t.Unix() < opts.End; {
+		next := nextBucket(t, opts.Bucket)
+		buckets = append(buckets, [2]int64{max(t.Unix(), opts.Start), min(next.Unix(), opts.End)})
+		t = next
+	}
+	return buckets
+}
+
+// 在内存中按统计方式汇总作业的资源使用量, 未结束的作业运行到now, 结果按统计维度排序.
+// 异构作业的各组件分别统计, 作业数只计一次. 用于不能在数据库中汇总的后端
+func SummarizeUsage(jobs []*Job, opts *UsageOptions, now int64) []*UsageSummary {
+	buckets := usageBuckets(opts)
+	summaryMap := make(map[UsageKey]*UsageSummary)
+	add := func(job *Job, countJob bool) {
+		if job.StartTime == 0 {
+			return
+		}
+		end := job.EndTime
+		if end == 0 {
+			end = now
+		}
+		for _, bucket := range buckets {
+			// 时间段不包括结束时间, 刚好在开始时间结束的作业不属于这个时间段
+			if job.StartTime >= bucket[1] || end < bucket[0] || (end == bucket[0] && job.StartTime != end) {
+				continue
+			}
+			seconds := min(end, bucket[1]) - max(job.StartTime, bucket[0])
+			key := opts.usageKey(bucket[0], job.User, job.Account, job.Partition, job.Qos)
+			summary, ok := summaryMap[key]
+			if !ok {
+				summary = &UsageSummary{UsageKey: key}
+				summaryMap[key] = summary
+			}
+			if countJob {
+				summary.JobCount++
+			}
+			summary.CpuSeconds += int64(job.CpusAlloc) * seconds
+			summary.GpuSeconds += int64(job.GpusAlloc) * seconds
+			summary.MemMbSeconds += job.MemAllocMb * seconds
+		}
+	}
+	for _, job := range jobs {
+		if len(job.HetComponents) == 0 {
+			add(job, true)
+			continue
+		}
+		for i, component := range job.HetComponents {
+			add(component, i == 0)
+		}
+	}
+	return sortedUsage(summaryMap)
+}
+
+// 统计维度中只保留分组的字段
+func (opts *UsageOptions) usageKey(bucketStart int64, user string, account string, partition string, qos string) UsageKey {
+	key := UsageKey{BucketStart: bucketStart}
+	if opts.GroupByUser {
+		key.User = user
+	}
+	if opts.GroupByAccount {
+		key.Account = account
+	}
+	if opts.GroupByPartition {
+		key.Partition = partition
+	}
+	if opts.GroupByQos {
+		key.Qos = qos
+	}
+	return key
+}
+
+// 按统计维度排序
+func sortedUsage(summaryMap map[UsageKey]*UsageSummary) []*UsageSummary {
+	summaries := make([]*UsageSummary, 0, len(summaryMap))
+	for _, summary := range summaryMap {
+		summaries = append(summaries, summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		a, b := summaries[i].UsageKey, summaries[j].UsageKey
+		if a.BucketStart != b.BucketStart {
+			return a.BucketStart < b.BucketStart
+		}
+		if a.User != b.User {
+			return a.User < b.User
+		}
+		if a.Account != b.Account {
+			return a.Account < b.Account
+		}
+		if a.Partition != b.Partition {
+			return a.Partition < b.Partition
+		}
+		return a.Qos < b.Qos
+	})
+	return summaries
+}
@@ -0,0 +1,109 @@
+package backend
+
+import "slices"
+
+// 作业的终止状态
+var TerminalStates = []string{"COMPLETED", "CANCELLED", "FAILED", "TIMEOUT", "NODE_FAIL", "PREEMPTED", "OUT_OF_MEMORY", "BOOT_FAIL", "DEADLINE", "SPECIAL_EXIT"}
+
+// 作业状态变化事件的类型
+type JobEventType int
+
+const (
+	JobSubmitted     JobEventType = iota + 1
+	JobStarted                    // 从排队变为运行
+	JobReasonChanged              // 排队原因变化
+	JobCompleted
+	JobFailed // 除完成和取消以外的终止状态, 如TIMEOUT、OUT_OF_MEMORY
+	JobCancelled
+	JobStateChanged // 其他状态变化, 如挂起、恢复和重新排队
+)
+
+// 作业状态变化事件
+type JobEvent struct {
+	Type           JobEventType
+	Job            *QueueJob
+	PreviousState  string // 新提交的作业为空
+	PreviousReason string
+}
+
+// 终止状态对应的事件类型, 不是终止状态时返回false
+func TerminalEventType(state string) (JobEventType, bool) {
+	switch {
+	case state == "COMPLETED":
+		return JobCompleted, true
+	case state == "CANCELLED":
+		return JobCancelled, true
+	case slices.Contains(TerminalStates, state):
+		return JobFailed, true
+	default:
+		return 0, false
+	}
+}
+
+// 比较前后两次slurmctld中的作业, 返回状态变化事件和已经离开slurmctld的作业
+func DiffQueueJobs(previous []*QueueJob, current []*QueueJob) ([]*JobEvent, []*QueueJob) {
+	previousJobs := make(map[uint32]*QueueJob, len(previous))
+	for _, job := range previous {
+		previousJobs[job.JobId] = job
+	}
+	var events []*JobEvent
+	currentJobs := make(map[uint32]bool, len(current))
+	for _, job := range current {
+		currentJobs[job.JobId] = true
+		old, ok := previousJobs[job.JobId]
+		if !ok {
+			events = append(events, newJobEvents(job, previousJobs)...)
+			continue
+		}
+		if event := jobChangeEvent(old, job); event != nil {
+			events = append(events, event)
+		}
+	}
+	var vanished []*QueueJob
+	for _, job := range previous {
+		if !currentJobs[job.JobId] {
+			vanished = append(vanished, job)
+		}
+	}
+	return events, vanished
+}
+
+// 新出现的作业, 作业数组中从排队任务拆分出来的任务不算新提交
+func newJobEvents(job *QueueJob, previousJobs map[uint32]*QueueJob) []*JobEvent {
+	var events []*JobEvent
+	_, split := previousJobs[job.ArrayJobId]
+	if job.ArrayJobId == 0 || job.ArrayJobId == job.JobId || !split {
+		events = append(events, &JobEvent{Type: JobSubmitted, Job: job})
+	}
+	previousState := "PENDING"
+	if eventType, ok := TerminalEventType(job.State); ok {
+		events = append(events, &JobEvent{Type: eventType, Job: job, PreviousState: previousState})
+	} else if job.State == "RUNNING" {
+		events = append(events, &JobEvent{Type: JobStarted, Job: job, PreviousState: previousState})
+	}
+	return events
+}
+
+// 同一个作业前后两次的变化, 没有变化时返回nil
+func jobChangeEvent(old *QueueJob, job *QueueJob) *JobEvent {
+	event := &JobEvent{Job: job, PreviousState: old.State, PreviousReason: old.Reason}
+	if job.State == old.State {
+		if job.State == "PENDING" && job.Reason != old.Reason {
+			event.Type = JobReasonChanged
+			return event
+		}
+		return nil
+	}
+	// 完成中的作业等到终止状态再通知
+	if job.State == "COMPLETING" {
+		return nil
+	}
+	if eventType, ok := TerminalEventType(job.State); ok {
+		event.Type = eventType
+	} else if job.State == "RUNNING" && old.State == "PENDING" {
+		event.Type = JobStarted
+	} else {
+		event.Type = JobStateChanged
+	}
+	return event
+}
@@ -0,0 +1,193 @@
+package billing
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"scow-slurm-adapter/utils"
+)
+
+var ErrNotConfigured = errors.New("billing is not configured")
+
+// 作业或异构作业组件的资源用量, 未结束的作业EndTime为0
+type Usage struct {
+	Partition string
+	Qos       string
+	Cpus      int32
+	Gpus      int32
+	MemMb     int64
+	StartTime int64
+	EndTime   int64
+}
+
+// 按资源类型分开的费用
+type Cost struct {
+	CpuCost float64
+	GpuCost float64
+	MemCost float64
+	Priced  bool // 没有匹配的单价规则时为false, 费用都为0
+}
+
+func (c Cost) Total() float64 {
+	return c.CpuCost + c.GpuCost + c.MemCost
+}
+
+// 累加异构作业各组件的费用
+func (c Cost) Add(other Cost) Cost {
+	return Cost{
+		CpuCost: c.CpuCost + other.CpuCost,
+		GpuCost: c.GpuCost + other.GpuCost,
+		MemCost: c.MemCost + other.MemCost,
+		Priced:  c.Priced || other.Priced,
+	}
+}
+
+// 一天中的时段, 单位为秒, end不大于start时跨过0点
+type window struct {
+	start      int
+	end        int
+	multiplier float64
+}
+
+func (w window) contains(second int) bool {
+	if w.start < w.end {
+		return second >= w.start && second < w.end
+	}
+	return second >= w.start || second < w.end
+}
+
+type Engine struct {
+	prices   []utils.PriceRule
+	windows  []window
+	location *time.Location
+}
+
+// 一天中的秒数, 格式为15:04
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, should be like 22:00", value)
+	}
+	return t.Hour()*3600 + t.Minute()*60, nil
+}
+
+// 根据配置创建计费引擎, 没有单价规则时返回ErrNotConfigured
+func New(config *utils.BillingConfig) (*Engine, error) {
+	if len(config.Prices) == 0 {
+		return nil, ErrNotConfigured
+	}
+	engine := &Engine{prices: config.Prices, location: time.Local}
+	if config.Timezone != "" {
+		location, err := time.LoadLocation(config.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		engine.location = location
+	}
+	for _, multiplier := range config.Multipliers {
+		start, err := parseClock(multiplier.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseClock(multiplier.End)
+		if err != nil {
+			return nil, err
+		}
+		if multiplier.Multiplier < 0 {
+			return nil, fmt.Errorf("multiplier of %s-%s should not be negative", multiplier.Start, multiplier.End)
+		}
+		engine.windows = append(engine.windows, window{start, end, multiplier.Multiplier})
+	}
+	return engine, nil
+}
+
+// 分区和QOS对应的单价规则
+func (e *Engine) Price(partition string, qos string) (*utils.PriceRule, bool) {
+	var (
+		best  *utils.PriceRule
+		score = -1
+	)
+	for i := range e.prices {
+		rule := &e.prices[i]
+		if (rule.Partition != "" && rule.Partition != partition) || (rule.Qos != "" && rule.Qos != qos) {
+			continue
+		}
+		s := 0
+		if rule.Partition != "" {
+			s += 2
+		}
+		if rule.Qos != "" {
+			s++
+		}
+		if s > score {
+			best, score = rule, s
+		}
+	}
+	return best, best != nil
+}
+
+// 某个时刻的价格倍率
+func (e *Engine) multiplier(t int64) float64 {
+	clock := time.Unix(t, 0).In(e.location)
+	second := clock.Hour()*3600 + clock.Minute()*60 + clock.Second()
+	for _, w := range e.windows {
+		if w.contains(second) {
+			return w.multiplier
+		}
+	}
+	return 1
+}
+
+// 运行时间按倍率加权后的秒数
+func (e *Engine) weightedSeconds(start int64, end int64) float64 {
+	if end <= start {
+		return 0
+	}
+	if len(e.windows) == 0 {
+		return float64(end - start)
+	}
+	// 倍率只在时段的边界变化
+	boundaries := []int64{start, end}
+	year, month, day := time.Unix(start, 0).In(e.location).Date()
+	for i := 0; ; i++ {
+		dayStart := time.Date(year, month, day+i, 0, 0, 0, 0, e.location)
+		if dayStart.Unix() >= end {
+			break
+		}
+		for _, w := range e.windows {
+			for _, second := range []int{w.start, w.end} {
+				t := time.Date(year, month, day+i, 0, 0, second, 0, e.location).Unix()
+				if t > start && t < end {
+					boundaries = append(boundaries, t)
+				}
+			}
+		}
+	}
+	sort.Slice(boundaries, func(i, j int) bool { return boundaries[i] < boundaries[j] })
+	var seconds float64
+	for i := 0; i+1 < len(boundaries); i++ {
+		seconds += float64(boundaries[i+1]-boundaries[i]) * e.multiplier(boundaries[i])
+	}
+	return seconds
+}
+
+// 计算作业的费用, 未结束的作业计算到now
+func (e *Engine) Cost(usage *Usage, now int64) Cost {
+	rule, ok := e.Price(usage.Partition, usage.Qos)
+	if !ok || usage.StartTime == 0 {
+		return Cost{Priced: ok}
+	}
+	end := usage.EndTime
+	if end == 0 {
+		end = now
+	}
+	hours := e.weightedSeconds(usage.StartTime, end) / 3600
+	return Cost{
+		CpuCost: float64(usage.Cpus) * hours * rule.CpuCoreHour,
+		GpuCost: float64(usage.Gpus) * hours * rule.GpuHour,
+		MemCost: float64(usage.MemMb) / 1024 * hours * rule.MemGbHour,
+		Priced:  true,
+	}
+}
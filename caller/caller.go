@@ -3,6 +3,7 @@ package caller
 import (
 	"bytes"
 	"database/sql"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -12,6 +13,8 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/natefinch/lumberjack.v2"
+	"scow-slurm-adapter/backend"
+	"scow-slurm-adapter/billing"
 	"scow-slurm-adapter/utils"
 )
 
@@ -19,6 +22,8 @@ var (
 	DB          *sql.DB
 	ConfigValue *utils.Config
 	Logger      *logrus.Logger
+	Clusters    map[string]*Cluster // 集群名到集群的映射, 包括默认集群
+	Billing     *billing.Engine     // 没有配置计费时为nil
 )
 
 type LogFormatter struct{}
@@ -50,8 +55,42 @@ func (m *LogFormatter) Format(entry *logrus.Entry) ([]byte, error) {
 func init() {
 	currentPwd, _ := os.Getwd()
 	ConfigValue = utils.ParseConfig(currentPwd + "/" + utils.DefaultConfigPath)
-	initDB()
+	// 使用slurmrestd时不需要连接slurm数据库
+	if ConfigValue.Slurm.Backend != backend.TypeRest {
+		initDB()
+	}
 	initLogger()
+	initBackend()
+	initBilling()
+}
+
+func initBilling() {
+	var err error
+	Billing, err = billing.New(&ConfigValue.Billing)
+	if err != nil && !errors.Is(err, billing.ErrNotConfigured) {
+		log.Fatal(err)
+	}
+}
+
+func initBackend() {
+	defaultBackend, err := backend.New(ConfigValue, DB)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defaultName := ConfigValue.MySQLConfig.ClusterName
+	Clusters = map[string]*Cluster{defaultName: {Name: defaultName, Backend: defaultBackend}}
+	// 同一个slurmdbd下的其他集群
+	for i := range ConfigValue.Clusters {
+		clusterConfig := &ConfigValue.Clusters[i]
+		if _, ok := Clusters[clusterConfig.Name]; ok {
+			continue
+		}
+		clusterBackend, err := backend.NewClusterBackend(ConfigValue, DB, clusterConfig)
+		if err != nil {
+			log.Fatal(err)
+		}
+		Clusters[clusterConfig.Name] = &Cluster{Name: clusterConfig.Name, Backend: clusterBackend}
+	}
 }
 
 func initDB() {
@@ -0,0 +1,82 @@
+package caller
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"scow-slurm-adapter/backend"
+)
+
+// 请求的gRPC metadata中指定集群的key, 不指定时使用mysql.clustername配置的默认集群
+const ClusterMetadataKey = "cluster"
+
+// 请求所在的集群
+type Cluster struct {
+	Name    string
+	Backend backend.Backend
+}
+
+type clusterContextKey struct{}
+
+// 请求metadata中指定的集群, 没有指定时返回默认集群
+func clusterFromMetadata(ctx context.Context) (*Cluster, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(ClusterMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return Clusters[ConfigValue.MySQLConfig.ClusterName], nil
+	}
+	if cluster, ok := Clusters[values[0]]; ok {
+		return cluster, nil
+	}
+	errInfo := &errdetails.ErrorInfo{
+		Reason: "CLUSTER_NOT_FOUND",
+	}
+	st := status.New(codes.NotFound, "The cluster does not exist.")
+	st, _ = st.WithDetails(errInfo)
+	Logger.Errorf("Select cluster %s failed: %v", values[0], st.Err())
+	return nil, st.Err()
+}
+
+// 获取请求所在的集群, 由拦截器放入context中
+func GetCluster(ctx context.Context) *Cluster {
+	if cluster, ok := ctx.Value(clusterContextKey{}).(*Cluster); ok {
+		return cluster
+	}
+	return Clusters[ConfigValue.MySQLConfig.ClusterName]
+}
+
+// 获取请求所在集群的后端
+func GetBackend(ctx context.Context) backend.Backend {
+	return GetCluster(ctx).Backend
+}
+
+// 根据metadata选择集群的一元拦截器
+func UnaryClusterInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	cluster, err := clusterFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(context.WithValue(ctx, clusterContextKey{}, cluster), req)
+}
+
+type clusterServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *clusterServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// 根据metadata选择集群的流拦截器
+func StreamClusterInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	cluster, err := clusterFromMetadata(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &clusterServerStream{ServerStream: ss, ctx: context.WithValue(ss.Context(), clusterContextKey{}, cluster)})
+}
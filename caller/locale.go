@@ -0,0 +1,18 @@
+package caller
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"scow-slurm-adapter/reason"
+)
+
+// 请求的gRPC metadata中指定语言的key, 值的格式和HTTP的Accept-Language相同
+const LocaleMetadataKey = "accept-language"
+
+// 请求的语言, 用于排队原因等说明文字, 没有指定时为英文
+func GetLocale(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return reason.MatchLocale(strings.Join(md.Get(LocaleMetadataKey), ","))
+}
@@ -45,6 +45,17 @@ type AppServiceClient interface {
 	// - For interactive applications running in containers:
 	//   This interface needs to provide the host and port information of the host machine to ensure scow can connect to the correct address.
 	//   Sometimes it needs to provide password for app
+	// - The adapter reads the connection info file written by the app's start script
+	//   under the job's working directory, host defaults to the first allocated node.
+	// errors:
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - job is pending, suspended or ended
+	//   FAILED_PRECONDITION, JOB_NOT_RUNNING, {}
+	// - connection info file does not exist
+	//   NOT_FOUND, CONNECTION_INFO_NOT_FOUND, {}
+	// - connection info file is empty, incomplete or has no valid port, retry later
+	//   UNAVAILABLE, CONNECTION_INFO_NOT_READY, {}
 	GetAppConnectionInfo(ctx context.Context, in *GetAppConnectionInfoRequest, opts ...grpc.CallOption) (*GetAppConnectionInfoResponse, error)
 }
 
@@ -78,6 +89,17 @@ type AppServiceServer interface {
 	// - For interactive applications running in containers:
 	//   This interface needs to provide the host and port information of the host machine to ensure scow can connect to the correct address.
 	//   Sometimes it needs to provide password for app
+	// - The adapter reads the connection info file written by the app's start script
+	//   under the job's working directory, host defaults to the first allocated node.
+	// errors:
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - job is pending, suspended or ended
+	//   FAILED_PRECONDITION, JOB_NOT_RUNNING, {}
+	// - connection info file does not exist
+	//   NOT_FOUND, CONNECTION_INFO_NOT_FOUND, {}
+	// - connection info file is empty, incomplete or has no valid port, retry later
+	//   UNAVAILABLE, CONNECTION_INFO_NOT_READY, {}
 	GetAppConnectionInfo(context.Context, *GetAppConnectionInfoRequest) (*GetAppConnectionInfoResponse, error)
 }
 
@@ -33,6 +33,139 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// stable categories which do not depend on the slurm version
+type PendingReason_Category int32
+
+const (
+	PendingReason_OTHER           PendingReason_Category = 0
+	PendingReason_RESOURCES       PendingReason_Category = 1
+	PendingReason_PRIORITY        PendingReason_Category = 2
+	PendingReason_LIMITS          PendingReason_Category = 3
+	PendingReason_DEPENDENCY      PendingReason_Category = 4
+	PendingReason_HELD            PendingReason_Category = 5
+	PendingReason_PARTITION_DOWN  PendingReason_Category = 6
+	PendingReason_ACCOUNT_BLOCKED PendingReason_Category = 7
+)
+
+// Enum value maps for PendingReason_Category.
+var (
+	PendingReason_Category_name = map[int32]string{
+		0: "OTHER",
+		1: "RESOURCES",
+		2: "PRIORITY",
+		3: "LIMITS",
+		4: "DEPENDENCY",
+		5: "HELD",
+		6: "PARTITION_DOWN",
+		7: "ACCOUNT_BLOCKED",
+	}
+	PendingReason_Category_value = map[string]int32{
+		"OTHER":           0,
+		"RESOURCES":       1,
+		"PRIORITY":        2,
+		"LIMITS":          3,
+		"DEPENDENCY":      4,
+		"HELD":            5,
+		"PARTITION_DOWN":  6,
+		"ACCOUNT_BLOCKED": 7,
+	}
+)
+
+func (x PendingReason_Category) Enum() *PendingReason_Category {
+	p := new(PendingReason_Category)
+	*p = x
+	return p
+}
+
+func (x PendingReason_Category) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PendingReason_Category) Descriptor() protoreflect.EnumDescriptor {
+	return file_job_proto_enumTypes[0].Descriptor()
+}
+
+func (PendingReason_Category) Type() protoreflect.EnumType {
+	return &file_job_proto_enumTypes[0]
+}
+
+func (x PendingReason_Category) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PendingReason_Category.Descriptor instead.
+func (PendingReason_Category) EnumDescriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type JobDependency_Type int32
+
+const (
+	// not set, rejected as an invalid dependency
+	JobDependency_DEPENDENCY_TYPE_UNSPECIFIED JobDependency_Type = 0
+	// begin after the jobs have started
+	JobDependency_AFTER JobDependency_Type = 1
+	// begin after the jobs have terminated
+	JobDependency_AFTER_ANY JobDependency_Type = 2
+	// begin after the jobs have successfully completed
+	JobDependency_AFTER_OK JobDependency_Type = 3
+	// begin after the jobs have failed
+	JobDependency_AFTER_NOT_OK JobDependency_Type = 4
+	// begin after all tasks of the job arrays have successfully completed
+	JobDependency_AFTER_CORR JobDependency_Type = 5
+	// begin after jobs with the same name and user have terminated
+	JobDependency_SINGLETON JobDependency_Type = 6
+)
+
+// Enum value maps for JobDependency_Type.
+var (
+	JobDependency_Type_name = map[int32]string{
+		0: "DEPENDENCY_TYPE_UNSPECIFIED",
+		1: "AFTER",
+		2: "AFTER_ANY",
+		3: "AFTER_OK",
+		4: "AFTER_NOT_OK",
+		5: "AFTER_CORR",
+		6: "SINGLETON",
+	}
+	JobDependency_Type_value = map[string]int32{
+		"DEPENDENCY_TYPE_UNSPECIFIED": 0,
+		"AFTER":                       1,
+		"AFTER_ANY":                   2,
+		"AFTER_OK":                    3,
+		"AFTER_NOT_OK":                4,
+		"AFTER_CORR":                  5,
+		"SINGLETON":                   6,
+	}
+)
+
+func (x JobDependency_Type) Enum() *JobDependency_Type {
+	p := new(JobDependency_Type)
+	*p = x
+	return p
+}
+
+func (x JobDependency_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobDependency_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_job_proto_enumTypes[1].Descriptor()
+}
+
+func (JobDependency_Type) Type() protoreflect.EnumType {
+	return &file_job_proto_enumTypes[1]
+}
+
+func (x JobDependency_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobDependency_Type.Descriptor instead.
+func (JobDependency_Type) EnumDescriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{2, 0}
+}
+
 type SortInfo_SortOrder int32
 
 const (
@@ -63,11 +196,11 @@ func (x SortInfo_SortOrder) String() string {
 }
 
 func (SortInfo_SortOrder) Descriptor() protoreflect.EnumDescriptor {
-	return file_job_proto_enumTypes[0].Descriptor()
+	return file_job_proto_enumTypes[2].Descriptor()
 }
 
 func (SortInfo_SortOrder) Type() protoreflect.EnumType {
-	return &file_job_proto_enumTypes[0]
+	return &file_job_proto_enumTypes[2]
 }
 
 func (x SortInfo_SortOrder) Number() protoreflect.EnumNumber {
@@ -76,7 +209,272 @@ func (x SortInfo_SortOrder) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SortInfo_SortOrder.Descriptor instead.
 func (SortInfo_SortOrder) EnumDescriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{3, 0}
+	return file_job_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type JobEvent_Type int32
+
+const (
+	JobEvent_INITIAL   JobEvent_Type = 0
+	JobEvent_SUBMITTED JobEvent_Type = 1
+	// the job changed from PENDING to RUNNING
+	JobEvent_STARTED JobEvent_Type = 2
+	// the pending reason of the job changed
+	JobEvent_REASON_CHANGED JobEvent_Type = 3
+	JobEvent_COMPLETED      JobEvent_Type = 4
+	// the job ended with a state other than COMPLETED and CANCELLED, e.g. FAILED, TIMEOUT
+	JobEvent_FAILED    JobEvent_Type = 5
+	JobEvent_CANCELLED JobEvent_Type = 6
+	// other state changes, e.g. suspended, resumed or requeued
+	JobEvent_STATE_CHANGED JobEvent_Type = 7
+)
+
+// Enum value maps for JobEvent_Type.
+var (
+	JobEvent_Type_name = map[int32]string{
+		0: "INITIAL",
+		1: "SUBMITTED",
+		2: "STARTED",
+		3: "REASON_CHANGED",
+		4: "COMPLETED",
+		5: "FAILED",
+		6: "CANCELLED",
+		7: "STATE_CHANGED",
+	}
+	JobEvent_Type_value = map[string]int32{
+		"INITIAL":        0,
+		"SUBMITTED":      1,
+		"STARTED":        2,
+		"REASON_CHANGED": 3,
+		"COMPLETED":      4,
+		"FAILED":         5,
+		"CANCELLED":      6,
+		"STATE_CHANGED":  7,
+	}
+)
+
+func (x JobEvent_Type) Enum() *JobEvent_Type {
+	p := new(JobEvent_Type)
+	*p = x
+	return p
+}
+
+func (x JobEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_job_proto_enumTypes[3].Descriptor()
+}
+
+func (JobEvent_Type) Type() protoreflect.EnumType {
+	return &file_job_proto_enumTypes[3]
+}
+
+func (x JobEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobEvent_Type.Descriptor instead.
+func (JobEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{56, 0}
+}
+
+type GetEfficiencySummaryRequest_GroupBy int32
+
+const (
+	GetEfficiencySummaryRequest_USER    GetEfficiencySummaryRequest_GroupBy = 0
+	GetEfficiencySummaryRequest_ACCOUNT GetEfficiencySummaryRequest_GroupBy = 1
+)
+
+// Enum value maps for GetEfficiencySummaryRequest_GroupBy.
+var (
+	GetEfficiencySummaryRequest_GroupBy_name = map[int32]string{
+		0: "USER",
+		1: "ACCOUNT",
+	}
+	GetEfficiencySummaryRequest_GroupBy_value = map[string]int32{
+		"USER":    0,
+		"ACCOUNT": 1,
+	}
+)
+
+func (x GetEfficiencySummaryRequest_GroupBy) Enum() *GetEfficiencySummaryRequest_GroupBy {
+	p := new(GetEfficiencySummaryRequest_GroupBy)
+	*p = x
+	return p
+}
+
+func (x GetEfficiencySummaryRequest_GroupBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetEfficiencySummaryRequest_GroupBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_job_proto_enumTypes[4].Descriptor()
+}
+
+func (GetEfficiencySummaryRequest_GroupBy) Type() protoreflect.EnumType {
+	return &file_job_proto_enumTypes[4]
+}
+
+func (x GetEfficiencySummaryRequest_GroupBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetEfficiencySummaryRequest_GroupBy.Descriptor instead.
+func (GetEfficiencySummaryRequest_GroupBy) EnumDescriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{63, 0}
+}
+
+type GetUsageSummaryRequest_GroupBy int32
+
+const (
+	GetUsageSummaryRequest_USER      GetUsageSummaryRequest_GroupBy = 0
+	GetUsageSummaryRequest_ACCOUNT   GetUsageSummaryRequest_GroupBy = 1
+	GetUsageSummaryRequest_PARTITION GetUsageSummaryRequest_GroupBy = 2
+	GetUsageSummaryRequest_QOS       GetUsageSummaryRequest_GroupBy = 3
+)
+
+// Enum value maps for GetUsageSummaryRequest_GroupBy.
+var (
+	GetUsageSummaryRequest_GroupBy_name = map[int32]string{
+		0: "USER",
+		1: "ACCOUNT",
+		2: "PARTITION",
+		3: "QOS",
+	}
+	GetUsageSummaryRequest_GroupBy_value = map[string]int32{
+		"USER":      0,
+		"ACCOUNT":   1,
+		"PARTITION": 2,
+		"QOS":       3,
+	}
+)
+
+func (x GetUsageSummaryRequest_GroupBy) Enum() *GetUsageSummaryRequest_GroupBy {
+	p := new(GetUsageSummaryRequest_GroupBy)
+	*p = x
+	return p
+}
+
+func (x GetUsageSummaryRequest_GroupBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetUsageSummaryRequest_GroupBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_job_proto_enumTypes[5].Descriptor()
+}
+
+func (GetUsageSummaryRequest_GroupBy) Type() protoreflect.EnumType {
+	return &file_job_proto_enumTypes[5]
+}
+
+func (x GetUsageSummaryRequest_GroupBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetUsageSummaryRequest_GroupBy.Descriptor instead.
+func (GetUsageSummaryRequest_GroupBy) EnumDescriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{66, 0}
+}
+
+type GetUsageSummaryRequest_Bucket int32
+
+const (
+	GetUsageSummaryRequest_NONE GetUsageSummaryRequest_Bucket = 0
+	GetUsageSummaryRequest_DAY  GetUsageSummaryRequest_Bucket = 1
+	// weeks start on Monday
+	GetUsageSummaryRequest_WEEK  GetUsageSummaryRequest_Bucket = 2
+	GetUsageSummaryRequest_MONTH GetUsageSummaryRequest_Bucket = 3
+)
+
+// Enum value maps for GetUsageSummaryRequest_Bucket.
+var (
+	GetUsageSummaryRequest_Bucket_name = map[int32]string{
+		0: "NONE",
+		1: "DAY",
+		2: "WEEK",
+		3: "MONTH",
+	}
+	GetUsageSummaryRequest_Bucket_value = map[string]int32{
+		"NONE":  0,
+		"DAY":   1,
+		"WEEK":  2,
+		"MONTH": 3,
+	}
+)
+
+func (x GetUsageSummaryRequest_Bucket) Enum() *GetUsageSummaryRequest_Bucket {
+	p := new(GetUsageSummaryRequest_Bucket)
+	*p = x
+	return p
+}
+
+func (x GetUsageSummaryRequest_Bucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetUsageSummaryRequest_Bucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_job_proto_enumTypes[6].Descriptor()
+}
+
+func (GetUsageSummaryRequest_Bucket) Type() protoreflect.EnumType {
+	return &file_job_proto_enumTypes[6]
+}
+
+func (x GetUsageSummaryRequest_Bucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetUsageSummaryRequest_Bucket.Descriptor instead.
+func (GetUsageSummaryRequest_Bucket) EnumDescriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{66, 1}
+}
+
+type TailJobOutputRequest_OutputType int32
+
+const (
+	TailJobOutputRequest_STDOUT TailJobOutputRequest_OutputType = 0
+	TailJobOutputRequest_STDERR TailJobOutputRequest_OutputType = 1
+)
+
+// Enum value maps for TailJobOutputRequest_OutputType.
+var (
+	TailJobOutputRequest_OutputType_name = map[int32]string{
+		0: "STDOUT",
+		1: "STDERR",
+	}
+	TailJobOutputRequest_OutputType_value = map[string]int32{
+		"STDOUT": 0,
+		"STDERR": 1,
+	}
+)
+
+func (x TailJobOutputRequest_OutputType) Enum() *TailJobOutputRequest_OutputType {
+	p := new(TailJobOutputRequest_OutputType)
+	*p = x
+	return p
+}
+
+func (x TailJobOutputRequest_OutputType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TailJobOutputRequest_OutputType) Descriptor() protoreflect.EnumDescriptor {
+	return file_job_proto_enumTypes[7].Descriptor()
+}
+
+func (TailJobOutputRequest_OutputType) Type() protoreflect.EnumType {
+	return &file_job_proto_enumTypes[7]
+}
+
+func (x TailJobOutputRequest_OutputType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TailJobOutputRequest_OutputType.Descriptor instead.
+func (TailJobOutputRequest_OutputType) EnumDescriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{74, 0}
 }
 
 type JobInfo struct {
@@ -129,8 +527,39 @@ type JobInfo struct {
 	// memory used by job
 	MemAllocMb *int64 `protobuf:"varint,22,opt,name=mem_alloc_mb,json=memAllocMb,proto3,oneof" json:"mem_alloc_mb,omitempty"`
 	// the number of nodes used by job
-	NodesAlloc    *int32                 `protobuf:"varint,23,opt,name=nodes_alloc,json=nodesAlloc,proto3,oneof" json:"nodes_alloc,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	NodesAlloc *int32                 `protobuf:"varint,23,opt,name=nodes_alloc,json=nodesAlloc,proto3,oneof" json:"nodes_alloc,omitempty"`
+	EndTime    *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	// id of the job array this job belongs to, only set for array jobs
+	ArrayJobId *uint32 `protobuf:"varint,25,opt,name=array_job_id,json=arrayJobId,proto3,oneof" json:"array_job_id,omitempty"`
+	// task index in the job array, only set for array jobs.
+	// pending tasks that have not been split out are reported as one job
+	// with a range expression, e.g. 3-10
+	ArrayTaskId *string `protobuf:"bytes,26,opt,name=array_task_id,json=arrayTaskId,proto3,oneof" json:"array_task_id,omitempty"`
+	// exit code of the batch script, only set for ended jobs
+	ExitCode *int32 `protobuf:"varint,27,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
+	// signal that terminated the batch script, 0 if it exited normally
+	ExitSignal *int32 `protobuf:"varint,28,opt,name=exit_signal,json=exitSignal,proto3,oneof" json:"exit_signal,omitempty"`
+	// the highest exit code of all job steps, only set for ended jobs
+	DerivedExitCode *int32 `protobuf:"varint,29,opt,name=derived_exit_code,json=derivedExitCode,proto3,oneof" json:"derived_exit_code,omitempty"`
+	// signal of the job step with the highest exit code
+	DerivedExitSignal *int32 `protobuf:"varint,30,opt,name=derived_exit_signal,json=derivedExitSignal,proto3,oneof" json:"derived_exit_signal,omitempty"`
+	// dependency of a pending job reported by slurm, e.g. afterok:12_*(unfulfilled)
+	Dependency *string `protobuf:"bytes,31,opt,name=dependency,proto3,oneof" json:"dependency,omitempty"`
+	// id of the heterogeneous job this job belongs to, the job id of component 0.
+	// only set for heterogeneous jobs
+	HetJobId *uint32 `protobuf:"varint,32,opt,name=het_job_id,json=hetJobId,proto3,oneof" json:"het_job_id,omitempty"`
+	// index of this component in the heterogeneous job, only set for heterogeneous jobs
+	HetJobOffset *uint32 `protobuf:"varint,33,opt,name=het_job_offset,json=hetJobOffset,proto3,oneof" json:"het_job_offset,omitempty"`
+	// all components of a heterogeneous job, only set on component 0.
+	// the resources of component 0 (cpus, nodes, gpus, memory, node list) are
+	// the totals of all components
+	HetComponents []*JobInfo `protobuf:"bytes,34,rep,name=het_components,json=hetComponents,proto3" json:"het_components,omitempty"`
+	// start time of a pending job estimated by the scheduler, as squeue --start shows.
+	// not set before the scheduler has estimated it or when the job is not pending
+	ScheduledStartTime *timestamppb.Timestamp `protobuf:"bytes,35,opt,name=scheduled_start_time,json=scheduledStartTime,proto3,oneof" json:"scheduled_start_time,omitempty"`
+	// classified reason of a pending job, reason keeps the raw reason reported by slurm.
+	// not set when the job is not pending or slurm reports no reason
+	PendingReason *PendingReason `protobuf:"bytes,36,opt,name=pending_reason,json=pendingReason,proto3,oneof" json:"pending_reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -333,81 +762,122 @@ func (x *JobInfo) GetEndTime() *timestamppb.Timestamp {
 	return nil
 }
 
-type TimeRange struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *JobInfo) GetArrayJobId() uint32 {
+	if x != nil && x.ArrayJobId != nil {
+		return *x.ArrayJobId
+	}
+	return 0
 }
 
-func (x *TimeRange) Reset() {
-	*x = TimeRange{}
-	mi := &file_job_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *JobInfo) GetArrayTaskId() string {
+	if x != nil && x.ArrayTaskId != nil {
+		return *x.ArrayTaskId
+	}
+	return ""
 }
 
-func (x *TimeRange) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JobInfo) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
 }
 
-func (*TimeRange) ProtoMessage() {}
+func (x *JobInfo) GetExitSignal() int32 {
+	if x != nil && x.ExitSignal != nil {
+		return *x.ExitSignal
+	}
+	return 0
+}
 
-func (x *TimeRange) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JobInfo) GetDerivedExitCode() int32 {
+	if x != nil && x.DerivedExitCode != nil {
+		return *x.DerivedExitCode
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
-func (*TimeRange) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{1}
+func (x *JobInfo) GetDerivedExitSignal() int32 {
+	if x != nil && x.DerivedExitSignal != nil {
+		return *x.DerivedExitSignal
+	}
+	return 0
 }
 
-func (x *TimeRange) GetStartTime() *timestamppb.Timestamp {
+func (x *JobInfo) GetDependency() string {
+	if x != nil && x.Dependency != nil {
+		return *x.Dependency
+	}
+	return ""
+}
+
+func (x *JobInfo) GetHetJobId() uint32 {
+	if x != nil && x.HetJobId != nil {
+		return *x.HetJobId
+	}
+	return 0
+}
+
+func (x *JobInfo) GetHetJobOffset() uint32 {
+	if x != nil && x.HetJobOffset != nil {
+		return *x.HetJobOffset
+	}
+	return 0
+}
+
+func (x *JobInfo) GetHetComponents() []*JobInfo {
 	if x != nil {
-		return x.StartTime
+		return x.HetComponents
 	}
 	return nil
 }
 
-func (x *TimeRange) GetEndTime() *timestamppb.Timestamp {
+func (x *JobInfo) GetScheduledStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EndTime
+		return x.ScheduledStartTime
 	}
 	return nil
 }
 
-type PageInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+func (x *JobInfo) GetPendingReason() *PendingReason {
+	if x != nil {
+		return x.PendingReason
+	}
+	return nil
+}
+
+type PendingReason struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Category PendingReason_Category `protobuf:"varint,1,opt,name=category,proto3,enum=scow.scheduler_adapter.PendingReason_Category" json:"category,omitempty"`
+	// slurm reason code without its parameters, e.g. ReqNodeNotAvail, AssocGrpCPUMinutesLimit
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// parameters parsed from the raw reason, e.g.
+	// - unavailable_nodes: cn[01-04] for ReqNodeNotAvail
+	// - scope: association, qos, partition or array and limit: GrpCPUMinutes for limits
+	// - partition, account and allowed_accounts for ACCOUNT_BLOCKED
+	Params map[string]string `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// human readable explanation in the language of the accept-language metadata
+	// of the request (en or zh-CN), English if not set or not supported
+	Explanation   string `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PageInfo) Reset() {
-	*x = PageInfo{}
-	mi := &file_job_proto_msgTypes[2]
+func (x *PendingReason) Reset() {
+	*x = PendingReason{}
+	mi := &file_job_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PageInfo) String() string {
+func (x *PendingReason) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PageInfo) ProtoMessage() {}
+func (*PendingReason) ProtoMessage() {}
 
-func (x *PageInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[2]
+func (x *PendingReason) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,9 +888,180 @@ func (x *PageInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PageInfo.ProtoReflect.Descriptor instead.
-func (*PageInfo) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use PendingReason.ProtoReflect.Descriptor instead.
+func (*PendingReason) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PendingReason) GetCategory() PendingReason_Category {
+	if x != nil {
+		return x.Category
+	}
+	return PendingReason_OTHER
+}
+
+func (x *PendingReason) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PendingReason) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *PendingReason) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+type JobDependency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  JobDependency_Type     `protobuf:"varint,1,opt,name=type,proto3,enum=scow.scheduler_adapter.JobDependency_Type" json:"type,omitempty"`
+	// the jobs depended on, not used by SINGLETON
+	JobIds        []uint32 `protobuf:"varint,2,rep,packed,name=job_ids,json=jobIds,proto3" json:"job_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobDependency) Reset() {
+	*x = JobDependency{}
+	mi := &file_job_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobDependency) ProtoMessage() {}
+
+func (x *JobDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobDependency.ProtoReflect.Descriptor instead.
+func (*JobDependency) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *JobDependency) GetType() JobDependency_Type {
+	if x != nil {
+		return x.Type
+	}
+	return JobDependency_DEPENDENCY_TYPE_UNSPECIFIED
+}
+
+func (x *JobDependency) GetJobIds() []uint32 {
+	if x != nil {
+		return x.JobIds
+	}
+	return nil
+}
+
+type TimeRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	mi := &file_job_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TimeRange) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *TimeRange) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type PageInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageInfo) Reset() {
+	*x = PageInfo{}
+	mi := &file_job_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageInfo) ProtoMessage() {}
+
+func (x *PageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageInfo.ProtoReflect.Descriptor instead.
+func (*PageInfo) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PageInfo) GetPage() uint32 {
@@ -438,16 +1079,19 @@ func (x *PageInfo) GetPageSize() uint64 {
 }
 
 type SortInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Order         SortInfo_SortOrder     `protobuf:"varint,2,opt,name=order,proto3,enum=scow.scheduler_adapter.SortInfo_SortOrder" json:"order,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// one of job_id, submit_time, start_time, end_time, state, user, account, partition,
+	// elapsed_seconds, cpus_alloc and gpus_alloc. Jobs with the same value are ordered by job id.
+	// if empty, jobs are ordered by job id
+	Field         string             `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Order         SortInfo_SortOrder `protobuf:"varint,2,opt,name=order,proto3,enum=scow.scheduler_adapter.SortInfo_SortOrder" json:"order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SortInfo) Reset() {
 	*x = SortInfo{}
-	mi := &file_job_proto_msgTypes[3]
+	mi := &file_job_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -459,7 +1103,7 @@ func (x *SortInfo) String() string {
 func (*SortInfo) ProtoMessage() {}
 
 func (x *SortInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[3]
+	mi := &file_job_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +1116,7 @@ func (x *SortInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SortInfo.ProtoReflect.Descriptor instead.
 func (*SortInfo) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{3}
+	return file_job_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SortInfo) GetField() string {
@@ -500,14 +1144,19 @@ type GetJobsRequest struct {
 	// if not set, no pagination
 	PageInfo *PageInfo `protobuf:"bytes,3,opt,name=page_info,json=pageInfo,proto3,oneof" json:"page_info,omitempty"`
 	// returned jobs should be sorted if set
-	Sort          *SortInfo `protobuf:"bytes,4,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Sort *SortInfo `protobuf:"bytes,4,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	// next_page_token of the previous response, continue after the last job of that page.
+	// page_info.page is ignored if set, page_info.page_size is the page size
+	PageToken *string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	// don't count total_count, which is slow on a large job table
+	SkipTotalCount bool `protobuf:"varint,6,opt,name=skip_total_count,json=skipTotalCount,proto3" json:"skip_total_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetJobsRequest) Reset() {
 	*x = GetJobsRequest{}
-	mi := &file_job_proto_msgTypes[4]
+	mi := &file_job_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -519,7 +1168,7 @@ func (x *GetJobsRequest) String() string {
 func (*GetJobsRequest) ProtoMessage() {}
 
 func (x *GetJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[4]
+	mi := &file_job_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +1181,7 @@ func (x *GetJobsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobsRequest.ProtoReflect.Descriptor instead.
 func (*GetJobsRequest) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{4}
+	return file_job_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetJobsRequest) GetFields() []string {
@@ -563,19 +1212,36 @@ func (x *GetJobsRequest) GetSort() *SortInfo {
 	return nil
 }
 
+func (x *GetJobsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *GetJobsRequest) GetSkipTotalCount() bool {
+	if x != nil {
+		return x.SkipTotalCount
+	}
+	return false
+}
+
 type GetJobsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Jobs  []*JobInfo             `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
 	// page total count
 	// if no pagination, don't set this field
-	TotalCount    *uint32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
+	TotalCount *uint32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3,oneof" json:"total_count,omitempty"`
+	// set if page_token is set or page_info.page is the first page, and there may be more jobs.
+	// pass it as page_token to get the next page
+	NextPageToken *string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetJobsResponse) Reset() {
 	*x = GetJobsResponse{}
-	mi := &file_job_proto_msgTypes[5]
+	mi := &file_job_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -587,7 +1253,7 @@ func (x *GetJobsResponse) String() string {
 func (*GetJobsResponse) ProtoMessage() {}
 
 func (x *GetJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[5]
+	mi := &file_job_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,7 +1266,7 @@ func (x *GetJobsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobsResponse.ProtoReflect.Descriptor instead.
 func (*GetJobsResponse) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{5}
+	return file_job_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetJobsResponse) GetJobs() []*JobInfo {
@@ -617,31 +1283,40 @@ func (x *GetJobsResponse) GetTotalCount() uint32 {
 	return 0
 }
 
-type GetJobByIdRequest struct {
+func (x *GetJobsResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
+type StreamJobsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// required JobInfo fields
-	// The value of the string corresponds to the name of each field in JobInfo
-	Fields        []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
-	JobId         uint32   `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// required JobInfo fields, the same as GetJobsRequest
+	Fields []string               `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	Filter *GetJobsRequest_Filter `protobuf:"bytes,2,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	Sort   *SortInfo              `protobuf:"bytes,3,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	// jobs per response, 1000 if not set
+	BatchSize     uint32 `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetJobByIdRequest) Reset() {
-	*x = GetJobByIdRequest{}
-	mi := &file_job_proto_msgTypes[6]
+func (x *StreamJobsRequest) Reset() {
+	*x = StreamJobsRequest{}
+	mi := &file_job_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetJobByIdRequest) String() string {
+func (x *StreamJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobByIdRequest) ProtoMessage() {}
+func (*StreamJobsRequest) ProtoMessage() {}
 
-func (x *GetJobByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[6]
+func (x *StreamJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,47 +1327,61 @@ func (x *GetJobByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJobByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetJobByIdRequest) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use StreamJobsRequest.ProtoReflect.Descriptor instead.
+func (*StreamJobsRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetJobByIdRequest) GetFields() []string {
+func (x *StreamJobsRequest) GetFields() []string {
 	if x != nil {
 		return x.Fields
 	}
 	return nil
 }
 
-func (x *GetJobByIdRequest) GetJobId() uint32 {
+func (x *StreamJobsRequest) GetFilter() *GetJobsRequest_Filter {
 	if x != nil {
-		return x.JobId
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *StreamJobsRequest) GetSort() *SortInfo {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *StreamJobsRequest) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
 	}
 	return 0
 }
 
-type GetJobByIdResponse struct {
+type StreamJobsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Job           *JobInfo               `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Jobs          []*JobInfo             `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetJobByIdResponse) Reset() {
-	*x = GetJobByIdResponse{}
-	mi := &file_job_proto_msgTypes[7]
+func (x *StreamJobsResponse) Reset() {
+	*x = StreamJobsResponse{}
+	mi := &file_job_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetJobByIdResponse) String() string {
+func (x *StreamJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobByIdResponse) ProtoMessage() {}
+func (*StreamJobsResponse) ProtoMessage() {}
 
-func (x *GetJobByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[7]
+func (x *StreamJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,42 +1392,43 @@ func (x *GetJobByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJobByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetJobByIdResponse) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use StreamJobsResponse.ProtoReflect.Descriptor instead.
+func (*StreamJobsResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetJobByIdResponse) GetJob() *JobInfo {
+func (x *StreamJobsResponse) GetJobs() []*JobInfo {
 	if x != nil {
-		return x.Job
+		return x.Jobs
 	}
 	return nil
 }
 
-type ChangeJobTimeLimitRequest struct {
+type GetJobByIdRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	JobId uint32                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	// increase or decrease time limit
-	DeltaMinutes  int64 `protobuf:"varint,2,opt,name=delta_minutes,json=deltaMinutes,proto3" json:"delta_minutes,omitempty"`
+	// required JobInfo fields
+	// The value of the string corresponds to the name of each field in JobInfo
+	Fields        []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	JobId         uint32   `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeJobTimeLimitRequest) Reset() {
-	*x = ChangeJobTimeLimitRequest{}
-	mi := &file_job_proto_msgTypes[8]
+func (x *GetJobByIdRequest) Reset() {
+	*x = GetJobByIdRequest{}
+	mi := &file_job_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeJobTimeLimitRequest) String() string {
+func (x *GetJobByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeJobTimeLimitRequest) ProtoMessage() {}
+func (*GetJobByIdRequest) ProtoMessage() {}
 
-func (x *ChangeJobTimeLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[8]
+func (x *GetJobByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,46 +1439,47 @@ func (x *ChangeJobTimeLimitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeJobTimeLimitRequest.ProtoReflect.Descriptor instead.
-func (*ChangeJobTimeLimitRequest) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetJobByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetJobByIdRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ChangeJobTimeLimitRequest) GetJobId() uint32 {
+func (x *GetJobByIdRequest) GetFields() []string {
 	if x != nil {
-		return x.JobId
+		return x.Fields
 	}
-	return 0
+	return nil
 }
 
-func (x *ChangeJobTimeLimitRequest) GetDeltaMinutes() int64 {
+func (x *GetJobByIdRequest) GetJobId() uint32 {
 	if x != nil {
-		return x.DeltaMinutes
+		return x.JobId
 	}
 	return 0
 }
 
-type ChangeJobTimeLimitResponse struct {
+type GetJobByIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *JobInfo               `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeJobTimeLimitResponse) Reset() {
-	*x = ChangeJobTimeLimitResponse{}
-	mi := &file_job_proto_msgTypes[9]
+func (x *GetJobByIdResponse) Reset() {
+	*x = GetJobByIdResponse{}
+	mi := &file_job_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeJobTimeLimitResponse) String() string {
+func (x *GetJobByIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeJobTimeLimitResponse) ProtoMessage() {}
+func (*GetJobByIdResponse) ProtoMessage() {}
 
-func (x *ChangeJobTimeLimitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[9]
+func (x *GetJobByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,33 +1490,42 @@ func (x *ChangeJobTimeLimitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeJobTimeLimitResponse.ProtoReflect.Descriptor instead.
-func (*ChangeJobTimeLimitResponse) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetJobByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetJobByIdResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{11}
 }
 
-type QueryJobTimeLimitRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         uint32                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+func (x *GetJobByIdResponse) GetJob() *JobInfo {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ChangeJobTimeLimitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	JobId uint32                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// increase or decrease time limit
+	DeltaMinutes  int64 `protobuf:"varint,2,opt,name=delta_minutes,json=deltaMinutes,proto3" json:"delta_minutes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryJobTimeLimitRequest) Reset() {
-	*x = QueryJobTimeLimitRequest{}
-	mi := &file_job_proto_msgTypes[10]
+func (x *ChangeJobTimeLimitRequest) Reset() {
+	*x = ChangeJobTimeLimitRequest{}
+	mi := &file_job_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryJobTimeLimitRequest) String() string {
+func (x *ChangeJobTimeLimitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryJobTimeLimitRequest) ProtoMessage() {}
+func (*ChangeJobTimeLimitRequest) ProtoMessage() {}
 
-func (x *QueryJobTimeLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[10]
+func (x *ChangeJobTimeLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,40 +1536,46 @@ func (x *QueryJobTimeLimitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryJobTimeLimitRequest.ProtoReflect.Descriptor instead.
-func (*QueryJobTimeLimitRequest) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ChangeJobTimeLimitRequest.ProtoReflect.Descriptor instead.
+func (*ChangeJobTimeLimitRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QueryJobTimeLimitRequest) GetJobId() uint32 {
+func (x *ChangeJobTimeLimitRequest) GetJobId() uint32 {
 	if x != nil {
 		return x.JobId
 	}
 	return 0
 }
 
-type QueryJobTimeLimitResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	TimeLimitMinutes uint64                 `protobuf:"varint,1,opt,name=time_limit_minutes,json=timeLimitMinutes,proto3" json:"time_limit_minutes,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *ChangeJobTimeLimitRequest) GetDeltaMinutes() int64 {
+	if x != nil {
+		return x.DeltaMinutes
+	}
+	return 0
 }
 
-func (x *QueryJobTimeLimitResponse) Reset() {
-	*x = QueryJobTimeLimitResponse{}
-	mi := &file_job_proto_msgTypes[11]
+type ChangeJobTimeLimitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeJobTimeLimitResponse) Reset() {
+	*x = ChangeJobTimeLimitResponse{}
+	mi := &file_job_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryJobTimeLimitResponse) String() string {
+func (x *ChangeJobTimeLimitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryJobTimeLimitResponse) ProtoMessage() {}
+func (*ChangeJobTimeLimitResponse) ProtoMessage() {}
 
-func (x *QueryJobTimeLimitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[11]
+func (x *ChangeJobTimeLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,59 +1586,45 @@ func (x *QueryJobTimeLimitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryJobTimeLimitResponse.ProtoReflect.Descriptor instead.
-func (*QueryJobTimeLimitResponse) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *QueryJobTimeLimitResponse) GetTimeLimitMinutes() uint64 {
-	if x != nil {
-		return x.TimeLimitMinutes
-	}
-	return 0
+// Deprecated: Use ChangeJobTimeLimitResponse.ProtoReflect.Descriptor instead.
+func (*ChangeJobTimeLimitResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{13}
 }
 
-type SubmitJobRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	UserId  string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	JobName string                 `protobuf:"bytes,2,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	Account string                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
-	// if not set, use a default partition
-	Partition string  `protobuf:"bytes,4,opt,name=partition,proto3" json:"partition,omitempty"`
-	Qos       *string `protobuf:"bytes,5,opt,name=qos,proto3,oneof" json:"qos,omitempty"`
-	NodeCount uint32  `protobuf:"varint,6,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
-	GpuCount  uint32  `protobuf:"varint,7,opt,name=gpu_count,json=gpuCount,proto3" json:"gpu_count,omitempty"`
-	// if not set, use default memory size
-	MemoryMb         *uint64 `protobuf:"varint,8,opt,name=memory_mb,json=memoryMb,proto3,oneof" json:"memory_mb,omitempty"`
-	CoreCount        uint32  `protobuf:"varint,9,opt,name=core_count,json=coreCount,proto3" json:"core_count,omitempty"`
-	TimeLimitMinutes *uint32 `protobuf:"varint,10,opt,name=time_limit_minutes,json=timeLimitMinutes,proto3,oneof" json:"time_limit_minutes,omitempty"`
-	Script           string  `protobuf:"bytes,11,opt,name=script,proto3" json:"script,omitempty"`
-	WorkingDirectory string  `protobuf:"bytes,12,opt,name=working_directory,json=workingDirectory,proto3" json:"working_directory,omitempty"`
-	// relative to working directory
-	Stdout *string `protobuf:"bytes,13,opt,name=stdout,proto3,oneof" json:"stdout,omitempty"`
-	// relative to working directory
-	Stderr *string `protobuf:"bytes,14,opt,name=stderr,proto3,oneof" json:"stderr,omitempty"`
-	// extra options when submitting job
-	ExtraOptions  []string `protobuf:"bytes,15,rep,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
+// only the set fields are changed, the others keep their current values
+type UpdateJobRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	JobId     uint32                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Partition *string                `protobuf:"bytes,2,opt,name=partition,proto3,oneof" json:"partition,omitempty"`
+	Qos       *string                `protobuf:"bytes,3,opt,name=qos,proto3,oneof" json:"qos,omitempty"`
+	NodeCount *uint32                `protobuf:"varint,4,opt,name=node_count,json=nodeCount,proto3,oneof" json:"node_count,omitempty"`
+	// total cpus of the job
+	Cpus *uint32 `protobuf:"varint,5,opt,name=cpus,proto3,oneof" json:"cpus,omitempty"`
+	// gpus per node
+	Gpus *uint32 `protobuf:"varint,6,opt,name=gpus,proto3,oneof" json:"gpus,omitempty"`
+	// memory per node
+	MemoryMb      *uint64 `protobuf:"varint,7,opt,name=memory_mb,json=memoryMb,proto3,oneof" json:"memory_mb,omitempty"`
+	Name          *string `protobuf:"bytes,8,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Comment       *string `protobuf:"bytes,9,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitJobRequest) Reset() {
-	*x = SubmitJobRequest{}
-	mi := &file_job_proto_msgTypes[12]
+func (x *UpdateJobRequest) Reset() {
+	*x = UpdateJobRequest{}
+	mi := &file_job_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitJobRequest) String() string {
+func (x *UpdateJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitJobRequest) ProtoMessage() {}
+func (*UpdateJobRequest) ProtoMessage() {}
 
-func (x *SubmitJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[12]
+func (x *UpdateJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -943,139 +1635,141 @@ func (x *SubmitJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitJobRequest.ProtoReflect.Descriptor instead.
-func (*SubmitJobRequest) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *SubmitJobRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *SubmitJobRequest) GetJobName() string {
-	if x != nil {
-		return x.JobName
-	}
-	return ""
+// Deprecated: Use UpdateJobRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJobRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SubmitJobRequest) GetAccount() string {
+func (x *UpdateJobRequest) GetJobId() uint32 {
 	if x != nil {
-		return x.Account
+		return x.JobId
 	}
-	return ""
+	return 0
 }
 
-func (x *SubmitJobRequest) GetPartition() string {
-	if x != nil {
-		return x.Partition
+func (x *UpdateJobRequest) GetPartition() string {
+	if x != nil && x.Partition != nil {
+		return *x.Partition
 	}
 	return ""
 }
 
-func (x *SubmitJobRequest) GetQos() string {
+func (x *UpdateJobRequest) GetQos() string {
 	if x != nil && x.Qos != nil {
 		return *x.Qos
 	}
 	return ""
 }
 
-func (x *SubmitJobRequest) GetNodeCount() uint32 {
-	if x != nil {
-		return x.NodeCount
+func (x *UpdateJobRequest) GetNodeCount() uint32 {
+	if x != nil && x.NodeCount != nil {
+		return *x.NodeCount
 	}
 	return 0
 }
 
-func (x *SubmitJobRequest) GetGpuCount() uint32 {
-	if x != nil {
-		return x.GpuCount
+func (x *UpdateJobRequest) GetCpus() uint32 {
+	if x != nil && x.Cpus != nil {
+		return *x.Cpus
 	}
 	return 0
 }
 
-func (x *SubmitJobRequest) GetMemoryMb() uint64 {
-	if x != nil && x.MemoryMb != nil {
-		return *x.MemoryMb
+func (x *UpdateJobRequest) GetGpus() uint32 {
+	if x != nil && x.Gpus != nil {
+		return *x.Gpus
 	}
 	return 0
 }
 
-func (x *SubmitJobRequest) GetCoreCount() uint32 {
-	if x != nil {
-		return x.CoreCount
+func (x *UpdateJobRequest) GetMemoryMb() uint64 {
+	if x != nil && x.MemoryMb != nil {
+		return *x.MemoryMb
 	}
 	return 0
 }
 
-func (x *SubmitJobRequest) GetTimeLimitMinutes() uint32 {
-	if x != nil && x.TimeLimitMinutes != nil {
-		return *x.TimeLimitMinutes
+func (x *UpdateJobRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *SubmitJobRequest) GetScript() string {
-	if x != nil {
-		return x.Script
+func (x *UpdateJobRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
 	}
 	return ""
 }
 
-func (x *SubmitJobRequest) GetWorkingDirectory() string {
-	if x != nil {
-		return x.WorkingDirectory
-	}
-	return ""
+type UpdateJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// fields not listed here have been changed
+	RejectedFields []*UpdateJobResponse_RejectedField `protobuf:"bytes,1,rep,name=rejected_fields,json=rejectedFields,proto3" json:"rejected_fields,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SubmitJobRequest) GetStdout() string {
-	if x != nil && x.Stdout != nil {
-		return *x.Stdout
-	}
-	return ""
+func (x *UpdateJobResponse) Reset() {
+	*x = UpdateJobResponse{}
+	mi := &file_job_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitJobRequest) GetStderr() string {
-	if x != nil && x.Stderr != nil {
-		return *x.Stderr
+func (x *UpdateJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobResponse) ProtoMessage() {}
+
+func (x *UpdateJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SubmitJobRequest) GetExtraOptions() []string {
+// Deprecated: Use UpdateJobResponse.ProtoReflect.Descriptor instead.
+func (*UpdateJobResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateJobResponse) GetRejectedFields() []*UpdateJobResponse_RejectedField {
 	if x != nil {
-		return x.ExtraOptions
+		return x.RejectedFields
 	}
 	return nil
 }
 
-type SubmitJobResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	JobId           uint32                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	GeneratedScript string                 `protobuf:"bytes,2,opt,name=generated_script,json=generatedScript,proto3" json:"generated_script,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetJobPriorityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint32                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitJobResponse) Reset() {
-	*x = SubmitJobResponse{}
-	mi := &file_job_proto_msgTypes[13]
+func (x *GetJobPriorityRequest) Reset() {
+	*x = GetJobPriorityRequest{}
+	mi := &file_job_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitJobResponse) String() string {
+func (x *GetJobPriorityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitJobResponse) ProtoMessage() {}
+func (*GetJobPriorityRequest) ProtoMessage() {}
 
-func (x *SubmitJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[13]
+func (x *GetJobPriorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,9 +1780,616 @@ func (x *SubmitJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitJobResponse.ProtoReflect.Descriptor instead.
-func (*SubmitJobResponse) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetJobPriorityRequest.ProtoReflect.Descriptor instead.
+func (*GetJobPriorityRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetJobPriorityRequest) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+// weighted priority factors of a pending job in one of its partitions, as shown by sprio
+type JobPriority struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Partition       string                 `protobuf:"bytes,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	Priority        int64                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Age             int64                  `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`
+	Fairshare       int64                  `protobuf:"varint,4,opt,name=fairshare,proto3" json:"fairshare,omitempty"`
+	JobSize         int64                  `protobuf:"varint,5,opt,name=job_size,json=jobSize,proto3" json:"job_size,omitempty"`
+	PartitionFactor int64                  `protobuf:"varint,6,opt,name=partition_factor,json=partitionFactor,proto3" json:"partition_factor,omitempty"`
+	Qos             int64                  `protobuf:"varint,7,opt,name=qos,proto3" json:"qos,omitempty"`
+	Nice            int64                  `protobuf:"varint,8,opt,name=nice,proto3" json:"nice,omitempty"`
+	// e.g. cpu, mem, gres/gpu
+	Tres map[string]int64 `protobuf:"bytes,9,rep,name=tres,proto3" json:"tres,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// 1 for the job with the highest priority in the partition
+	Rank uint32 `protobuf:"varint,10,opt,name=rank,proto3" json:"rank,omitempty"`
+	// number of pending jobs in the partition
+	PendingCount  uint32 `protobuf:"varint,11,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobPriority) Reset() {
+	*x = JobPriority{}
+	mi := &file_job_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobPriority) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobPriority) ProtoMessage() {}
+
+func (x *JobPriority) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobPriority.ProtoReflect.Descriptor instead.
+func (*JobPriority) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *JobPriority) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *JobPriority) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *JobPriority) GetAge() int64 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *JobPriority) GetFairshare() int64 {
+	if x != nil {
+		return x.Fairshare
+	}
+	return 0
+}
+
+func (x *JobPriority) GetJobSize() int64 {
+	if x != nil {
+		return x.JobSize
+	}
+	return 0
+}
+
+func (x *JobPriority) GetPartitionFactor() int64 {
+	if x != nil {
+		return x.PartitionFactor
+	}
+	return 0
+}
+
+func (x *JobPriority) GetQos() int64 {
+	if x != nil {
+		return x.Qos
+	}
+	return 0
+}
+
+func (x *JobPriority) GetNice() int64 {
+	if x != nil {
+		return x.Nice
+	}
+	return 0
+}
+
+func (x *JobPriority) GetTres() map[string]int64 {
+	if x != nil {
+		return x.Tres
+	}
+	return nil
+}
+
+func (x *JobPriority) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *JobPriority) GetPendingCount() uint32 {
+	if x != nil {
+		return x.PendingCount
+	}
+	return 0
+}
+
+type GetJobPriorityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// one for each partition the job is submitted to,
+	// empty if slurm does not list the job, e.g. a held job
+	Priorities    []*JobPriority `protobuf:"bytes,1,rep,name=priorities,proto3" json:"priorities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobPriorityResponse) Reset() {
+	*x = GetJobPriorityResponse{}
+	mi := &file_job_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobPriorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobPriorityResponse) ProtoMessage() {}
+
+func (x *GetJobPriorityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobPriorityResponse.ProtoReflect.Descriptor instead.
+func (*GetJobPriorityResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetJobPriorityResponse) GetPriorities() []*JobPriority {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+type QueryJobTimeLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint32                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryJobTimeLimitRequest) Reset() {
+	*x = QueryJobTimeLimitRequest{}
+	mi := &file_job_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryJobTimeLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryJobTimeLimitRequest) ProtoMessage() {}
+
+func (x *QueryJobTimeLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryJobTimeLimitRequest.ProtoReflect.Descriptor instead.
+func (*QueryJobTimeLimitRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueryJobTimeLimitRequest) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type QueryJobTimeLimitResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TimeLimitMinutes uint64                 `protobuf:"varint,1,opt,name=time_limit_minutes,json=timeLimitMinutes,proto3" json:"time_limit_minutes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *QueryJobTimeLimitResponse) Reset() {
+	*x = QueryJobTimeLimitResponse{}
+	mi := &file_job_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryJobTimeLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryJobTimeLimitResponse) ProtoMessage() {}
+
+func (x *QueryJobTimeLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryJobTimeLimitResponse.ProtoReflect.Descriptor instead.
+func (*QueryJobTimeLimitResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QueryJobTimeLimitResponse) GetTimeLimitMinutes() uint64 {
+	if x != nil {
+		return x.TimeLimitMinutes
+	}
+	return 0
+}
+
+type SubmitJobRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserId  string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	JobName string                 `protobuf:"bytes,2,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Account string                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// if not set, use a default partition
+	Partition string  `protobuf:"bytes,4,opt,name=partition,proto3" json:"partition,omitempty"`
+	Qos       *string `protobuf:"bytes,5,opt,name=qos,proto3,oneof" json:"qos,omitempty"`
+	NodeCount uint32  `protobuf:"varint,6,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	GpuCount  uint32  `protobuf:"varint,7,opt,name=gpu_count,json=gpuCount,proto3" json:"gpu_count,omitempty"`
+	// if not set, use default memory size
+	MemoryMb         *uint64 `protobuf:"varint,8,opt,name=memory_mb,json=memoryMb,proto3,oneof" json:"memory_mb,omitempty"`
+	CoreCount        uint32  `protobuf:"varint,9,opt,name=core_count,json=coreCount,proto3" json:"core_count,omitempty"`
+	TimeLimitMinutes *uint32 `protobuf:"varint,10,opt,name=time_limit_minutes,json=timeLimitMinutes,proto3,oneof" json:"time_limit_minutes,omitempty"`
+	Script           string  `protobuf:"bytes,11,opt,name=script,proto3" json:"script,omitempty"`
+	WorkingDirectory string  `protobuf:"bytes,12,opt,name=working_directory,json=workingDirectory,proto3" json:"working_directory,omitempty"`
+	// relative to working directory
+	Stdout *string `protobuf:"bytes,13,opt,name=stdout,proto3,oneof" json:"stdout,omitempty"`
+	// relative to working directory
+	Stderr *string `protobuf:"bytes,14,opt,name=stderr,proto3,oneof" json:"stderr,omitempty"`
+	// extra options when submitting job
+	ExtraOptions []string `protobuf:"bytes,15,rep,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
+	// submit as a job array, same as the value of sbatch --array, e.g. 0-15:4%2
+	Array *string `protobuf:"bytes,16,opt,name=array,proto3,oneof" json:"array,omitempty"`
+	// the same as sbatch --dependency
+	Dependencies []*JobDependency `protobuf:"bytes,17,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// if true, the job can begin when any of the dependencies is satisfied,
+	// otherwise all the dependencies must be satisfied
+	DependencyAny bool `protobuf:"varint,18,opt,name=dependency_any,json=dependencyAny,proto3" json:"dependency_any,omitempty"`
+	// if true, only validate the job with sbatch --test-only without queuing it
+	DryRun bool `protobuf:"varint,19,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// submit as a heterogeneous job with one component for each element.
+	// partition, qos, node_count, core_count and gpu_count of the request are
+	// replaced by the components. cannot be used together with array
+	HetComponents []*HetJobComponent `protobuf:"bytes,20,rep,name=het_components,json=hetComponents,proto3" json:"het_components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitJobRequest) Reset() {
+	*x = SubmitJobRequest{}
+	mi := &file_job_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitJobRequest) ProtoMessage() {}
+
+func (x *SubmitJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitJobRequest.ProtoReflect.Descriptor instead.
+func (*SubmitJobRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SubmitJobRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetQos() string {
+	if x != nil && x.Qos != nil {
+		return *x.Qos
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetNodeCount() uint32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetGpuCount() uint32 {
+	if x != nil {
+		return x.GpuCount
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetMemoryMb() uint64 {
+	if x != nil && x.MemoryMb != nil {
+		return *x.MemoryMb
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetCoreCount() uint32 {
+	if x != nil {
+		return x.CoreCount
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetTimeLimitMinutes() uint32 {
+	if x != nil && x.TimeLimitMinutes != nil {
+		return *x.TimeLimitMinutes
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetWorkingDirectory() string {
+	if x != nil {
+		return x.WorkingDirectory
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetStdout() string {
+	if x != nil && x.Stdout != nil {
+		return *x.Stdout
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetStderr() string {
+	if x != nil && x.Stderr != nil {
+		return *x.Stderr
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetExtraOptions() []string {
+	if x != nil {
+		return x.ExtraOptions
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetArray() string {
+	if x != nil && x.Array != nil {
+		return *x.Array
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetDependencies() []*JobDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetDependencyAny() bool {
+	if x != nil {
+		return x.DependencyAny
+	}
+	return false
+}
+
+func (x *SubmitJobRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SubmitJobRequest) GetHetComponents() []*HetJobComponent {
+	if x != nil {
+		return x.HetComponents
+	}
+	return nil
+}
+
+// the resources of a heterogeneous job component, separated by #SBATCH hetjob
+type HetJobComponent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// if not set, use the partition of the job
+	Partition string `protobuf:"bytes,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	// if not set, use the qos of the job
+	Qos       *string `protobuf:"bytes,2,opt,name=qos,proto3,oneof" json:"qos,omitempty"`
+	NodeCount uint32  `protobuf:"varint,3,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	CoreCount uint32  `protobuf:"varint,4,opt,name=core_count,json=coreCount,proto3" json:"core_count,omitempty"`
+	GpuCount  uint32  `protobuf:"varint,5,opt,name=gpu_count,json=gpuCount,proto3" json:"gpu_count,omitempty"`
+	// extra #SBATCH options of this component
+	ExtraOptions  []string `protobuf:"bytes,6,rep,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HetJobComponent) Reset() {
+	*x = HetJobComponent{}
+	mi := &file_job_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HetJobComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HetJobComponent) ProtoMessage() {}
+
+func (x *HetJobComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HetJobComponent.ProtoReflect.Descriptor instead.
+func (*HetJobComponent) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *HetJobComponent) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *HetJobComponent) GetQos() string {
+	if x != nil && x.Qos != nil {
+		return *x.Qos
+	}
+	return ""
+}
+
+func (x *HetJobComponent) GetNodeCount() uint32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *HetJobComponent) GetCoreCount() uint32 {
+	if x != nil {
+		return x.CoreCount
+	}
+	return 0
+}
+
+func (x *HetJobComponent) GetGpuCount() uint32 {
+	if x != nil {
+		return x.GpuCount
+	}
+	return 0
+}
+
+func (x *HetJobComponent) GetExtraOptions() []string {
+	if x != nil {
+		return x.ExtraOptions
+	}
+	return nil
+}
+
+type SubmitJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the array job id if submitted as a job array, 0 if dry_run is true
+	JobId           uint32 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	GeneratedScript string `protobuf:"bytes,2,opt,name=generated_script,json=generatedScript,proto3" json:"generated_script,omitempty"`
+	// only set if dry_run is true
+	DryRunResult  *DryRunResult `protobuf:"bytes,3,opt,name=dry_run_result,json=dryRunResult,proto3" json:"dry_run_result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitJobResponse) Reset() {
+	*x = SubmitJobResponse{}
+	mi := &file_job_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitJobResponse) ProtoMessage() {}
+
+func (x *SubmitJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitJobResponse.ProtoReflect.Descriptor instead.
+func (*SubmitJobResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SubmitJobResponse) GetJobId() uint32 {
@@ -1098,36 +2399,3755 @@ func (x *SubmitJobResponse) GetJobId() uint32 {
 	return 0
 }
 
-func (x *SubmitJobResponse) GetGeneratedScript() string {
+func (x *SubmitJobResponse) GetGeneratedScript() string {
+	if x != nil {
+		return x.GeneratedScript
+	}
+	return ""
+}
+
+func (x *SubmitJobResponse) GetDryRunResult() *DryRunResult {
+	if x != nil {
+		return x.DryRunResult
+	}
+	return nil
+}
+
+type DryRunResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// whether slurm would accept the job
+	Accepted bool `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// the following fields are only set if accepted
+	EstimatedStartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=estimated_start_time,json=estimatedStartTime,proto3" json:"estimated_start_time,omitempty"`
+	NodeList           string                 `protobuf:"bytes,3,opt,name=node_list,json=nodeList,proto3" json:"node_list,omitempty"`
+	Partition          string                 `protobuf:"bytes,4,opt,name=partition,proto3" json:"partition,omitempty"`
+	Cpus               uint32                 `protobuf:"varint,5,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	// the reason reported by slurm if not accepted
+	RejectionReason string `protobuf:"bytes,6,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DryRunResult) Reset() {
+	*x = DryRunResult{}
+	mi := &file_job_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DryRunResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunResult) ProtoMessage() {}
+
+func (x *DryRunResult) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunResult.ProtoReflect.Descriptor instead.
+func (*DryRunResult) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DryRunResult) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *DryRunResult) GetEstimatedStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EstimatedStartTime
+	}
+	return nil
+}
+
+func (x *DryRunResult) GetNodeList() string {
+	if x != nil {
+		return x.NodeList
+	}
+	return ""
+}
+
+func (x *DryRunResult) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *DryRunResult) GetCpus() uint32 {
+	if x != nil {
+		return x.Cpus
+	}
+	return 0
+}
+
+func (x *DryRunResult) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+// size of a hypothetical job, the time limit defaults to that of the partition
+type GetNextAvailableSlotRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the estimate runs as this user, so the limits of the user and account apply
+	UserId           string  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Account          string  `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Partition        string  `protobuf:"bytes,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	Qos              *string `protobuf:"bytes,4,opt,name=qos,proto3,oneof" json:"qos,omitempty"`
+	NodeCount        uint32  `protobuf:"varint,5,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	CoreCount        uint32  `protobuf:"varint,6,opt,name=core_count,json=coreCount,proto3" json:"core_count,omitempty"`
+	GpuCount         uint32  `protobuf:"varint,7,opt,name=gpu_count,json=gpuCount,proto3" json:"gpu_count,omitempty"`
+	TimeLimitMinutes *uint32 `protobuf:"varint,8,opt,name=time_limit_minutes,json=timeLimitMinutes,proto3,oneof" json:"time_limit_minutes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetNextAvailableSlotRequest) Reset() {
+	*x = GetNextAvailableSlotRequest{}
+	mi := &file_job_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextAvailableSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextAvailableSlotRequest) ProtoMessage() {}
+
+func (x *GetNextAvailableSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextAvailableSlotRequest.ProtoReflect.Descriptor instead.
+func (*GetNextAvailableSlotRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetNextAvailableSlotRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetNextAvailableSlotRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *GetNextAvailableSlotRequest) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *GetNextAvailableSlotRequest) GetQos() string {
+	if x != nil && x.Qos != nil {
+		return *x.Qos
+	}
+	return ""
+}
+
+func (x *GetNextAvailableSlotRequest) GetNodeCount() uint32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *GetNextAvailableSlotRequest) GetCoreCount() uint32 {
+	if x != nil {
+		return x.CoreCount
+	}
+	return 0
+}
+
+func (x *GetNextAvailableSlotRequest) GetGpuCount() uint32 {
+	if x != nil {
+		return x.GpuCount
+	}
+	return 0
+}
+
+func (x *GetNextAvailableSlotRequest) GetTimeLimitMinutes() uint32 {
+	if x != nil && x.TimeLimitMinutes != nil {
+		return *x.TimeLimitMinutes
+	}
+	return 0
+}
+
+type GetNextAvailableSlotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// estimate of the backfill scheduler, as sbatch --test-only reports.
+	// the job is not submitted
+	Slot          *DryRunResult `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNextAvailableSlotResponse) Reset() {
+	*x = GetNextAvailableSlotResponse{}
+	mi := &file_job_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextAvailableSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextAvailableSlotResponse) ProtoMessage() {}
+
+func (x *GetNextAvailableSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextAvailableSlotResponse.ProtoReflect.Descriptor instead.
+func (*GetNextAvailableSlotResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetNextAvailableSlotResponse) GetSlot() *DryRunResult {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+type WorkflowJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unique name of the job in the workflow
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// user_id of the job is ignored, the job is submitted as the user of the workflow
+	Job           *SubmitJobRequest     `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	DependsOn     []*WorkflowDependency `protobuf:"bytes,3,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowJob) Reset() {
+	*x = WorkflowJob{}
+	mi := &file_job_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowJob) ProtoMessage() {}
+
+func (x *WorkflowJob) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowJob.ProtoReflect.Descriptor instead.
+func (*WorkflowJob) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WorkflowJob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkflowJob) GetJob() *SubmitJobRequest {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *WorkflowJob) GetDependsOn() []*WorkflowDependency {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+type WorkflowDependency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name of another job in the workflow
+	Name          string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          JobDependency_Type `protobuf:"varint,2,opt,name=type,proto3,enum=scow.scheduler_adapter.JobDependency_Type" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowDependency) Reset() {
+	*x = WorkflowDependency{}
+	mi := &file_job_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowDependency) ProtoMessage() {}
+
+func (x *WorkflowDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowDependency.ProtoReflect.Descriptor instead.
+func (*WorkflowDependency) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WorkflowDependency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkflowDependency) GetType() JobDependency_Type {
+	if x != nil {
+		return x.Type
+	}
+	return JobDependency_DEPENDENCY_TYPE_UNSPECIFIED
+}
+
+type SubmitWorkflowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Jobs          []*WorkflowJob         `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitWorkflowRequest) Reset() {
+	*x = SubmitWorkflowRequest{}
+	mi := &file_job_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitWorkflowRequest) ProtoMessage() {}
+
+func (x *SubmitWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*SubmitWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SubmitWorkflowRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitWorkflowRequest) GetJobs() []*WorkflowJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type SubmitWorkflowResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// in the order of submission
+	Jobs          []*WorkflowJobResult `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitWorkflowResponse) Reset() {
+	*x = SubmitWorkflowResponse{}
+	mi := &file_job_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitWorkflowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitWorkflowResponse) ProtoMessage() {}
+
+func (x *SubmitWorkflowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitWorkflowResponse.ProtoReflect.Descriptor instead.
+func (*SubmitWorkflowResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubmitWorkflowResponse) GetJobs() []*WorkflowJobResult {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type WorkflowJobResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	JobId           uint32                 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	GeneratedScript string                 `protobuf:"bytes,3,opt,name=generated_script,json=generatedScript,proto3" json:"generated_script,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WorkflowJobResult) Reset() {
+	*x = WorkflowJobResult{}
+	mi := &file_job_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowJobResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowJobResult) ProtoMessage() {}
+
+func (x *WorkflowJobResult) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowJobResult.ProtoReflect.Descriptor instead.
+func (*WorkflowJobResult) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *WorkflowJobResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkflowJobResult) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *WorkflowJobResult) GetGeneratedScript() string {
+	if x != nil {
+		return x.GeneratedScript
+	}
+	return ""
+}
+
+type JobTemplate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Partition        string                 `protobuf:"bytes,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	Qos              *string                `protobuf:"bytes,4,opt,name=qos,proto3,oneof" json:"qos,omitempty"`
+	NodeCount        uint32                 `protobuf:"varint,5,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	CoreCount        uint32                 `protobuf:"varint,6,opt,name=core_count,json=coreCount,proto3" json:"core_count,omitempty"`
+	GpuCount         uint32                 `protobuf:"varint,7,opt,name=gpu_count,json=gpuCount,proto3" json:"gpu_count,omitempty"`
+	TimeLimitMinutes *uint32                `protobuf:"varint,8,opt,name=time_limit_minutes,json=timeLimitMinutes,proto3,oneof" json:"time_limit_minutes,omitempty"`
+	ExtraOptions     []string               `protobuf:"bytes,9,rep,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
+	// {{NAME}} in the script is replaced by the value of variable NAME
+	Script string `protobuf:"bytes,10,opt,name=script,proto3" json:"script,omitempty"`
+	// default values of variables
+	Variables map[string]string `protobuf:"bytes,11,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// variables in the script without default values, must be set when submitting
+	RequiredVariables []string `protobuf:"bytes,12,rep,name=required_variables,json=requiredVariables,proto3" json:"required_variables,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *JobTemplate) Reset() {
+	*x = JobTemplate{}
+	mi := &file_job_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTemplate) ProtoMessage() {}
+
+func (x *JobTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTemplate.ProtoReflect.Descriptor instead.
+func (*JobTemplate) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *JobTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetQos() string {
+	if x != nil && x.Qos != nil {
+		return *x.Qos
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetNodeCount() uint32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *JobTemplate) GetCoreCount() uint32 {
+	if x != nil {
+		return x.CoreCount
+	}
+	return 0
+}
+
+func (x *JobTemplate) GetGpuCount() uint32 {
+	if x != nil {
+		return x.GpuCount
+	}
+	return 0
+}
+
+func (x *JobTemplate) GetTimeLimitMinutes() uint32 {
+	if x != nil && x.TimeLimitMinutes != nil {
+		return *x.TimeLimitMinutes
+	}
+	return 0
+}
+
+func (x *JobTemplate) GetExtraOptions() []string {
+	if x != nil {
+		return x.ExtraOptions
+	}
+	return nil
+}
+
+func (x *JobTemplate) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *JobTemplate) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *JobTemplate) GetRequiredVariables() []string {
+	if x != nil {
+		return x.RequiredVariables
+	}
+	return nil
+}
+
+type ListJobTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobTemplatesRequest) Reset() {
+	*x = ListJobTemplatesRequest{}
+	mi := &file_job_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobTemplatesRequest) ProtoMessage() {}
+
+func (x *ListJobTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListJobTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{33}
+}
+
+type ListJobTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*JobTemplate         `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobTemplatesResponse) Reset() {
+	*x = ListJobTemplatesResponse{}
+	mi := &file_job_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobTemplatesResponse) ProtoMessage() {}
+
+func (x *ListJobTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListJobTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListJobTemplatesResponse) GetTemplates() []*JobTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type SubmitJobFromTemplateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TemplateName     string                 `protobuf:"bytes,2,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+	JobName          string                 `protobuf:"bytes,3,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Account          string                 `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	WorkingDirectory string                 `protobuf:"bytes,5,opt,name=working_directory,json=workingDirectory,proto3" json:"working_directory,omitempty"`
+	// values of the variables in the template script
+	Variables map[string]string `protobuf:"bytes,6,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// the following fields override the defaults of the template if set
+	Partition        *string `protobuf:"bytes,7,opt,name=partition,proto3,oneof" json:"partition,omitempty"`
+	Qos              *string `protobuf:"bytes,8,opt,name=qos,proto3,oneof" json:"qos,omitempty"`
+	NodeCount        *uint32 `protobuf:"varint,9,opt,name=node_count,json=nodeCount,proto3,oneof" json:"node_count,omitempty"`
+	CoreCount        *uint32 `protobuf:"varint,10,opt,name=core_count,json=coreCount,proto3,oneof" json:"core_count,omitempty"`
+	GpuCount         *uint32 `protobuf:"varint,11,opt,name=gpu_count,json=gpuCount,proto3,oneof" json:"gpu_count,omitempty"`
+	TimeLimitMinutes *uint32 `protobuf:"varint,12,opt,name=time_limit_minutes,json=timeLimitMinutes,proto3,oneof" json:"time_limit_minutes,omitempty"`
+	// relative to working directory
+	Stdout *string `protobuf:"bytes,13,opt,name=stdout,proto3,oneof" json:"stdout,omitempty"`
+	// relative to working directory
+	Stderr *string `protobuf:"bytes,14,opt,name=stderr,proto3,oneof" json:"stderr,omitempty"`
+	// appended to the extra options of the template
+	ExtraOptions  []string         `protobuf:"bytes,15,rep,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
+	Array         *string          `protobuf:"bytes,16,opt,name=array,proto3,oneof" json:"array,omitempty"`
+	Dependencies  []*JobDependency `protobuf:"bytes,17,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	DryRun        bool             `protobuf:"varint,18,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitJobFromTemplateRequest) Reset() {
+	*x = SubmitJobFromTemplateRequest{}
+	mi := &file_job_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitJobFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitJobFromTemplateRequest) ProtoMessage() {}
+
+func (x *SubmitJobFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitJobFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SubmitJobFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SubmitJobFromTemplateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateRequest) GetTemplateName() string {
+	if x != nil {
+		return x.TemplateName
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateRequest) GetWorkingDirectory() string {
+	if x != nil {
+		return x.WorkingDirectory
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *SubmitJobFromTemplateRequest) GetPartition() string {
+	if x != nil && x.Partition != nil {
+		return *x.Partition
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateRequest) GetQos() string {
+	if x != nil && x.Qos != nil {
+		return *x.Qos
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateRequest) GetNodeCount() uint32 {
+	if x != nil && x.NodeCount != nil {
+		return *x.NodeCount
+	}
+	return 0
+}
+
+func (x *SubmitJobFromTemplateRequest) GetCoreCount() uint32 {
+	if x != nil && x.CoreCount != nil {
+		return *x.CoreCount
+	}
+	return 0
+}
+
+func (x *SubmitJobFromTemplateRequest) GetGpuCount() uint32 {
+	if x != nil && x.GpuCount != nil {
+		return *x.GpuCount
+	}
+	return 0
+}
+
+func (x *SubmitJobFromTemplateRequest) GetTimeLimitMinutes() uint32 {
+	if x != nil && x.TimeLimitMinutes != nil {
+		return *x.TimeLimitMinutes
+	}
+	return 0
+}
+
+func (x *SubmitJobFromTemplateRequest) GetStdout() string {
+	if x != nil && x.Stdout != nil {
+		return *x.Stdout
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateRequest) GetStderr() string {
+	if x != nil && x.Stderr != nil {
+		return *x.Stderr
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateRequest) GetExtraOptions() []string {
+	if x != nil {
+		return x.ExtraOptions
+	}
+	return nil
+}
+
+func (x *SubmitJobFromTemplateRequest) GetArray() string {
+	if x != nil && x.Array != nil {
+		return *x.Array
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateRequest) GetDependencies() []*JobDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *SubmitJobFromTemplateRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SubmitJobFromTemplateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 if dry_run is true
+	JobId           uint32 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	GeneratedScript string `protobuf:"bytes,2,opt,name=generated_script,json=generatedScript,proto3" json:"generated_script,omitempty"`
+	// only set if dry_run is true
+	DryRunResult  *DryRunResult `protobuf:"bytes,3,opt,name=dry_run_result,json=dryRunResult,proto3" json:"dry_run_result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitJobFromTemplateResponse) Reset() {
+	*x = SubmitJobFromTemplateResponse{}
+	mi := &file_job_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitJobFromTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitJobFromTemplateResponse) ProtoMessage() {}
+
+func (x *SubmitJobFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitJobFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SubmitJobFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SubmitJobFromTemplateResponse) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *SubmitJobFromTemplateResponse) GetGeneratedScript() string {
+	if x != nil {
+		return x.GeneratedScript
+	}
+	return ""
+}
+
+func (x *SubmitJobFromTemplateResponse) GetDryRunResult() *DryRunResult {
+	if x != nil {
+		return x.DryRunResult
+	}
+	return nil
+}
+
+type CancelJobRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// cancel the whole job array if job_id is an array job id
+	JobId int32 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// if set, only cancel this task of the job array specified by job_id
+	ArrayTaskId   *uint32 `protobuf:"varint,3,opt,name=array_task_id,json=arrayTaskId,proto3,oneof" json:"array_task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobRequest) Reset() {
+	*x = CancelJobRequest{}
+	mi := &file_job_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobRequest) ProtoMessage() {}
+
+func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CancelJobRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelJobRequest) GetJobId() int32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *CancelJobRequest) GetArrayTaskId() uint32 {
+	if x != nil && x.ArrayTaskId != nil {
+		return *x.ArrayTaskId
+	}
+	return 0
+}
+
+type CancelJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobResponse) Reset() {
+	*x = CancelJobResponse{}
+	mi := &file_job_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobResponse) ProtoMessage() {}
+
+func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelJobResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{38}
+}
+
+type CancelJobsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only jobs of this user are cancelled and scancel runs as the user,
+	// required unless admin is true
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// at least one filter option must be set.
+	// The logical relationship between multiple filtering options is "AND".
+	Filter *CancelJobsRequest_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// if true, only count the matched jobs without cancelling them
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// if true, cancel jobs of any user as administrator, user_id must be empty
+	Admin         bool `protobuf:"varint,4,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobsRequest) Reset() {
+	*x = CancelJobsRequest{}
+	mi := &file_job_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobsRequest) ProtoMessage() {}
+
+func (x *CancelJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobsRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobsRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CancelJobsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelJobsRequest) GetFilter() *CancelJobsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *CancelJobsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CancelJobsRequest) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+type CancelJobsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the number of matched jobs, a pending job array range counts as one
+	MatchedCount uint32 `protobuf:"varint,1,opt,name=matched_count,json=matchedCount,proto3" json:"matched_count,omitempty"`
+	// the outcome of each matched job, empty if dry_run is true
+	Results       []*CancelJobResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobsResponse) Reset() {
+	*x = CancelJobsResponse{}
+	mi := &file_job_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobsResponse) ProtoMessage() {}
+
+func (x *CancelJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobsResponse.ProtoReflect.Descriptor instead.
+func (*CancelJobsResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CancelJobsResponse) GetMatchedCount() uint32 {
+	if x != nil {
+		return x.MatchedCount
+	}
+	return 0
+}
+
+func (x *CancelJobsResponse) GetResults() []*CancelJobResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type CancelJobResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	JobId uint32                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// the task or pending task range of the job array, e.g. 3 or 2-10
+	ArrayTaskId *string `protobuf:"bytes,2,opt,name=array_task_id,json=arrayTaskId,proto3,oneof" json:"array_task_id,omitempty"`
+	User        string  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Cancelled   bool    `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// the error reported by slurm if not cancelled
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobResult) Reset() {
+	*x = CancelJobResult{}
+	mi := &file_job_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobResult) ProtoMessage() {}
+
+func (x *CancelJobResult) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobResult.ProtoReflect.Descriptor instead.
+func (*CancelJobResult) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CancelJobResult) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *CancelJobResult) GetArrayTaskId() string {
+	if x != nil && x.ArrayTaskId != nil {
+		return *x.ArrayTaskId
+	}
+	return ""
+}
+
+func (x *CancelJobResult) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *CancelJobResult) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *CancelJobResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SubmitScriptAsJobRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Script string                 `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
+	// absolute path of the script file, used as job's work directory when not specified in script
+	ScriptFileFullPath *string `protobuf:"bytes,3,opt,name=script_file_full_path,json=scriptFileFullPath,proto3,oneof" json:"script_file_full_path,omitempty"`
+	// if true, only validate the job with sbatch --test-only without queuing it
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitScriptAsJobRequest) Reset() {
+	*x = SubmitScriptAsJobRequest{}
+	mi := &file_job_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitScriptAsJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitScriptAsJobRequest) ProtoMessage() {}
+
+func (x *SubmitScriptAsJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitScriptAsJobRequest.ProtoReflect.Descriptor instead.
+func (*SubmitScriptAsJobRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SubmitScriptAsJobRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitScriptAsJobRequest) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *SubmitScriptAsJobRequest) GetScriptFileFullPath() string {
+	if x != nil && x.ScriptFileFullPath != nil {
+		return *x.ScriptFileFullPath
+	}
+	return ""
+}
+
+func (x *SubmitScriptAsJobRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SubmitScriptAsJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 if dry_run is true
+	JobId uint32 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// only set if dry_run is true
+	DryRunResult  *DryRunResult `protobuf:"bytes,2,opt,name=dry_run_result,json=dryRunResult,proto3" json:"dry_run_result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitScriptAsJobResponse) Reset() {
+	*x = SubmitScriptAsJobResponse{}
+	mi := &file_job_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitScriptAsJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitScriptAsJobResponse) ProtoMessage() {}
+
+func (x *SubmitScriptAsJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitScriptAsJobResponse.ProtoReflect.Descriptor instead.
+func (*SubmitScriptAsJobResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SubmitScriptAsJobResponse) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *SubmitScriptAsJobResponse) GetDryRunResult() *DryRunResult {
+	if x != nil {
+		return x.DryRunResult
+	}
+	return nil
+}
+
+type JobStepInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// step id without the job id, e.g. 0, 1, batch, extern
+	StepId     string                 `protobuf:"bytes,1,opt,name=step_id,json=stepId,proto3" json:"step_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	State      string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	NodeList   string                 `protobuf:"bytes,4,opt,name=node_list,json=nodeList,proto3" json:"node_list,omitempty"`
+	NodesAlloc int32                  `protobuf:"varint,5,opt,name=nodes_alloc,json=nodesAlloc,proto3" json:"nodes_alloc,omitempty"`
+	TaskCount  int32                  `protobuf:"varint,6,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	StartTime  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	// running time excluding suspended time
+	ElapsedSeconds int64 `protobuf:"varint,9,opt,name=elapsed_seconds,json=elapsedSeconds,proto3" json:"elapsed_seconds,omitempty"`
+	// only set for ended steps
+	ExitCode   *int32 `protobuf:"varint,10,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
+	ExitSignal *int32 `protobuf:"varint,11,opt,name=exit_signal,json=exitSignal,proto3,oneof" json:"exit_signal,omitempty"`
+	// allocated TRES, memory in MB, e.g. {cpu: 4, mem: 4096, node: 1, gres/gpu: 1}
+	TresAlloc map[string]uint64 `protobuf:"bytes,12,rep,name=tres_alloc,json=tresAlloc,proto3" json:"tres_alloc,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// the max usage of TRES among tasks, cpu time in milliseconds and
+	// memory in bytes. updated from live data for running steps
+	TresUsageInMax map[string]uint64 `protobuf:"bytes,13,rep,name=tres_usage_in_max,json=tresUsageInMax,proto3" json:"tres_usage_in_max,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// the average usage of TRES among tasks, same units as tres_usage_in_max
+	TresUsageInAve map[string]uint64 `protobuf:"bytes,14,rep,name=tres_usage_in_ave,json=tresUsageInAve,proto3" json:"tres_usage_in_ave,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// the total usage of TRES of all tasks, same units as tres_usage_in_max
+	TresUsageInTot map[string]uint64 `protobuf:"bytes,15,rep,name=tres_usage_in_tot,json=tresUsageInTot,proto3" json:"tres_usage_in_tot,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// user and system cpu time, only set for ended steps
+	CpuTimeSeconds int64 `protobuf:"varint,16,opt,name=cpu_time_seconds,json=cpuTimeSeconds,proto3" json:"cpu_time_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JobStepInfo) Reset() {
+	*x = JobStepInfo{}
+	mi := &file_job_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobStepInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobStepInfo) ProtoMessage() {}
+
+func (x *JobStepInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobStepInfo.ProtoReflect.Descriptor instead.
+func (*JobStepInfo) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *JobStepInfo) GetStepId() string {
+	if x != nil {
+		return x.StepId
+	}
+	return ""
+}
+
+func (x *JobStepInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JobStepInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *JobStepInfo) GetNodeList() string {
+	if x != nil {
+		return x.NodeList
+	}
+	return ""
+}
+
+func (x *JobStepInfo) GetNodesAlloc() int32 {
+	if x != nil {
+		return x.NodesAlloc
+	}
+	return 0
+}
+
+func (x *JobStepInfo) GetTaskCount() int32 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *JobStepInfo) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *JobStepInfo) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *JobStepInfo) GetElapsedSeconds() int64 {
+	if x != nil {
+		return x.ElapsedSeconds
+	}
+	return 0
+}
+
+func (x *JobStepInfo) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+func (x *JobStepInfo) GetExitSignal() int32 {
+	if x != nil && x.ExitSignal != nil {
+		return *x.ExitSignal
+	}
+	return 0
+}
+
+func (x *JobStepInfo) GetTresAlloc() map[string]uint64 {
+	if x != nil {
+		return x.TresAlloc
+	}
+	return nil
+}
+
+func (x *JobStepInfo) GetTresUsageInMax() map[string]uint64 {
+	if x != nil {
+		return x.TresUsageInMax
+	}
+	return nil
+}
+
+func (x *JobStepInfo) GetTresUsageInAve() map[string]uint64 {
+	if x != nil {
+		return x.TresUsageInAve
+	}
+	return nil
+}
+
+func (x *JobStepInfo) GetTresUsageInTot() map[string]uint64 {
+	if x != nil {
+		return x.TresUsageInTot
+	}
+	return nil
+}
+
+func (x *JobStepInfo) GetCpuTimeSeconds() int64 {
+	if x != nil {
+		return x.CpuTimeSeconds
+	}
+	return 0
+}
+
+type HoldJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the owner of the job, required unless admin is true
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	JobId  uint32 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// if set, only hold this task of the job array specified by job_id
+	ArrayTaskId *uint32 `protobuf:"varint,3,opt,name=array_task_id,json=arrayTaskId,proto3,oneof" json:"array_task_id,omitempty"`
+	// recorded as the admin comment of the job, only allowed when admin is true
+	Reason *string `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// if true, hold any job as administrator, user_id must be empty
+	Admin         bool `protobuf:"varint,5,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldJobRequest) Reset() {
+	*x = HoldJobRequest{}
+	mi := &file_job_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldJobRequest) ProtoMessage() {}
+
+func (x *HoldJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldJobRequest.ProtoReflect.Descriptor instead.
+func (*HoldJobRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *HoldJobRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HoldJobRequest) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *HoldJobRequest) GetArrayTaskId() uint32 {
+	if x != nil && x.ArrayTaskId != nil {
+		return *x.ArrayTaskId
+	}
+	return 0
+}
+
+func (x *HoldJobRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *HoldJobRequest) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+type HoldJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the state of the job after the operation
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// pending or suspension reason, e.g. JobHeldUser
+	StateReason   string `protobuf:"bytes,2,opt,name=state_reason,json=stateReason,proto3" json:"state_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldJobResponse) Reset() {
+	*x = HoldJobResponse{}
+	mi := &file_job_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldJobResponse) ProtoMessage() {}
+
+func (x *HoldJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldJobResponse.ProtoReflect.Descriptor instead.
+func (*HoldJobResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *HoldJobResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *HoldJobResponse) GetStateReason() string {
+	if x != nil {
+		return x.StateReason
+	}
+	return ""
+}
+
+type ReleaseJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the owner of the job, required unless admin is true
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	JobId  uint32 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// if set, only release this task of the job array specified by job_id
+	ArrayTaskId *uint32 `protobuf:"varint,3,opt,name=array_task_id,json=arrayTaskId,proto3,oneof" json:"array_task_id,omitempty"`
+	// recorded as the admin comment of the job, only allowed when admin is true
+	Reason *string `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// if true, release any job as administrator, user_id must be empty
+	Admin         bool `protobuf:"varint,5,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseJobRequest) Reset() {
+	*x = ReleaseJobRequest{}
+	mi := &file_job_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseJobRequest) ProtoMessage() {}
+
+func (x *ReleaseJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseJobRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseJobRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReleaseJobRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReleaseJobRequest) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *ReleaseJobRequest) GetArrayTaskId() uint32 {
+	if x != nil && x.ArrayTaskId != nil {
+		return *x.ArrayTaskId
+	}
+	return 0
+}
+
+func (x *ReleaseJobRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ReleaseJobRequest) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+type ReleaseJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the state of the job after the operation
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// pending or suspension reason, e.g. JobHeldUser
+	StateReason   string `protobuf:"bytes,2,opt,name=state_reason,json=stateReason,proto3" json:"state_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseJobResponse) Reset() {
+	*x = ReleaseJobResponse{}
+	mi := &file_job_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseJobResponse) ProtoMessage() {}
+
+func (x *ReleaseJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseJobResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseJobResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReleaseJobResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ReleaseJobResponse) GetStateReason() string {
+	if x != nil {
+		return x.StateReason
+	}
+	return ""
+}
+
+type SuspendJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the owner of the job, required unless admin is true
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	JobId  uint32 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// if set, only suspend this task of the job array specified by job_id
+	ArrayTaskId *uint32 `protobuf:"varint,3,opt,name=array_task_id,json=arrayTaskId,proto3,oneof" json:"array_task_id,omitempty"`
+	// recorded as the admin comment of the job, only allowed when admin is true
+	Reason *string `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// if true, suspend any job as administrator, user_id must be empty
+	Admin         bool `protobuf:"varint,5,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendJobRequest) Reset() {
+	*x = SuspendJobRequest{}
+	mi := &file_job_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendJobRequest) ProtoMessage() {}
+
+func (x *SuspendJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendJobRequest.ProtoReflect.Descriptor instead.
+func (*SuspendJobRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SuspendJobRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuspendJobRequest) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *SuspendJobRequest) GetArrayTaskId() uint32 {
+	if x != nil && x.ArrayTaskId != nil {
+		return *x.ArrayTaskId
+	}
+	return 0
+}
+
+func (x *SuspendJobRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *SuspendJobRequest) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+type SuspendJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the state of the job after the operation
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// pending or suspension reason, e.g. JobHeldUser
+	StateReason   string `protobuf:"bytes,2,opt,name=state_reason,json=stateReason,proto3" json:"state_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendJobResponse) Reset() {
+	*x = SuspendJobResponse{}
+	mi := &file_job_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendJobResponse) ProtoMessage() {}
+
+func (x *SuspendJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendJobResponse.ProtoReflect.Descriptor instead.
+func (*SuspendJobResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SuspendJobResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SuspendJobResponse) GetStateReason() string {
+	if x != nil {
+		return x.StateReason
+	}
+	return ""
+}
+
+type ResumeJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the owner of the job, required unless admin is true
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	JobId  uint32 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// if set, only resume this task of the job array specified by job_id
+	ArrayTaskId *uint32 `protobuf:"varint,3,opt,name=array_task_id,json=arrayTaskId,proto3,oneof" json:"array_task_id,omitempty"`
+	// recorded as the admin comment of the job, only allowed when admin is true
+	Reason *string `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// if true, resume any job as administrator, user_id must be empty
+	Admin         bool `protobuf:"varint,5,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeJobRequest) Reset() {
+	*x = ResumeJobRequest{}
+	mi := &file_job_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeJobRequest) ProtoMessage() {}
+
+func (x *ResumeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeJobRequest.ProtoReflect.Descriptor instead.
+func (*ResumeJobRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ResumeJobRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResumeJobRequest) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *ResumeJobRequest) GetArrayTaskId() uint32 {
+	if x != nil && x.ArrayTaskId != nil {
+		return *x.ArrayTaskId
+	}
+	return 0
+}
+
+func (x *ResumeJobRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ResumeJobRequest) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+type ResumeJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the state of the job after the operation
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// pending or suspension reason, e.g. JobHeldUser
+	StateReason   string `protobuf:"bytes,2,opt,name=state_reason,json=stateReason,proto3" json:"state_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeJobResponse) Reset() {
+	*x = ResumeJobResponse{}
+	mi := &file_job_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeJobResponse) ProtoMessage() {}
+
+func (x *ResumeJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeJobResponse.ProtoReflect.Descriptor instead.
+func (*ResumeJobResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ResumeJobResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ResumeJobResponse) GetStateReason() string {
+	if x != nil {
+		return x.StateReason
+	}
+	return ""
+}
+
+type RequeueJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the owner of the job, required unless admin is true
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	JobId  uint32 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// if set, only requeue this task of the job array specified by job_id
+	ArrayTaskId *uint32 `protobuf:"varint,3,opt,name=array_task_id,json=arrayTaskId,proto3,oneof" json:"array_task_id,omitempty"`
+	// recorded as the admin comment of the job, only allowed when admin is true
+	Reason *string `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// if true, requeue any job as administrator, user_id must be empty
+	Admin         bool `protobuf:"varint,5,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueJobRequest) Reset() {
+	*x = RequeueJobRequest{}
+	mi := &file_job_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueJobRequest) ProtoMessage() {}
+
+func (x *RequeueJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueJobRequest.ProtoReflect.Descriptor instead.
+func (*RequeueJobRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RequeueJobRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RequeueJobRequest) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *RequeueJobRequest) GetArrayTaskId() uint32 {
+	if x != nil && x.ArrayTaskId != nil {
+		return *x.ArrayTaskId
+	}
+	return 0
+}
+
+func (x *RequeueJobRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *RequeueJobRequest) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+type RequeueJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the state of the job after the operation
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// pending or suspension reason, e.g. JobHeldUser
+	StateReason   string `protobuf:"bytes,2,opt,name=state_reason,json=stateReason,proto3" json:"state_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueJobResponse) Reset() {
+	*x = RequeueJobResponse{}
+	mi := &file_job_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueJobResponse) ProtoMessage() {}
+
+func (x *RequeueJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueJobResponse.ProtoReflect.Descriptor instead.
+func (*RequeueJobResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RequeueJobResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *RequeueJobResponse) GetStateReason() string {
+	if x != nil {
+		return x.StateReason
+	}
+	return ""
+}
+
+type WatchJobsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The logical relationship between multiple filtering options is "AND".
+	Filter *WatchJobsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// if true, the current jobs matching the filter are sent as INITIAL events first
+	InitialSnapshot bool `protobuf:"varint,2,opt,name=initial_snapshot,json=initialSnapshot,proto3" json:"initial_snapshot,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchJobsRequest) Reset() {
+	*x = WatchJobsRequest{}
+	mi := &file_job_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchJobsRequest) ProtoMessage() {}
+
+func (x *WatchJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchJobsRequest.ProtoReflect.Descriptor instead.
+func (*WatchJobsRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *WatchJobsRequest) GetFilter() *WatchJobsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchJobsRequest) GetInitialSnapshot() bool {
+	if x != nil {
+		return x.InitialSnapshot
+	}
+	return false
+}
+
+type JobEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  JobEvent_Type          `protobuf:"varint,1,opt,name=type,proto3,enum=scow.scheduler_adapter.JobEvent_Type" json:"type,omitempty"`
+	Job   *JobInfo               `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	// empty for INITIAL and SUBMITTED events
+	PreviousState string `protobuf:"bytes,3,opt,name=previous_state,json=previousState,proto3" json:"previous_state,omitempty"`
+	// the pending reason before the change
+	PreviousReason string `protobuf:"bytes,4,opt,name=previous_reason,json=previousReason,proto3" json:"previous_reason,omitempty"`
+	// when the change was observed
+	Time          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobEvent) Reset() {
+	*x = JobEvent{}
+	mi := &file_job_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobEvent) ProtoMessage() {}
+
+func (x *JobEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobEvent.ProtoReflect.Descriptor instead.
+func (*JobEvent) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *JobEvent) GetType() JobEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return JobEvent_INITIAL
+}
+
+func (x *JobEvent) GetJob() *JobInfo {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *JobEvent) GetPreviousState() string {
+	if x != nil {
+		return x.PreviousState
+	}
+	return ""
+}
+
+func (x *JobEvent) GetPreviousReason() string {
+	if x != nil {
+		return x.PreviousReason
+	}
+	return ""
+}
+
+func (x *JobEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type WatchJobsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// events observed in the same poll
+	Events        []*JobEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchJobsResponse) Reset() {
+	*x = WatchJobsResponse{}
+	mi := &file_job_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchJobsResponse) ProtoMessage() {}
+
+func (x *WatchJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchJobsResponse.ProtoReflect.Descriptor instead.
+func (*WatchJobsResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *WatchJobsResponse) GetEvents() []*JobEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetJobStepsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint32                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobStepsRequest) Reset() {
+	*x = GetJobStepsRequest{}
+	mi := &file_job_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobStepsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobStepsRequest) ProtoMessage() {}
+
+func (x *GetJobStepsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobStepsRequest.ProtoReflect.Descriptor instead.
+func (*GetJobStepsRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetJobStepsRequest) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type GetJobStepsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Steps         []*JobStepInfo         `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobStepsResponse) Reset() {
+	*x = GetJobStepsResponse{}
+	mi := &file_job_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobStepsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobStepsResponse) ProtoMessage() {}
+
+func (x *GetJobStepsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobStepsResponse.ProtoReflect.Descriptor instead.
+func (*GetJobStepsResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetJobStepsResponse) GetSteps() []*JobStepInfo {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type JobEfficiency struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	JobId     uint32                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	User      string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Account   string                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	State     string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	CpusAlloc int32                  `protobuf:"varint,5,opt,name=cpus_alloc,json=cpusAlloc,proto3" json:"cpus_alloc,omitempty"`
+	// cpu time used by all steps
+	CpuUsedSeconds int64 `protobuf:"varint,6,opt,name=cpu_used_seconds,json=cpuUsedSeconds,proto3" json:"cpu_used_seconds,omitempty"`
+	// cpus_alloc * elapsed_seconds
+	CpuAllocSeconds      int64   `protobuf:"varint,7,opt,name=cpu_alloc_seconds,json=cpuAllocSeconds,proto3" json:"cpu_alloc_seconds,omitempty"`
+	CpuEfficiencyPercent float64 `protobuf:"fixed64,8,opt,name=cpu_efficiency_percent,json=cpuEfficiencyPercent,proto3" json:"cpu_efficiency_percent,omitempty"`
+	// memory allocated to the job
+	MemReqMb int64 `protobuf:"varint,9,opt,name=mem_req_mb,json=memReqMb,proto3" json:"mem_req_mb,omitempty"`
+	// the largest sum of the max memory of tasks among steps
+	MemPeakMb int64 `protobuf:"varint,10,opt,name=mem_peak_mb,json=memPeakMb,proto3" json:"mem_peak_mb,omitempty"`
+	// the largest sum of the average memory of tasks among steps
+	MemAveMb                 int64   `protobuf:"varint,11,opt,name=mem_ave_mb,json=memAveMb,proto3" json:"mem_ave_mb,omitempty"`
+	MemPeakEfficiencyPercent float64 `protobuf:"fixed64,12,opt,name=mem_peak_efficiency_percent,json=memPeakEfficiencyPercent,proto3" json:"mem_peak_efficiency_percent,omitempty"`
+	MemAveEfficiencyPercent  float64 `protobuf:"fixed64,13,opt,name=mem_ave_efficiency_percent,json=memAveEfficiencyPercent,proto3" json:"mem_ave_efficiency_percent,omitempty"`
+	ElapsedSeconds           int64   `protobuf:"varint,14,opt,name=elapsed_seconds,json=elapsedSeconds,proto3" json:"elapsed_seconds,omitempty"`
+	// 0 if the job has no time limit
+	TimeLimitMinutes int64 `protobuf:"varint,15,opt,name=time_limit_minutes,json=timeLimitMinutes,proto3" json:"time_limit_minutes,omitempty"`
+	// elapsed time against time limit, 0 if the job has no time limit
+	TimeEfficiencyPercent float64 `protobuf:"fixed64,16,opt,name=time_efficiency_percent,json=timeEfficiencyPercent,proto3" json:"time_efficiency_percent,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *JobEfficiency) Reset() {
+	*x = JobEfficiency{}
+	mi := &file_job_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobEfficiency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobEfficiency) ProtoMessage() {}
+
+func (x *JobEfficiency) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobEfficiency.ProtoReflect.Descriptor instead.
+func (*JobEfficiency) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *JobEfficiency) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *JobEfficiency) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *JobEfficiency) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *JobEfficiency) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *JobEfficiency) GetCpusAlloc() int32 {
+	if x != nil {
+		return x.CpusAlloc
+	}
+	return 0
+}
+
+func (x *JobEfficiency) GetCpuUsedSeconds() int64 {
+	if x != nil {
+		return x.CpuUsedSeconds
+	}
+	return 0
+}
+
+func (x *JobEfficiency) GetCpuAllocSeconds() int64 {
+	if x != nil {
+		return x.CpuAllocSeconds
+	}
+	return 0
+}
+
+func (x *JobEfficiency) GetCpuEfficiencyPercent() float64 {
+	if x != nil {
+		return x.CpuEfficiencyPercent
+	}
+	return 0
+}
+
+func (x *JobEfficiency) GetMemReqMb() int64 {
+	if x != nil {
+		return x.MemReqMb
+	}
+	return 0
+}
+
+func (x *JobEfficiency) GetMemPeakMb() int64 {
+	if x != nil {
+		return x.MemPeakMb
+	}
+	return 0
+}
+
+func (x *JobEfficiency) GetMemAveMb() int64 {
+	if x != nil {
+		return x.MemAveMb
+	}
+	return 0
+}
+
+func (x *JobEfficiency) GetMemPeakEfficiencyPercent() float64 {
+	if x != nil {
+		return x.MemPeakEfficiencyPercent
+	}
+	return 0
+}
+
+func (x *JobEfficiency) GetMemAveEfficiencyPercent() float64 {
+	if x != nil {
+		return x.MemAveEfficiencyPercent
+	}
+	return 0
+}
+
+func (x *JobEfficiency) GetElapsedSeconds() int64 {
+	if x != nil {
+		return x.ElapsedSeconds
+	}
+	return 0
+}
+
+func (x *JobEfficiency) GetTimeLimitMinutes() int64 {
+	if x != nil {
+		return x.TimeLimitMinutes
+	}
+	return 0
+}
+
+func (x *JobEfficiency) GetTimeEfficiencyPercent() float64 {
+	if x != nil {
+		return x.TimeEfficiencyPercent
+	}
+	return 0
+}
+
+type GetJobEfficiencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint32                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobEfficiencyRequest) Reset() {
+	*x = GetJobEfficiencyRequest{}
+	mi := &file_job_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobEfficiencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobEfficiencyRequest) ProtoMessage() {}
+
+func (x *GetJobEfficiencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobEfficiencyRequest.ProtoReflect.Descriptor instead.
+func (*GetJobEfficiencyRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetJobEfficiencyRequest) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type GetJobEfficiencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Efficiency    *JobEfficiency         `protobuf:"bytes,1,opt,name=efficiency,proto3" json:"efficiency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobEfficiencyResponse) Reset() {
+	*x = GetJobEfficiencyResponse{}
+	mi := &file_job_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobEfficiencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobEfficiencyResponse) ProtoMessage() {}
+
+func (x *GetJobEfficiencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobEfficiencyResponse.ProtoReflect.Descriptor instead.
+func (*GetJobEfficiencyResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetJobEfficiencyResponse) GetEfficiency() *JobEfficiency {
+	if x != nil {
+		return x.Efficiency
+	}
+	return nil
+}
+
+type GetEfficiencySummaryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// jobs ended in the time range are counted
+	EndTime       *TimeRange                          `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Users         []string                            `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	Accounts      []string                            `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	GroupBy       GetEfficiencySummaryRequest_GroupBy `protobuf:"varint,4,opt,name=group_by,json=groupBy,proto3,enum=scow.scheduler_adapter.GetEfficiencySummaryRequest_GroupBy" json:"group_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEfficiencySummaryRequest) Reset() {
+	*x = GetEfficiencySummaryRequest{}
+	mi := &file_job_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEfficiencySummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEfficiencySummaryRequest) ProtoMessage() {}
+
+func (x *GetEfficiencySummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEfficiencySummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetEfficiencySummaryRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetEfficiencySummaryRequest) GetEndTime() *TimeRange {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetEfficiencySummaryRequest) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetEfficiencySummaryRequest) GetAccounts() []string {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GetEfficiencySummaryRequest) GetGroupBy() GetEfficiencySummaryRequest_GroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return GetEfficiencySummaryRequest_USER
+}
+
+type EfficiencySummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user or account name according to group_by
+	Name                 string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	JobCount             uint32  `protobuf:"varint,2,opt,name=job_count,json=jobCount,proto3" json:"job_count,omitempty"`
+	CpuUsedSeconds       int64   `protobuf:"varint,3,opt,name=cpu_used_seconds,json=cpuUsedSeconds,proto3" json:"cpu_used_seconds,omitempty"`
+	CpuAllocSeconds      int64   `protobuf:"varint,4,opt,name=cpu_alloc_seconds,json=cpuAllocSeconds,proto3" json:"cpu_alloc_seconds,omitempty"`
+	CpuEfficiencyPercent float64 `protobuf:"fixed64,5,opt,name=cpu_efficiency_percent,json=cpuEfficiencyPercent,proto3" json:"cpu_efficiency_percent,omitempty"`
+	MemPeakMb            int64   `protobuf:"varint,6,opt,name=mem_peak_mb,json=memPeakMb,proto3" json:"mem_peak_mb,omitempty"`
+	MemReqMb             int64   `protobuf:"varint,7,opt,name=mem_req_mb,json=memReqMb,proto3" json:"mem_req_mb,omitempty"`
+	// total peak memory against total allocated memory
+	MemEfficiencyPercent float64 `protobuf:"fixed64,8,opt,name=mem_efficiency_percent,json=memEfficiencyPercent,proto3" json:"mem_efficiency_percent,omitempty"`
+	// only jobs with time limit are counted
+	ElapsedSeconds        int64   `protobuf:"varint,9,opt,name=elapsed_seconds,json=elapsedSeconds,proto3" json:"elapsed_seconds,omitempty"`
+	TimeLimitSeconds      int64   `protobuf:"varint,10,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3" json:"time_limit_seconds,omitempty"`
+	TimeEfficiencyPercent float64 `protobuf:"fixed64,11,opt,name=time_efficiency_percent,json=timeEfficiencyPercent,proto3" json:"time_efficiency_percent,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *EfficiencySummary) Reset() {
+	*x = EfficiencySummary{}
+	mi := &file_job_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EfficiencySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EfficiencySummary) ProtoMessage() {}
+
+func (x *EfficiencySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EfficiencySummary.ProtoReflect.Descriptor instead.
+func (*EfficiencySummary) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *EfficiencySummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EfficiencySummary) GetJobCount() uint32 {
+	if x != nil {
+		return x.JobCount
+	}
+	return 0
+}
+
+func (x *EfficiencySummary) GetCpuUsedSeconds() int64 {
+	if x != nil {
+		return x.CpuUsedSeconds
+	}
+	return 0
+}
+
+func (x *EfficiencySummary) GetCpuAllocSeconds() int64 {
+	if x != nil {
+		return x.CpuAllocSeconds
+	}
+	return 0
+}
+
+func (x *EfficiencySummary) GetCpuEfficiencyPercent() float64 {
+	if x != nil {
+		return x.CpuEfficiencyPercent
+	}
+	return 0
+}
+
+func (x *EfficiencySummary) GetMemPeakMb() int64 {
+	if x != nil {
+		return x.MemPeakMb
+	}
+	return 0
+}
+
+func (x *EfficiencySummary) GetMemReqMb() int64 {
+	if x != nil {
+		return x.MemReqMb
+	}
+	return 0
+}
+
+func (x *EfficiencySummary) GetMemEfficiencyPercent() float64 {
+	if x != nil {
+		return x.MemEfficiencyPercent
+	}
+	return 0
+}
+
+func (x *EfficiencySummary) GetElapsedSeconds() int64 {
+	if x != nil {
+		return x.ElapsedSeconds
+	}
+	return 0
+}
+
+func (x *EfficiencySummary) GetTimeLimitSeconds() int64 {
+	if x != nil {
+		return x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *EfficiencySummary) GetTimeEfficiencyPercent() float64 {
+	if x != nil {
+		return x.TimeEfficiencyPercent
+	}
+	return 0
+}
+
+type GetEfficiencySummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summaries     []*EfficiencySummary   `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEfficiencySummaryResponse) Reset() {
+	*x = GetEfficiencySummaryResponse{}
+	mi := &file_job_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEfficiencySummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEfficiencySummaryResponse) ProtoMessage() {}
+
+func (x *GetEfficiencySummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEfficiencySummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetEfficiencySummaryResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetEfficiencySummaryResponse) GetSummaries() []*EfficiencySummary {
+	if x != nil {
+		return x.Summaries
+	}
+	return nil
+}
+
+type GetUsageSummaryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// usage of jobs running in the time range is counted, jobs across the boundaries are clipped
+	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Users     []string   `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	Accounts  []string   `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// fields to group by, all jobs are summed up if empty
+	GroupBy []GetUsageSummaryRequest_GroupBy `protobuf:"varint,4,rep,packed,name=group_by,json=groupBy,proto3,enum=scow.scheduler_adapter.GetUsageSummaryRequest_GroupBy" json:"group_by,omitempty"`
+	// split the time range by the server's local time
+	Bucket        GetUsageSummaryRequest_Bucket `protobuf:"varint,5,opt,name=bucket,proto3,enum=scow.scheduler_adapter.GetUsageSummaryRequest_Bucket" json:"bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageSummaryRequest) Reset() {
+	*x = GetUsageSummaryRequest{}
+	mi := &file_job_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageSummaryRequest) ProtoMessage() {}
+
+func (x *GetUsageSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetUsageSummaryRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *GetUsageSummaryRequest) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetUsageSummaryRequest) GetAccounts() []string {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GetUsageSummaryRequest) GetGroupBy() []GetUsageSummaryRequest_GroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *GetUsageSummaryRequest) GetBucket() GetUsageSummaryRequest_Bucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return GetUsageSummaryRequest_NONE
+}
+
+type UsageSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty if not grouped by the field
+	User      string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Account   string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Partition string `protobuf:"bytes,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	Qos       string `protobuf:"bytes,4,opt,name=qos,proto3" json:"qos,omitempty"`
+	// start of the time bucket, clipped to the time range
+	BucketStart *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"`
+	// jobs running in the bucket
+	JobCount      uint32  `protobuf:"varint,6,opt,name=job_count,json=jobCount,proto3" json:"job_count,omitempty"`
+	CpuHours      float64 `protobuf:"fixed64,7,opt,name=cpu_hours,json=cpuHours,proto3" json:"cpu_hours,omitempty"`
+	GpuHours      float64 `protobuf:"fixed64,8,opt,name=gpu_hours,json=gpuHours,proto3" json:"gpu_hours,omitempty"`
+	MemGbHours    float64 `protobuf:"fixed64,9,opt,name=mem_gb_hours,json=memGbHours,proto3" json:"mem_gb_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageSummary) Reset() {
+	*x = UsageSummary{}
+	mi := &file_job_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageSummary) ProtoMessage() {}
+
+func (x *UsageSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageSummary.ProtoReflect.Descriptor instead.
+func (*UsageSummary) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UsageSummary) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *UsageSummary) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *UsageSummary) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *UsageSummary) GetQos() string {
+	if x != nil {
+		return x.Qos
+	}
+	return ""
+}
+
+func (x *UsageSummary) GetBucketStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BucketStart
+	}
+	return nil
+}
+
+func (x *UsageSummary) GetJobCount() uint32 {
+	if x != nil {
+		return x.JobCount
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetCpuHours() float64 {
+	if x != nil {
+		return x.CpuHours
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetGpuHours() float64 {
+	if x != nil {
+		return x.GpuHours
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetMemGbHours() float64 {
+	if x != nil {
+		return x.MemGbHours
+	}
+	return 0
+}
+
+type GetUsageSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summaries     []*UsageSummary        `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageSummaryResponse) Reset() {
+	*x = GetUsageSummaryResponse{}
+	mi := &file_job_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageSummaryResponse) ProtoMessage() {}
+
+func (x *GetUsageSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetUsageSummaryResponse) GetSummaries() []*UsageSummary {
+	if x != nil {
+		return x.Summaries
+	}
+	return nil
+}
+
+type GetJobCostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         uint32                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobCostRequest) Reset() {
+	*x = GetJobCostRequest{}
+	mi := &file_job_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobCostRequest) ProtoMessage() {}
+
+func (x *GetJobCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobCostRequest.ProtoReflect.Descriptor instead.
+func (*GetJobCostRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetJobCostRequest) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type JobCost struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	JobId     uint32                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	User      string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Account   string                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Partition string                 `protobuf:"bytes,4,opt,name=partition,proto3" json:"partition,omitempty"`
+	Qos       string                 `protobuf:"bytes,5,opt,name=qos,proto3" json:"qos,omitempty"`
+	CpuCost   float64                `protobuf:"fixed64,6,opt,name=cpu_cost,json=cpuCost,proto3" json:"cpu_cost,omitempty"`
+	GpuCost   float64                `protobuf:"fixed64,7,opt,name=gpu_cost,json=gpuCost,proto3" json:"gpu_cost,omitempty"`
+	MemCost   float64                `protobuf:"fixed64,8,opt,name=mem_cost,json=memCost,proto3" json:"mem_cost,omitempty"`
+	TotalCost float64                `protobuf:"fixed64,9,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	// false if no price rule matches the partition and qos of the job, the costs are 0
+	Priced        bool `protobuf:"varint,10,opt,name=priced,proto3" json:"priced,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobCost) Reset() {
+	*x = JobCost{}
+	mi := &file_job_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobCost) ProtoMessage() {}
+
+func (x *JobCost) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobCost.ProtoReflect.Descriptor instead.
+func (*JobCost) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *JobCost) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *JobCost) GetUser() string {
 	if x != nil {
-		return x.GeneratedScript
+		return x.User
 	}
 	return ""
 }
 
-type CancelJobRequest struct {
+func (x *JobCost) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *JobCost) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *JobCost) GetQos() string {
+	if x != nil {
+		return x.Qos
+	}
+	return ""
+}
+
+func (x *JobCost) GetCpuCost() float64 {
+	if x != nil {
+		return x.CpuCost
+	}
+	return 0
+}
+
+func (x *JobCost) GetGpuCost() float64 {
+	if x != nil {
+		return x.GpuCost
+	}
+	return 0
+}
+
+func (x *JobCost) GetMemCost() float64 {
+	if x != nil {
+		return x.MemCost
+	}
+	return 0
+}
+
+func (x *JobCost) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *JobCost) GetPriced() bool {
+	if x != nil {
+		return x.Priced
+	}
+	return false
+}
+
+type GetJobCostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	JobId         int32                  `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Cost          *JobCost               `protobuf:"bytes,1,opt,name=cost,proto3" json:"cost,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelJobRequest) Reset() {
-	*x = CancelJobRequest{}
-	mi := &file_job_proto_msgTypes[14]
+func (x *GetJobCostResponse) Reset() {
+	*x = GetJobCostResponse{}
+	mi := &file_job_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobCostResponse) ProtoMessage() {}
+
+func (x *GetJobCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobCostResponse.ProtoReflect.Descriptor instead.
+func (*GetJobCostResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetJobCostResponse) GetCost() *JobCost {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+type GetJobsCostRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *GetJobsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// if not set, no pagination
+	PageInfo      *PageInfo `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3,oneof" json:"page_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobsCostRequest) Reset() {
+	*x = GetJobsCostRequest{}
+	mi := &file_job_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobsCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobsCostRequest) ProtoMessage() {}
+
+func (x *GetJobsCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobsCostRequest.ProtoReflect.Descriptor instead.
+func (*GetJobsCostRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetJobsCostRequest) GetFilter() *GetJobsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetJobsCostRequest) GetPageInfo() *PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+type GetJobsCostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Costs []*JobCost             `protobuf:"bytes,1,rep,name=costs,proto3" json:"costs,omitempty"`
+	// total cost of the jobs in this page
+	TotalCost     float64 `protobuf:"fixed64,2,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	TotalCount    uint32  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobsCostResponse) Reset() {
+	*x = GetJobsCostResponse{}
+	mi := &file_job_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobsCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobsCostResponse) ProtoMessage() {}
+
+func (x *GetJobsCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobsCostResponse.ProtoReflect.Descriptor instead.
+func (*GetJobsCostResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetJobsCostResponse) GetCosts() []*JobCost {
+	if x != nil {
+		return x.Costs
+	}
+	return nil
+}
+
+func (x *GetJobsCostResponse) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *GetJobsCostResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type TailJobOutputRequest struct {
+	state      protoimpl.MessageState          `protogen:"open.v1"`
+	JobId      uint32                          `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	OutputType TailJobOutputRequest_OutputType `protobuf:"varint,2,opt,name=output_type,json=outputType,proto3,enum=scow.scheduler_adapter.TailJobOutputRequest_OutputType" json:"output_type,omitempty"`
+	// byte offset in the file to start from, pass the offset of the last
+	// received response to resume
+	Offset uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// the stream ends after sending this many bytes.
+	// if not set, use the default limit of the adapter,
+	// values larger than the maximum of the adapter are reduced to the maximum
+	MaxBytes *uint64 `protobuf:"varint,4,opt,name=max_bytes,json=maxBytes,proto3,oneof" json:"max_bytes,omitempty"`
+	// the owner of the job, the file is read as this user
+	UserId        string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TailJobOutputRequest) Reset() {
+	*x = TailJobOutputRequest{}
+	mi := &file_job_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TailJobOutputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailJobOutputRequest) ProtoMessage() {}
+
+func (x *TailJobOutputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailJobOutputRequest.ProtoReflect.Descriptor instead.
+func (*TailJobOutputRequest) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TailJobOutputRequest) GetJobId() uint32 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *TailJobOutputRequest) GetOutputType() TailJobOutputRequest_OutputType {
+	if x != nil {
+		return x.OutputType
+	}
+	return TailJobOutputRequest_STDOUT
+}
+
+func (x *TailJobOutputRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TailJobOutputRequest) GetMaxBytes() uint64 {
+	if x != nil && x.MaxBytes != nil {
+		return *x.MaxBytes
+	}
+	return 0
+}
+
+func (x *TailJobOutputRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type TailJobOutputResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Data  []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// byte offset in the file after this chunk
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// absolute path of the output file
+	Path          string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TailJobOutputResponse) Reset() {
+	*x = TailJobOutputResponse{}
+	mi := &file_job_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TailJobOutputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailJobOutputResponse) ProtoMessage() {}
+
+func (x *TailJobOutputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TailJobOutputResponse.ProtoReflect.Descriptor instead.
+func (*TailJobOutputResponse) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TailJobOutputResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TailJobOutputResponse) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TailJobOutputResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// filter options. The logical relationship between multiple filtering options is "AND".
+type GetJobsRequest_Filter struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Users    []string               `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Accounts []string               `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	States   []string               `protobuf:"bytes,3,rep,name=states,proto3" json:"states,omitempty"`
+	// if set this field, return jobs that submitted between the time range(both endpoints included)
+	SubmitTime *TimeRange `protobuf:"bytes,4,opt,name=submit_time,json=submitTime,proto3,oneof" json:"submit_time,omitempty"`
+	// if set this field, return jobs that ended between the time range(both endpoints included)
+	EndTime *TimeRange `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	JobId   *uint32    `protobuf:"varint,6,opt,name=job_id,json=jobId,proto3,oneof" json:"job_id,omitempty"`
+	JobName *string    `protobuf:"bytes,7,opt,name=job_name,json=jobName,proto3,oneof" json:"job_name,omitempty"`
+	// if set this field, return all tasks of the job array
+	ArrayJobId    *uint32 `protobuf:"varint,8,opt,name=array_job_id,json=arrayJobId,proto3,oneof" json:"array_job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobsRequest_Filter) Reset() {
+	*x = GetJobsRequest_Filter{}
+	mi := &file_job_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelJobRequest) String() string {
+func (x *GetJobsRequest_Filter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelJobRequest) ProtoMessage() {}
+func (*GetJobsRequest_Filter) ProtoMessage() {}
 
-func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[14]
+func (x *GetJobsRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1138,86 +6158,91 @@ func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
-func (*CancelJobRequest) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetJobsRequest_Filter.ProtoReflect.Descriptor instead.
+func (*GetJobsRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{6, 0}
 }
 
-func (x *CancelJobRequest) GetUserId() string {
+func (x *GetJobsRequest_Filter) GetUsers() []string {
 	if x != nil {
-		return x.UserId
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *CancelJobRequest) GetJobId() int32 {
+func (x *GetJobsRequest_Filter) GetAccounts() []string {
 	if x != nil {
-		return x.JobId
+		return x.Accounts
 	}
-	return 0
+	return nil
 }
 
-type CancelJobResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetJobsRequest_Filter) GetStates() []string {
+	if x != nil {
+		return x.States
+	}
+	return nil
 }
 
-func (x *CancelJobResponse) Reset() {
-	*x = CancelJobResponse{}
-	mi := &file_job_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetJobsRequest_Filter) GetSubmitTime() *TimeRange {
+	if x != nil {
+		return x.SubmitTime
+	}
+	return nil
 }
 
-func (x *CancelJobResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetJobsRequest_Filter) GetEndTime() *TimeRange {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
 }
 
-func (*CancelJobResponse) ProtoMessage() {}
+func (x *GetJobsRequest_Filter) GetJobId() uint32 {
+	if x != nil && x.JobId != nil {
+		return *x.JobId
+	}
+	return 0
+}
 
-func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[15]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetJobsRequest_Filter) GetJobName() string {
+	if x != nil && x.JobName != nil {
+		return *x.JobName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CancelJobResponse.ProtoReflect.Descriptor instead.
-func (*CancelJobResponse) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{15}
+func (x *GetJobsRequest_Filter) GetArrayJobId() uint32 {
+	if x != nil && x.ArrayJobId != nil {
+		return *x.ArrayJobId
+	}
+	return 0
 }
 
-type SubmitScriptAsJobRequest struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Script string                 `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
-	// absolute path of the script file, used as job's work directory when not specified in script
-	ScriptFileFullPath *string `protobuf:"bytes,3,opt,name=script_file_full_path,json=scriptFileFullPath,proto3,oneof" json:"script_file_full_path,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// name of a field in UpdateJobRequest which slurm refused to change, with the reason
+type UpdateJobResponse_RejectedField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitScriptAsJobRequest) Reset() {
-	*x = SubmitScriptAsJobRequest{}
-	mi := &file_job_proto_msgTypes[16]
+func (x *UpdateJobResponse_RejectedField) Reset() {
+	*x = UpdateJobResponse_RejectedField{}
+	mi := &file_job_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitScriptAsJobRequest) String() string {
+func (x *UpdateJobResponse_RejectedField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitScriptAsJobRequest) ProtoMessage() {}
+func (*UpdateJobResponse_RejectedField) ProtoMessage() {}
 
-func (x *SubmitScriptAsJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[16]
+func (x *UpdateJobResponse_RejectedField) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1228,54 +6253,54 @@ func (x *SubmitScriptAsJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitScriptAsJobRequest.ProtoReflect.Descriptor instead.
-func (*SubmitScriptAsJobRequest) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use UpdateJobResponse_RejectedField.ProtoReflect.Descriptor instead.
+func (*UpdateJobResponse_RejectedField) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{15, 0}
 }
 
-func (x *SubmitScriptAsJobRequest) GetUserId() string {
+func (x *UpdateJobResponse_RejectedField) GetField() string {
 	if x != nil {
-		return x.UserId
+		return x.Field
 	}
 	return ""
 }
 
-func (x *SubmitScriptAsJobRequest) GetScript() string {
+func (x *UpdateJobResponse_RejectedField) GetReason() string {
 	if x != nil {
-		return x.Script
-	}
-	return ""
-}
-
-func (x *SubmitScriptAsJobRequest) GetScriptFileFullPath() string {
-	if x != nil && x.ScriptFileFullPath != nil {
-		return *x.ScriptFileFullPath
+		return x.Reason
 	}
 	return ""
 }
 
-type SubmitScriptAsJobResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         uint32                 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+type CancelJobsRequest_Filter struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Users    []string               `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Accounts []string               `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// PENDING, RUNNING or SUSPENDED, if not set, jobs in all these states are matched
+	States     []string `protobuf:"bytes,3,rep,name=states,proto3" json:"states,omitempty"`
+	JobName    *string  `protobuf:"bytes,4,opt,name=job_name,json=jobName,proto3,oneof" json:"job_name,omitempty"`
+	Partitions []string `protobuf:"bytes,5,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	// an array job id matches all tasks of the job array
+	JobIds        []uint32 `protobuf:"varint,6,rep,packed,name=job_ids,json=jobIds,proto3" json:"job_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitScriptAsJobResponse) Reset() {
-	*x = SubmitScriptAsJobResponse{}
-	mi := &file_job_proto_msgTypes[17]
+func (x *CancelJobsRequest_Filter) Reset() {
+	*x = CancelJobsRequest_Filter{}
+	mi := &file_job_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitScriptAsJobResponse) String() string {
+func (x *CancelJobsRequest_Filter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitScriptAsJobResponse) ProtoMessage() {}
+func (*CancelJobsRequest_Filter) ProtoMessage() {}
 
-func (x *SubmitScriptAsJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[17]
+func (x *CancelJobsRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1286,49 +6311,79 @@ func (x *SubmitScriptAsJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitScriptAsJobResponse.ProtoReflect.Descriptor instead.
-func (*SubmitScriptAsJobResponse) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CancelJobsRequest_Filter.ProtoReflect.Descriptor instead.
+func (*CancelJobsRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{39, 0}
 }
 
-func (x *SubmitScriptAsJobResponse) GetJobId() uint32 {
+func (x *CancelJobsRequest_Filter) GetUsers() []string {
 	if x != nil {
-		return x.JobId
+		return x.Users
 	}
-	return 0
+	return nil
 }
 
-// filter options. The logical relationship between multiple filtering options is "AND".
-type GetJobsRequest_Filter struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Users    []string               `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Accounts []string               `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	States   []string               `protobuf:"bytes,3,rep,name=states,proto3" json:"states,omitempty"`
-	// if set this field, return jobs that submitted between the time range(both endpoints included)
-	SubmitTime *TimeRange `protobuf:"bytes,4,opt,name=submit_time,json=submitTime,proto3,oneof" json:"submit_time,omitempty"`
-	// if set this field, return jobs that ended between the time range(both endpoints included)
-	EndTime       *TimeRange `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
-	JobId         *uint32    `protobuf:"varint,6,opt,name=job_id,json=jobId,proto3,oneof" json:"job_id,omitempty"`
-	JobName       *string    `protobuf:"bytes,7,opt,name=job_name,json=jobName,proto3,oneof" json:"job_name,omitempty"`
+func (x *CancelJobsRequest_Filter) GetAccounts() []string {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *CancelJobsRequest_Filter) GetStates() []string {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *CancelJobsRequest_Filter) GetJobName() string {
+	if x != nil && x.JobName != nil {
+		return *x.JobName
+	}
+	return ""
+}
+
+func (x *CancelJobsRequest_Filter) GetPartitions() []string {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+func (x *CancelJobsRequest_Filter) GetJobIds() []uint32 {
+	if x != nil {
+		return x.JobIds
+	}
+	return nil
+}
+
+type WatchJobsRequest_Filter struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Users      []string               `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Accounts   []string               `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Partitions []string               `protobuf:"bytes,3,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	// an array job id matches all tasks of the job array
+	JobIds        []uint32 `protobuf:"varint,4,rep,packed,name=job_ids,json=jobIds,proto3" json:"job_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetJobsRequest_Filter) Reset() {
-	*x = GetJobsRequest_Filter{}
-	mi := &file_job_proto_msgTypes[18]
+func (x *WatchJobsRequest_Filter) Reset() {
+	*x = WatchJobsRequest_Filter{}
+	mi := &file_job_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetJobsRequest_Filter) String() string {
+func (x *WatchJobsRequest_Filter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobsRequest_Filter) ProtoMessage() {}
+func (*WatchJobsRequest_Filter) ProtoMessage() {}
 
-func (x *GetJobsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_job_proto_msgTypes[18]
+func (x *WatchJobsRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_job_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1339,60 +6394,39 @@ func (x *GetJobsRequest_Filter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJobsRequest_Filter.ProtoReflect.Descriptor instead.
-func (*GetJobsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_job_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use WatchJobsRequest_Filter.ProtoReflect.Descriptor instead.
+func (*WatchJobsRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_job_proto_rawDescGZIP(), []int{55, 0}
 }
 
-func (x *GetJobsRequest_Filter) GetUsers() []string {
+func (x *WatchJobsRequest_Filter) GetUsers() []string {
 	if x != nil {
 		return x.Users
 	}
 	return nil
 }
 
-func (x *GetJobsRequest_Filter) GetAccounts() []string {
+func (x *WatchJobsRequest_Filter) GetAccounts() []string {
 	if x != nil {
 		return x.Accounts
 	}
 	return nil
 }
 
-func (x *GetJobsRequest_Filter) GetStates() []string {
-	if x != nil {
-		return x.States
-	}
-	return nil
-}
-
-func (x *GetJobsRequest_Filter) GetSubmitTime() *TimeRange {
+func (x *WatchJobsRequest_Filter) GetPartitions() []string {
 	if x != nil {
-		return x.SubmitTime
+		return x.Partitions
 	}
 	return nil
 }
 
-func (x *GetJobsRequest_Filter) GetEndTime() *TimeRange {
+func (x *WatchJobsRequest_Filter) GetJobIds() []uint32 {
 	if x != nil {
-		return x.EndTime
+		return x.JobIds
 	}
 	return nil
 }
 
-func (x *GetJobsRequest_Filter) GetJobId() uint32 {
-	if x != nil && x.JobId != nil {
-		return *x.JobId
-	}
-	return 0
-}
-
-func (x *GetJobsRequest_Filter) GetJobName() string {
-	if x != nil && x.JobName != nil {
-		return *x.JobName
-	}
-	return ""
-}
-
 var File_job_proto protoreflect.FileDescriptor
 
 var file_job_proto_rawDesc = string([]byte{
@@ -1400,7 +6434,7 @@ var file_job_proto_rawDesc = string([]byte{
 	0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70,
 	0x74, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x08, 0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x0e, 0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f,
 	0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
 	0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
@@ -1454,157 +6488,615 @@ var file_job_proto_rawDesc = string([]byte{
 	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x0a, 0x52, 0x07, 0x65, 0x6e, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x74, 0x64, 0x6f,
-	0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x74, 0x64, 0x65,
-	0x72, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x6c, 0x61, 0x70, 0x73,
-	0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x67, 0x70, 0x75, 0x73, 0x5f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x63, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x70, 0x75, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
-	0x63, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x65, 0x6d, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x5f,
-	0x6d, 0x62, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x63, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22,
-	0xa3, 0x01, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3e, 0x0a,
-	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
-	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a,
-	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x07, 0x65,
-	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x40, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x6f,
-	0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x1e, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x53, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a,
-	0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x22, 0xe7, 0x04, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a,
-	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x12, 0x4a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a,
-	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x42,
-	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x88,
-	0x01, 0x01, 0x12, 0x39, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x48, 0x02, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x1a, 0xcf, 0x02,
-	0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x73, 0x12, 0x47, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73,
+	0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x61, 0x79,
+	0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x0b, 0x52,
+	0x0a, 0x61, 0x72, 0x72, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x27,
+	0x0a, 0x0d, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0c, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x61, 0x79, 0x54, 0x61,
+	0x73, 0x6b, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x05, 0x48, 0x0d, 0x52, 0x08, 0x65, 0x78,
+	0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x78, 0x69,
+	0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x05, 0x48, 0x0e,
+	0x52, 0x0a, 0x65, 0x78, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x2f, 0x0a, 0x11, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x05, 0x48, 0x0f, 0x52, 0x0f, 0x64, 0x65,
+	0x72, 0x69, 0x76, 0x65, 0x64, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x33, 0x0a, 0x13, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x69, 0x74,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x05, 0x48, 0x10, 0x52,
+	0x11, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x45, 0x78, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x11, 0x52, 0x0a, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a, 0x68, 0x65,
+	0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x12,
+	0x52, 0x08, 0x68, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a,
+	0x0e, 0x68, 0x65, 0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x21, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x13, 0x52, 0x0c, 0x68, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0e, 0x68, 0x65, 0x74, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x22, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0d, 0x68, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x51, 0x0a, 0x14, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x14, 0x52, 0x12, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63,
+	0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x48, 0x15, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x74, 0x64, 0x6f, 0x75,
+	0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x72,
+	0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65,
+	0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x67, 0x70, 0x75, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x70, 0x75, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x65, 0x6d, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x6d,
+	0x62, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69,
+	0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x42,
+	0x14, 0x0a, 0x12, 0x5f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x69, 0x74,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65,
+	0x64, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x68, 0x65, 0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x68, 0x65, 0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x17,
+	0x0a, 0x15, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9b, 0x03, 0x0a, 0x0d, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e,
+	0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x49, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73,
+	0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78,
+	0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
+	0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50,
+	0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x49, 0x4d,
+	0x49, 0x54, 0x53, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45,
+	0x4e, 0x43, 0x59, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x45, 0x4c, 0x44, 0x10, 0x05, 0x12,
+	0x12, 0x0a, 0x0e, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x57,
+	0x4e, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x07, 0x22, 0xeb, 0x01, 0x0a, 0x0d, 0x4a, 0x6f, 0x62,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3e, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6a, 0x6f,
+	0x62, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x6a, 0x6f, 0x62,
+	0x49, 0x64, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b,
+	0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x41, 0x46, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x46, 0x54, 0x45,
+	0x52, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x46, 0x54, 0x45, 0x52,
+	0x5f, 0x4f, 0x4b, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x4f, 0x4b, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x46, 0x54, 0x45, 0x52,
+	0x5f, 0x43, 0x4f, 0x52, 0x52, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x49, 0x4e, 0x47, 0x4c,
+	0x45, 0x54, 0x4f, 0x4e, 0x10, 0x06, 0x22, 0xa3, 0x01, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x08,
+	0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x08, 0x53, 0x6f,
+	0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x40, 0x0a, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x73, 0x63,
+	0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x6f,
+	0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x1e,
+	0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x07, 0x0a, 0x03, 0x41,
+	0x53, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x22, 0xfc,
+	0x05, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x4a, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x63, 0x6f, 0x77,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73,
 	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x08, 0x65,
-	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x02, 0x52, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x70,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x87, 0x03, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73,
+	0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x41, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x1e, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x03, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x25, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x61, 0x79, 0x4a, 0x6f,
+	0x62, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbd, 0x01,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe5, 0x01,
+	0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x4a, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x63,
+	0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53,
+	0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x01, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a,
+	0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x49, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x6a,
+	0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63, 0x6f, 0x77,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73,
+	0x22, 0x42, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a,
+	0x6f, 0x62, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x6a, 0x6f,
+	0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x57, 0x0a,
+	0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f,
+	0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xed, 0x02, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x03, 0x71, 0x6f, 0x73, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02,
+	0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x17,
+	0x0a, 0x04, 0x63, 0x70, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x04,
+	0x63, 0x70, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x67, 0x70, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x04, 0x67, 0x70, 0x75, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x62, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x05, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x62, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x06, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x71, 0x6f, 0x73,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x63, 0x70, 0x75, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x67, 0x70, 0x75,
+	0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x62, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0xb4, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0e, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x3d, 0x0a, 0x0d,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x98, 0x03, 0x0a, 0x0b,
+	0x4a, 0x6f, 0x62, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x72, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x61, 0x69, 0x72,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x71,
+	0x6f, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x71, 0x6f, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6e, 0x69, 0x63,
+	0x65, 0x12, 0x41, 0x0a, 0x04, 0x74, 0x72, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04,
+	0x74, 0x72, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x37, 0x0a,
+	0x09, 0x54, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x43, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f,
+	0x62, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f,
+	0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x22, 0xac, 0x06, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x03, 0x71, 0x6f, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x70,
+	0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x67,
+	0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x5f, 0x6d, 0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x62, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x72,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63,
+	0x6f, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52,
+	0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x19, 0x0a, 0x05, 0x61, 0x72, 0x72, 0x61, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05,
+	0x52, 0x05, 0x61, 0x72, 0x72, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x0c, 0x64, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x61, 0x6e, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x41, 0x6e, 0x79, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x4e, 0x0a, 0x0e, 0x68, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
 	0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61,
-	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x48, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1a,
-	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02,
-	0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6a, 0x6f,
-	0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x07,
-	0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65,
-	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6a, 0x6f, 0x62, 0x5f,
-	0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
-	0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6f, 0x72,
-	0x74, 0x22, 0x7c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
-	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x42, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x15, 0x0a, 0x06,
-	0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a, 0x6f,
-	0x62, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x42, 0x79, 0x49,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x6a, 0x6f, 0x62,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e,
-	0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x57, 0x0a, 0x19,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62,
+	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x68, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x71, 0x6f, 0x73, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x62, 0x42, 0x15, 0x0a, 0x13, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x72, 0x72,
+	0x61, 0x79, 0x22, 0xce, 0x01, 0x0a, 0x0f, 0x48, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x03, 0x71, 0x6f, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x70, 0x75,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x67, 0x70,
+	0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x5f,
+	0x71, 0x6f, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
-	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x4d, 0x69,
-	0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a,
-	0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x54,
-	0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a,
-	0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
-	0x73, 0x22, 0xac, 0x04, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x03, 0x71, 0x6f, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e,
-	0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x70, 0x75, 0x5f,
+	0x12, 0x29, 0x0a, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x4a, 0x0a, 0x0e, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xf2, 0x01, 0x0a, 0x0c, 0x44, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x14, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12,
+	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x70, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x70, 0x75,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb2, 0x02, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a,
+	0x03, 0x71, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x71, 0x6f,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x67, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x31, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x10, 0x74,
+	0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x88,
+	0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x71, 0x6f, 0x73, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x22, 0x58, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x38, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x0b,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4a, 0x6f, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x3a, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73,
+	0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x49, 0x0a, 0x0a, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x09, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x22, 0x68, 0x0a, 0x12, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x3e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a,
+	0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x69, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x37, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x57, 0x0a, 0x16, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x04,
+	0x6a, 0x6f, 0x62, 0x73, 0x22, 0x69, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a,
+	0x6f, 0x62, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22,
+	0xa1, 0x04, 0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x03, 0x71, 0x6f, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x72,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63,
+	0x6f, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x70, 0x75, 0x5f,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x67, 0x70, 0x75,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f,
-	0x6d, 0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x4d, 0x62, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x72, 0x65, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x72,
-	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0d, 0x48, 0x02, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d,
-	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x01, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x50, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x71, 0x6f, 0x73, 0x42, 0x15, 0x0a, 0x13,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x8d, 0x07,
+	0x0a, 0x1c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x46, 0x72, 0x6f, 0x6d, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
 	0x74, 0x12, 0x2b, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x77, 0x6f,
-	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b,
-	0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03,
-	0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73,
-	0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x06, 0x73,
-	0x74, 0x64, 0x65, 0x72, 0x72, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x06, 0x0a,
-	0x04, 0x5f, 0x71, 0x6f, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x5f, 0x6d, 0x62, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73,
-	0x74, 0x64, 0x6f, 0x75, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72,
-	0x22, 0x55, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x42, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x9d, 0x01, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x61,
+	0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x43, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x4a, 0x6f, 0x62, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x03, 0x71, 0x6f, 0x73, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x02, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x67, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x08, 0x67, 0x70, 0x75, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x05, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f,
+	0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f,
+	0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x72, 0x72, 0x61, 0x79,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x05, 0x61, 0x72, 0x72, 0x61, 0x79, 0x88,
+	0x01, 0x01, 0x12, 0x49, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x71, 0x6f, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f,
+	0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x67, 0x70, 0x75,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x64,
+	0x65, 0x72, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x22, 0xad, 0x01,
+	0x0a, 0x1d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x46, 0x72, 0x6f, 0x6d, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x12, 0x4a, 0x0a, 0x0e, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x6f, 0x77,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x0c, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x7d, 0x0a,
+	0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f,
+	0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x0d, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x61,
+	0x79, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61,
+	0x72, 0x72, 0x61, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xe0, 0x02, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x48, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0xb8, 0x01, 0x0a, 0x06, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1e,
+	0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x06, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6a, 0x6f, 0x62, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7c, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x41, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x27, 0x0a,
+	0x0d, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x61, 0x79, 0x54, 0x61, 0x73,
+	0x6b, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
+	0x22, 0xb6, 0x01, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70,
 	0x74, 0x41, 0x73, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
 	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
 	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
@@ -1612,72 +7104,650 @@ var file_job_proto_rawDesc = string([]byte{
 	0x0a, 0x15, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x66, 0x75,
 	0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
 	0x12, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x50,
-	0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x22, 0x32, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x41, 0x73, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a,
-	0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a,
-	0x6f, 0x62, 0x49, 0x64, 0x32, 0x82, 0x06, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x26,
+	0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x42,
+	0x18, 0x0a, 0x16, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x22, 0x7e, 0x0a, 0x19, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x73, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x4a, 0x0a,
+	0x0e, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x44,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x84, 0x09, 0x0a, 0x0b, 0x4a, 0x6f,
+	0x62, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x65,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x65, 0x70,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61,
+	0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x74, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x20,
+	0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x02, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x0b, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x69, 0x74, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x0a, 0x74, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x63, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x63, 0x6f,
+	0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x54, 0x72, 0x65, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x74, 0x72, 0x65, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x12, 0x62, 0x0a, 0x11, 0x74, 0x72, 0x65,
+	0x73, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f,
+	0x62, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x54, 0x72, 0x65, 0x73, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x6e, 0x4d, 0x61, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x74,
+	0x72, 0x65, 0x73, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x4d, 0x61, 0x78, 0x12, 0x62, 0x0a,
+	0x11, 0x74, 0x72, 0x65, 0x73, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x61,
+	0x76, 0x65, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x54, 0x72,
+	0x65, 0x73, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x41, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0e, 0x74, 0x72, 0x65, 0x73, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x41, 0x76,
+	0x65, 0x12, 0x62, 0x0a, 0x11, 0x74, 0x72, 0x65, 0x73, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x73,
+	0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x54, 0x72, 0x65, 0x73, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x54, 0x6f, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x74, 0x72, 0x65, 0x73, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x6e, 0x54, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x70, 0x75, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x63, 0x70, 0x75, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x1a,
+	0x3c, 0x0a, 0x0e, 0x54, 0x72, 0x65, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a,
+	0x13, 0x54, 0x72, 0x65, 0x73, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x4d, 0x61, 0x78, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x41, 0x0a, 0x13, 0x54, 0x72, 0x65, 0x73, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x41,
+	0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x72, 0x65, 0x73, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x6e, 0x54, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x22, 0xb9, 0x01, 0x0a, 0x0e, 0x48, 0x6f, 0x6c, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06,
+	0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0d, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x72,
+	0x72, 0x61, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69,
+	0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x0f,
+	0x48, 0x6f, 0x6c, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xbc, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x0d, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x61, 0x79, 0x54, 0x61,
+	0x73, 0x6b, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61,
+	0x72, 0x72, 0x61, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x12, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xbc, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0d,
+	0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x61, 0x79, 0x54, 0x61, 0x73, 0x6b,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x72, 0x72,
+	0x61, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x12, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0xbb, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0d, 0x61, 0x72, 0x72,
+	0x61, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x61, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f,
+	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0xbc, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0d, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f,
+	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
+	0x0b, 0x61, 0x72, 0x72, 0x61, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x4d, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xfb,
+	0x01, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x1a, 0x73, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x73, 0x22, 0xfb, 0x02, 0x0a,
+	0x08, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x2e, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a,
+	0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x07, 0x22, 0x4d, 0x0a, 0x11, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2b, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x53, 0x74, 0x65, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62,
+	0x53, 0x74, 0x65, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73,
+	0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0xfc, 0x04, 0x0a, 0x0d, 0x4a, 0x6f, 0x62,
+	0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f,
+	0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x70, 0x75, 0x73, 0x5f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x70, 0x75, 0x73, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x65, 0x64,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x63, 0x70, 0x75, 0x55, 0x73, 0x65, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x63, 0x70, 0x75, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x70, 0x75, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x70,
+	0x75, 0x5f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x63, 0x70, 0x75, 0x45,
+	0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x5f, 0x6d, 0x62, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x4d, 0x62, 0x12, 0x1e,
+	0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x5f, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x6d, 0x62, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x50, 0x65, 0x61, 0x6b, 0x4d, 0x62, 0x12, 0x1c,
+	0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x5f, 0x61, 0x76, 0x65, 0x5f, 0x6d, 0x62, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x41, 0x76, 0x65, 0x4d, 0x62, 0x12, 0x3d, 0x0a, 0x1b,
+	0x6d, 0x65, 0x6d, 0x5f, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x18, 0x6d, 0x65, 0x6d, 0x50, 0x65, 0x61, 0x6b, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69,
+	0x65, 0x6e, 0x63, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x6d,
+	0x65, 0x6d, 0x5f, 0x61, 0x76, 0x65, 0x5f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x17, 0x6d, 0x65, 0x6d, 0x41, 0x76, 0x65, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63,
+	0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6c, 0x61, 0x70,
+	0x73, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f,
+	0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74,
+	0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x36, 0x0a, 0x17, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x30, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4a, 0x6f,
+	0x62, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x6f, 0x77,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x0a, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x87, 0x02, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
 	0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f,
-	0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x56, 0x0a, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b,
+	0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x66, 0x66, 0x69, 0x63,
+	0x69, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x22, 0x20, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12,
+	0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x43, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x22, 0xd3, 0x03, 0x0a, 0x11, 0x45, 0x66, 0x66, 0x69, 0x63,
+	0x69, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x62, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x70, 0x75, 0x55, 0x73, 0x65, 0x64,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x70, 0x75, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x63, 0x70, 0x75, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x70, 0x75, 0x5f, 0x65, 0x66, 0x66, 0x69, 0x63,
+	0x69, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x14, 0x63, 0x70, 0x75, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e,
+	0x63, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6d,
+	0x5f, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x6d, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x6d, 0x65, 0x6d, 0x50, 0x65, 0x61, 0x6b, 0x4d, 0x62, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x65, 0x6d,
+	0x5f, 0x72, 0x65, 0x71, 0x5f, 0x6d, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d,
+	0x65, 0x6d, 0x52, 0x65, 0x71, 0x4d, 0x62, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x65, 0x6d, 0x5f, 0x65,
+	0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x6d, 0x65, 0x6d, 0x45, 0x66, 0x66, 0x69,
+	0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x66, 0x66,
+	0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x66, 0x66, 0x69, 0x63,
+	0x69, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x67, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65,
+	0x6e, 0x63, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x22, 0x9a, 0x03, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x40, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x51, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63,
 	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e,
-	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x63, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x42, 0x79, 0x49, 0x64, 0x12, 0x29, 0x2e,
-	0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61,
-	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x42, 0x79, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f,
-	0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x31, 0x2e, 0x73, 0x63, 0x6f,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x4d, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x38, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x79, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41,
+	0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x41, 0x52, 0x54,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x51, 0x4f, 0x53, 0x10, 0x03,
+	0x22, 0x30, 0x0a, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x4f, 0x4e, 0x54, 0x48,
+	0x10, 0x03, 0x22, 0xa4, 0x02, 0x0a, 0x0c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x71, 0x6f,
+	0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x62, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x70, 0x75, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x63, 0x70, 0x75, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x70,
+	0x75, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x67,
+	0x70, 0x75, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x5f, 0x67,
+	0x62, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d,
+	0x65, 0x6d, 0x47, 0x62, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x5d, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4a,
+	0x6f, 0x62, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a,
+	0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6a,
+	0x6f, 0x62, 0x49, 0x64, 0x22, 0x86, 0x02, 0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x73, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x71, 0x6f, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x73,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x67, 0x70, 0x75, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x65, 0x6d, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d,
+	0x65, 0x6d, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x6f, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x64, 0x22, 0x49, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x43, 0x6f,
+	0x73, 0x74, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x8c, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x35, 0x0a, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x73, 0x74,
+	0x52, 0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8e, 0x02, 0x0a, 0x14, 0x54, 0x61, 0x69, 0x6c,
+	0x4a, 0x6f, 0x62, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x58, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x73,
+	0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x69, 0x6c, 0x4a, 0x6f, 0x62, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x24, 0x0a, 0x0a, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x44, 0x4f, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x53, 0x54, 0x44, 0x45, 0x52, 0x52, 0x10, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x15, 0x54, 0x61, 0x69, 0x6c,
+	0x4a, 0x6f, 0x62, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x32, 0xf9, 0x17, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x5a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x26, 0x2e, 0x73, 0x63,
+	0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0a,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x29, 0x2e, 0x73, 0x63, 0x6f,
 	0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70,
-	0x74, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x69, 0x6d,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
-	0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61,
-	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x62,
-	0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x78, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x54, 0x69, 0x6d,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63,
+	0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x30, 0x01, 0x12, 0x63, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x42, 0x79, 0x49,
+	0x64, 0x12, 0x29, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f,
+	0x62, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73,
+	0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x42, 0x79, 0x49, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x31,
+	0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f,
+	0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x12, 0x28, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73,
+	0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a, 0x6f,
+	0x62, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x2e, 0x73, 0x63, 0x6f, 0x77,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x4e, 0x65, 0x78, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x6c, 0x6f,
+	0x74, 0x12, 0x33, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x78, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63,
 	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x11,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e,
+	0x74, 0x12, 0x30, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x4a, 0x6f, 0x62, 0x12, 0x28, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x73,
+	0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x84, 0x01, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x46, 0x72, 0x6f,
+	0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x34, 0x2e, 0x73, 0x63, 0x6f, 0x77,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x46, 0x72, 0x6f, 0x6d,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x35, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a,
+	0x6f, 0x62, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x2d, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x28, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0a, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x29, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a,
+	0x0a, 0x07, 0x48, 0x6f, 0x6c, 0x64, 0x4a, 0x6f, 0x62, 0x12, 0x26, 0x2e, 0x73, 0x63, 0x6f, 0x77,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0a, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x29, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e,
 	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x09, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x28, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e,
+	0x72, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x63, 0x0a, 0x0a, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4a, 0x6f, 0x62, 0x12, 0x29, 0x2e,
+	0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e,
 	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x72, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4a, 0x6f,
+	0x62, 0x12, 0x28, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x63,
+	0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x4a, 0x6f, 0x62, 0x12, 0x29, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x11, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x73, 0x4a, 0x6f, 0x62,
+	0x12, 0x30, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x73, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
 	0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a,
-	0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x28, 0x2e, 0x73, 0x63, 0x6f,
-	0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70,
-	0x74, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x78, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41,
-	0x73, 0x4a, 0x6f, 0x62, 0x12, 0x30, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x73, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63,
+	0x69, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x73, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0d, 0x54, 0x61, 0x69, 0x6c, 0x4a, 0x6f, 0x62,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2c, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63,
 	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x73, 0x4a, 0x6f,
-	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xb3, 0x01, 0x0a, 0x1a, 0x63, 0x6f,
-	0x6d, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
-	0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x42, 0x08, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x16, 0x73, 0x63, 0x6f, 0x77, 0x2d, 0x73, 0x6c, 0x75, 0x72, 0x6d,
-	0x2d, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0xa2, 0x02, 0x03, 0x53,
-	0x53, 0x58, 0xaa, 0x02, 0x15, 0x53, 0x63, 0x6f, 0x77, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0xca, 0x02, 0x15, 0x53, 0x63, 0x6f,
-	0x77, 0x5c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x41, 0x64, 0x61, 0x70, 0x74,
-	0x65, 0x72, 0xe2, 0x02, 0x21, 0x53, 0x63, 0x6f, 0x77, 0x5c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x53, 0x63, 0x6f, 0x77, 0x3a, 0x3a, 0x53,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x61, 0x69, 0x6c, 0x4a, 0x6f, 0x62, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x61,
+	0x69, 0x6c, 0x4a, 0x6f, 0x62, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x62, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f,
+	0x62, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73,
+	0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x2a, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x65, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x65, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x75, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x45, 0x66, 0x66, 0x69, 0x63,
+	0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2f, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x33, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x2e, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x63, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x29,
+	0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x43, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x63, 0x6f, 0x77,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73,
+	0x43, 0x6f, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62,
+	0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xb3, 0x01,
+	0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x63, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x42, 0x08, 0x4a, 0x6f,
+	0x62, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x16, 0x73, 0x63, 0x6f, 0x77, 0x2d, 0x73,
+	0x6c, 0x75, 0x72, 0x6d, 0x2d, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e,
+	0xa2, 0x02, 0x03, 0x53, 0x53, 0x58, 0xaa, 0x02, 0x15, 0x53, 0x63, 0x6f, 0x77, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0xca, 0x02,
+	0x15, 0x53, 0x63, 0x6f, 0x77, 0x5c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x41,
+	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0xe2, 0x02, 0x21, 0x53, 0x63, 0x6f, 0x77, 0x5c, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x53, 0x63, 0x6f,
+	0x77, 0x3a, 0x3a, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x41, 0x64, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1692,64 +7762,238 @@ func file_job_proto_rawDescGZIP() []byte {
 	return file_job_proto_rawDescData
 }
 
-var file_job_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_job_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_job_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_job_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
 var file_job_proto_goTypes = []any{
-	(SortInfo_SortOrder)(0),            // 0: scow.scheduler_adapter.SortInfo.SortOrder
-	(*JobInfo)(nil),                    // 1: scow.scheduler_adapter.JobInfo
-	(*TimeRange)(nil),                  // 2: scow.scheduler_adapter.TimeRange
-	(*PageInfo)(nil),                   // 3: scow.scheduler_adapter.PageInfo
-	(*SortInfo)(nil),                   // 4: scow.scheduler_adapter.SortInfo
-	(*GetJobsRequest)(nil),             // 5: scow.scheduler_adapter.GetJobsRequest
-	(*GetJobsResponse)(nil),            // 6: scow.scheduler_adapter.GetJobsResponse
-	(*GetJobByIdRequest)(nil),          // 7: scow.scheduler_adapter.GetJobByIdRequest
-	(*GetJobByIdResponse)(nil),         // 8: scow.scheduler_adapter.GetJobByIdResponse
-	(*ChangeJobTimeLimitRequest)(nil),  // 9: scow.scheduler_adapter.ChangeJobTimeLimitRequest
-	(*ChangeJobTimeLimitResponse)(nil), // 10: scow.scheduler_adapter.ChangeJobTimeLimitResponse
-	(*QueryJobTimeLimitRequest)(nil),   // 11: scow.scheduler_adapter.QueryJobTimeLimitRequest
-	(*QueryJobTimeLimitResponse)(nil),  // 12: scow.scheduler_adapter.QueryJobTimeLimitResponse
-	(*SubmitJobRequest)(nil),           // 13: scow.scheduler_adapter.SubmitJobRequest
-	(*SubmitJobResponse)(nil),          // 14: scow.scheduler_adapter.SubmitJobResponse
-	(*CancelJobRequest)(nil),           // 15: scow.scheduler_adapter.CancelJobRequest
-	(*CancelJobResponse)(nil),          // 16: scow.scheduler_adapter.CancelJobResponse
-	(*SubmitScriptAsJobRequest)(nil),   // 17: scow.scheduler_adapter.SubmitScriptAsJobRequest
-	(*SubmitScriptAsJobResponse)(nil),  // 18: scow.scheduler_adapter.SubmitScriptAsJobResponse
-	(*GetJobsRequest_Filter)(nil),      // 19: scow.scheduler_adapter.GetJobsRequest.Filter
-	(*timestamppb.Timestamp)(nil),      // 20: google.protobuf.Timestamp
+	(PendingReason_Category)(0),              // 0: scow.scheduler_adapter.PendingReason.Category
+	(JobDependency_Type)(0),                  // 1: scow.scheduler_adapter.JobDependency.Type
+	(SortInfo_SortOrder)(0),                  // 2: scow.scheduler_adapter.SortInfo.SortOrder
+	(JobEvent_Type)(0),                       // 3: scow.scheduler_adapter.JobEvent.Type
+	(GetEfficiencySummaryRequest_GroupBy)(0), // 4: scow.scheduler_adapter.GetEfficiencySummaryRequest.GroupBy
+	(GetUsageSummaryRequest_GroupBy)(0),      // 5: scow.scheduler_adapter.GetUsageSummaryRequest.GroupBy
+	(GetUsageSummaryRequest_Bucket)(0),       // 6: scow.scheduler_adapter.GetUsageSummaryRequest.Bucket
+	(TailJobOutputRequest_OutputType)(0),     // 7: scow.scheduler_adapter.TailJobOutputRequest.OutputType
+	(*JobInfo)(nil),                          // 8: scow.scheduler_adapter.JobInfo
+	(*PendingReason)(nil),                    // 9: scow.scheduler_adapter.PendingReason
+	(*JobDependency)(nil),                    // 10: scow.scheduler_adapter.JobDependency
+	(*TimeRange)(nil),                        // 11: scow.scheduler_adapter.TimeRange
+	(*PageInfo)(nil),                         // 12: scow.scheduler_adapter.PageInfo
+	(*SortInfo)(nil),                         // 13: scow.scheduler_adapter.SortInfo
+	(*GetJobsRequest)(nil),                   // 14: scow.scheduler_adapter.GetJobsRequest
+	(*GetJobsResponse)(nil),                  // 15: scow.scheduler_adapter.GetJobsResponse
+	(*StreamJobsRequest)(nil),                // 16: scow.scheduler_adapter.StreamJobsRequest
+	(*StreamJobsResponse)(nil),               // 17: scow.scheduler_adapter.StreamJobsResponse
+	(*GetJobByIdRequest)(nil),                // 18: scow.scheduler_adapter.GetJobByIdRequest
+	(*GetJobByIdResponse)(nil),               // 19: scow.scheduler_adapter.GetJobByIdResponse
+	(*ChangeJobTimeLimitRequest)(nil),        // 20: scow.scheduler_adapter.ChangeJobTimeLimitRequest
+	(*ChangeJobTimeLimitResponse)(nil),       // 21: scow.scheduler_adapter.ChangeJobTimeLimitResponse
+	(*UpdateJobRequest)(nil),                 // 22: scow.scheduler_adapter.UpdateJobRequest
+	(*UpdateJobResponse)(nil),                // 23: scow.scheduler_adapter.UpdateJobResponse
+	(*GetJobPriorityRequest)(nil),            // 24: scow.scheduler_adapter.GetJobPriorityRequest
+	(*JobPriority)(nil),                      // 25: scow.scheduler_adapter.JobPriority
+	(*GetJobPriorityResponse)(nil),           // 26: scow.scheduler_adapter.GetJobPriorityResponse
+	(*QueryJobTimeLimitRequest)(nil),         // 27: scow.scheduler_adapter.QueryJobTimeLimitRequest
+	(*QueryJobTimeLimitResponse)(nil),        // 28: scow.scheduler_adapter.QueryJobTimeLimitResponse
+	(*SubmitJobRequest)(nil),                 // 29: scow.scheduler_adapter.SubmitJobRequest
+	(*HetJobComponent)(nil),                  // 30: scow.scheduler_adapter.HetJobComponent
+	(*SubmitJobResponse)(nil),                // 31: scow.scheduler_adapter.SubmitJobResponse
+	(*DryRunResult)(nil),                     // 32: scow.scheduler_adapter.DryRunResult
+	(*GetNextAvailableSlotRequest)(nil),      // 33: scow.scheduler_adapter.GetNextAvailableSlotRequest
+	(*GetNextAvailableSlotResponse)(nil),     // 34: scow.scheduler_adapter.GetNextAvailableSlotResponse
+	(*WorkflowJob)(nil),                      // 35: scow.scheduler_adapter.WorkflowJob
+	(*WorkflowDependency)(nil),               // 36: scow.scheduler_adapter.WorkflowDependency
+	(*SubmitWorkflowRequest)(nil),            // 37: scow.scheduler_adapter.SubmitWorkflowRequest
+	(*SubmitWorkflowResponse)(nil),           // 38: scow.scheduler_adapter.SubmitWorkflowResponse
+	(*WorkflowJobResult)(nil),                // 39: scow.scheduler_adapter.WorkflowJobResult
+	(*JobTemplate)(nil),                      // 40: scow.scheduler_adapter.JobTemplate
+	(*ListJobTemplatesRequest)(nil),          // 41: scow.scheduler_adapter.ListJobTemplatesRequest
+	(*ListJobTemplatesResponse)(nil),         // 42: scow.scheduler_adapter.ListJobTemplatesResponse
+	(*SubmitJobFromTemplateRequest)(nil),     // 43: scow.scheduler_adapter.SubmitJobFromTemplateRequest
+	(*SubmitJobFromTemplateResponse)(nil),    // 44: scow.scheduler_adapter.SubmitJobFromTemplateResponse
+	(*CancelJobRequest)(nil),                 // 45: scow.scheduler_adapter.CancelJobRequest
+	(*CancelJobResponse)(nil),                // 46: scow.scheduler_adapter.CancelJobResponse
+	(*CancelJobsRequest)(nil),                // 47: scow.scheduler_adapter.CancelJobsRequest
+	(*CancelJobsResponse)(nil),               // 48: scow.scheduler_adapter.CancelJobsResponse
+	(*CancelJobResult)(nil),                  // 49: scow.scheduler_adapter.CancelJobResult
+	(*SubmitScriptAsJobRequest)(nil),         // 50: scow.scheduler_adapter.SubmitScriptAsJobRequest
+	(*SubmitScriptAsJobResponse)(nil),        // 51: scow.scheduler_adapter.SubmitScriptAsJobResponse
+	(*JobStepInfo)(nil),                      // 52: scow.scheduler_adapter.JobStepInfo
+	(*HoldJobRequest)(nil),                   // 53: scow.scheduler_adapter.HoldJobRequest
+	(*HoldJobResponse)(nil),                  // 54: scow.scheduler_adapter.HoldJobResponse
+	(*ReleaseJobRequest)(nil),                // 55: scow.scheduler_adapter.ReleaseJobRequest
+	(*ReleaseJobResponse)(nil),               // 56: scow.scheduler_adapter.ReleaseJobResponse
+	(*SuspendJobRequest)(nil),                // 57: scow.scheduler_adapter.SuspendJobRequest
+	(*SuspendJobResponse)(nil),               // 58: scow.scheduler_adapter.SuspendJobResponse
+	(*ResumeJobRequest)(nil),                 // 59: scow.scheduler_adapter.ResumeJobRequest
+	(*ResumeJobResponse)(nil),                // 60: scow.scheduler_adapter.ResumeJobResponse
+	(*RequeueJobRequest)(nil),                // 61: scow.scheduler_adapter.RequeueJobRequest
+	(*RequeueJobResponse)(nil),               // 62: scow.scheduler_adapter.RequeueJobResponse
+	(*WatchJobsRequest)(nil),                 // 63: scow.scheduler_adapter.WatchJobsRequest
+	(*JobEvent)(nil),                         // 64: scow.scheduler_adapter.JobEvent
+	(*WatchJobsResponse)(nil),                // 65: scow.scheduler_adapter.WatchJobsResponse
+	(*GetJobStepsRequest)(nil),               // 66: scow.scheduler_adapter.GetJobStepsRequest
+	(*GetJobStepsResponse)(nil),              // 67: scow.scheduler_adapter.GetJobStepsResponse
+	(*JobEfficiency)(nil),                    // 68: scow.scheduler_adapter.JobEfficiency
+	(*GetJobEfficiencyRequest)(nil),          // 69: scow.scheduler_adapter.GetJobEfficiencyRequest
+	(*GetJobEfficiencyResponse)(nil),         // 70: scow.scheduler_adapter.GetJobEfficiencyResponse
+	(*GetEfficiencySummaryRequest)(nil),      // 71: scow.scheduler_adapter.GetEfficiencySummaryRequest
+	(*EfficiencySummary)(nil),                // 72: scow.scheduler_adapter.EfficiencySummary
+	(*GetEfficiencySummaryResponse)(nil),     // 73: scow.scheduler_adapter.GetEfficiencySummaryResponse
+	(*GetUsageSummaryRequest)(nil),           // 74: scow.scheduler_adapter.GetUsageSummaryRequest
+	(*UsageSummary)(nil),                     // 75: scow.scheduler_adapter.UsageSummary
+	(*GetUsageSummaryResponse)(nil),          // 76: scow.scheduler_adapter.GetUsageSummaryResponse
+	(*GetJobCostRequest)(nil),                // 77: scow.scheduler_adapter.GetJobCostRequest
+	(*JobCost)(nil),                          // 78: scow.scheduler_adapter.JobCost
+	(*GetJobCostResponse)(nil),               // 79: scow.scheduler_adapter.GetJobCostResponse
+	(*GetJobsCostRequest)(nil),               // 80: scow.scheduler_adapter.GetJobsCostRequest
+	(*GetJobsCostResponse)(nil),              // 81: scow.scheduler_adapter.GetJobsCostResponse
+	(*TailJobOutputRequest)(nil),             // 82: scow.scheduler_adapter.TailJobOutputRequest
+	(*TailJobOutputResponse)(nil),            // 83: scow.scheduler_adapter.TailJobOutputResponse
+	nil,                                      // 84: scow.scheduler_adapter.PendingReason.ParamsEntry
+	(*GetJobsRequest_Filter)(nil),            // 85: scow.scheduler_adapter.GetJobsRequest.Filter
+	(*UpdateJobResponse_RejectedField)(nil),  // 86: scow.scheduler_adapter.UpdateJobResponse.RejectedField
+	nil,                                      // 87: scow.scheduler_adapter.JobPriority.TresEntry
+	nil,                                      // 88: scow.scheduler_adapter.JobTemplate.VariablesEntry
+	nil,                                      // 89: scow.scheduler_adapter.SubmitJobFromTemplateRequest.VariablesEntry
+	(*CancelJobsRequest_Filter)(nil),         // 90: scow.scheduler_adapter.CancelJobsRequest.Filter
+	nil,                                      // 91: scow.scheduler_adapter.JobStepInfo.TresAllocEntry
+	nil,                                      // 92: scow.scheduler_adapter.JobStepInfo.TresUsageInMaxEntry
+	nil,                                      // 93: scow.scheduler_adapter.JobStepInfo.TresUsageInAveEntry
+	nil,                                      // 94: scow.scheduler_adapter.JobStepInfo.TresUsageInTotEntry
+	(*WatchJobsRequest_Filter)(nil),          // 95: scow.scheduler_adapter.WatchJobsRequest.Filter
+	(*timestamppb.Timestamp)(nil),            // 96: google.protobuf.Timestamp
 }
 var file_job_proto_depIdxs = []int32{
-	20, // 0: scow.scheduler_adapter.JobInfo.submit_time:type_name -> google.protobuf.Timestamp
-	20, // 1: scow.scheduler_adapter.JobInfo.start_time:type_name -> google.protobuf.Timestamp
-	20, // 2: scow.scheduler_adapter.JobInfo.end_time:type_name -> google.protobuf.Timestamp
-	20, // 3: scow.scheduler_adapter.TimeRange.start_time:type_name -> google.protobuf.Timestamp
-	20, // 4: scow.scheduler_adapter.TimeRange.end_time:type_name -> google.protobuf.Timestamp
-	0,  // 5: scow.scheduler_adapter.SortInfo.order:type_name -> scow.scheduler_adapter.SortInfo.SortOrder
-	19, // 6: scow.scheduler_adapter.GetJobsRequest.filter:type_name -> scow.scheduler_adapter.GetJobsRequest.Filter
-	3,  // 7: scow.scheduler_adapter.GetJobsRequest.page_info:type_name -> scow.scheduler_adapter.PageInfo
-	4,  // 8: scow.scheduler_adapter.GetJobsRequest.sort:type_name -> scow.scheduler_adapter.SortInfo
-	1,  // 9: scow.scheduler_adapter.GetJobsResponse.jobs:type_name -> scow.scheduler_adapter.JobInfo
-	1,  // 10: scow.scheduler_adapter.GetJobByIdResponse.job:type_name -> scow.scheduler_adapter.JobInfo
-	2,  // 11: scow.scheduler_adapter.GetJobsRequest.Filter.submit_time:type_name -> scow.scheduler_adapter.TimeRange
-	2,  // 12: scow.scheduler_adapter.GetJobsRequest.Filter.end_time:type_name -> scow.scheduler_adapter.TimeRange
-	5,  // 13: scow.scheduler_adapter.JobService.GetJobs:input_type -> scow.scheduler_adapter.GetJobsRequest
-	7,  // 14: scow.scheduler_adapter.JobService.GetJobById:input_type -> scow.scheduler_adapter.GetJobByIdRequest
-	9,  // 15: scow.scheduler_adapter.JobService.ChangeJobTimeLimit:input_type -> scow.scheduler_adapter.ChangeJobTimeLimitRequest
-	11, // 16: scow.scheduler_adapter.JobService.QueryJobTimeLimit:input_type -> scow.scheduler_adapter.QueryJobTimeLimitRequest
-	13, // 17: scow.scheduler_adapter.JobService.SubmitJob:input_type -> scow.scheduler_adapter.SubmitJobRequest
-	15, // 18: scow.scheduler_adapter.JobService.CancelJob:input_type -> scow.scheduler_adapter.CancelJobRequest
-	17, // 19: scow.scheduler_adapter.JobService.SubmitScriptAsJob:input_type -> scow.scheduler_adapter.SubmitScriptAsJobRequest
-	6,  // 20: scow.scheduler_adapter.JobService.GetJobs:output_type -> scow.scheduler_adapter.GetJobsResponse
-	8,  // 21: scow.scheduler_adapter.JobService.GetJobById:output_type -> scow.scheduler_adapter.GetJobByIdResponse
-	10, // 22: scow.scheduler_adapter.JobService.ChangeJobTimeLimit:output_type -> scow.scheduler_adapter.ChangeJobTimeLimitResponse
-	12, // 23: scow.scheduler_adapter.JobService.QueryJobTimeLimit:output_type -> scow.scheduler_adapter.QueryJobTimeLimitResponse
-	14, // 24: scow.scheduler_adapter.JobService.SubmitJob:output_type -> scow.scheduler_adapter.SubmitJobResponse
-	16, // 25: scow.scheduler_adapter.JobService.CancelJob:output_type -> scow.scheduler_adapter.CancelJobResponse
-	18, // 26: scow.scheduler_adapter.JobService.SubmitScriptAsJob:output_type -> scow.scheduler_adapter.SubmitScriptAsJobResponse
-	20, // [20:27] is the sub-list for method output_type
-	13, // [13:20] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	96, // 0: scow.scheduler_adapter.JobInfo.submit_time:type_name -> google.protobuf.Timestamp
+	96, // 1: scow.scheduler_adapter.JobInfo.start_time:type_name -> google.protobuf.Timestamp
+	96, // 2: scow.scheduler_adapter.JobInfo.end_time:type_name -> google.protobuf.Timestamp
+	8,  // 3: scow.scheduler_adapter.JobInfo.het_components:type_name -> scow.scheduler_adapter.JobInfo
+	96, // 4: scow.scheduler_adapter.JobInfo.scheduled_start_time:type_name -> google.protobuf.Timestamp
+	9,  // 5: scow.scheduler_adapter.JobInfo.pending_reason:type_name -> scow.scheduler_adapter.PendingReason
+	0,  // 6: scow.scheduler_adapter.PendingReason.category:type_name -> scow.scheduler_adapter.PendingReason.Category
+	84, // 7: scow.scheduler_adapter.PendingReason.params:type_name -> scow.scheduler_adapter.PendingReason.ParamsEntry
+	1,  // 8: scow.scheduler_adapter.JobDependency.type:type_name -> scow.scheduler_adapter.JobDependency.Type
+	96, // 9: scow.scheduler_adapter.TimeRange.start_time:type_name -> google.protobuf.Timestamp
+	96, // 10: scow.scheduler_adapter.TimeRange.end_time:type_name -> google.protobuf.Timestamp
+	2,  // 11: scow.scheduler_adapter.SortInfo.order:type_name -> scow.scheduler_adapter.SortInfo.SortOrder
+	85, // 12: scow.scheduler_adapter.GetJobsRequest.filter:type_name -> scow.scheduler_adapter.GetJobsRequest.Filter
+	12, // 13: scow.scheduler_adapter.GetJobsRequest.page_info:type_name -> scow.scheduler_adapter.PageInfo
+	13, // 14: scow.scheduler_adapter.GetJobsRequest.sort:type_name -> scow.scheduler_adapter.SortInfo
+	8,  // 15: scow.scheduler_adapter.GetJobsResponse.jobs:type_name -> scow.scheduler_adapter.JobInfo
+	85, // 16: scow.scheduler_adapter.StreamJobsRequest.filter:type_name -> scow.scheduler_adapter.GetJobsRequest.Filter
+	13, // 17: scow.scheduler_adapter.StreamJobsRequest.sort:type_name -> scow.scheduler_adapter.SortInfo
+	8,  // 18: scow.scheduler_adapter.StreamJobsResponse.jobs:type_name -> scow.scheduler_adapter.JobInfo
+	8,  // 19: scow.scheduler_adapter.GetJobByIdResponse.job:type_name -> scow.scheduler_adapter.JobInfo
+	86, // 20: scow.scheduler_adapter.UpdateJobResponse.rejected_fields:type_name -> scow.scheduler_adapter.UpdateJobResponse.RejectedField
+	87, // 21: scow.scheduler_adapter.JobPriority.tres:type_name -> scow.scheduler_adapter.JobPriority.TresEntry
+	25, // 22: scow.scheduler_adapter.GetJobPriorityResponse.priorities:type_name -> scow.scheduler_adapter.JobPriority
+	10, // 23: scow.scheduler_adapter.SubmitJobRequest.dependencies:type_name -> scow.scheduler_adapter.JobDependency
+	30, // 24: scow.scheduler_adapter.SubmitJobRequest.het_components:type_name -> scow.scheduler_adapter.HetJobComponent
+	32, // 25: scow.scheduler_adapter.SubmitJobResponse.dry_run_result:type_name -> scow.scheduler_adapter.DryRunResult
+	96, // 26: scow.scheduler_adapter.DryRunResult.estimated_start_time:type_name -> google.protobuf.Timestamp
+	32, // 27: scow.scheduler_adapter.GetNextAvailableSlotResponse.slot:type_name -> scow.scheduler_adapter.DryRunResult
+	29, // 28: scow.scheduler_adapter.WorkflowJob.job:type_name -> scow.scheduler_adapter.SubmitJobRequest
+	36, // 29: scow.scheduler_adapter.WorkflowJob.depends_on:type_name -> scow.scheduler_adapter.WorkflowDependency
+	1,  // 30: scow.scheduler_adapter.WorkflowDependency.type:type_name -> scow.scheduler_adapter.JobDependency.Type
+	35, // 31: scow.scheduler_adapter.SubmitWorkflowRequest.jobs:type_name -> scow.scheduler_adapter.WorkflowJob
+	39, // 32: scow.scheduler_adapter.SubmitWorkflowResponse.jobs:type_name -> scow.scheduler_adapter.WorkflowJobResult
+	88, // 33: scow.scheduler_adapter.JobTemplate.variables:type_name -> scow.scheduler_adapter.JobTemplate.VariablesEntry
+	40, // 34: scow.scheduler_adapter.ListJobTemplatesResponse.templates:type_name -> scow.scheduler_adapter.JobTemplate
+	89, // 35: scow.scheduler_adapter.SubmitJobFromTemplateRequest.variables:type_name -> scow.scheduler_adapter.SubmitJobFromTemplateRequest.VariablesEntry
+	10, // 36: scow.scheduler_adapter.SubmitJobFromTemplateRequest.dependencies:type_name -> scow.scheduler_adapter.JobDependency
+	32, // 37: scow.scheduler_adapter.SubmitJobFromTemplateResponse.dry_run_result:type_name -> scow.scheduler_adapter.DryRunResult
+	90, // 38: scow.scheduler_adapter.CancelJobsRequest.filter:type_name -> scow.scheduler_adapter.CancelJobsRequest.Filter
+	49, // 39: scow.scheduler_adapter.CancelJobsResponse.results:type_name -> scow.scheduler_adapter.CancelJobResult
+	32, // 40: scow.scheduler_adapter.SubmitScriptAsJobResponse.dry_run_result:type_name -> scow.scheduler_adapter.DryRunResult
+	96, // 41: scow.scheduler_adapter.JobStepInfo.start_time:type_name -> google.protobuf.Timestamp
+	96, // 42: scow.scheduler_adapter.JobStepInfo.end_time:type_name -> google.protobuf.Timestamp
+	91, // 43: scow.scheduler_adapter.JobStepInfo.tres_alloc:type_name -> scow.scheduler_adapter.JobStepInfo.TresAllocEntry
+	92, // 44: scow.scheduler_adapter.JobStepInfo.tres_usage_in_max:type_name -> scow.scheduler_adapter.JobStepInfo.TresUsageInMaxEntry
+	93, // 45: scow.scheduler_adapter.JobStepInfo.tres_usage_in_ave:type_name -> scow.scheduler_adapter.JobStepInfo.TresUsageInAveEntry
+	94, // 46: scow.scheduler_adapter.JobStepInfo.tres_usage_in_tot:type_name -> scow.scheduler_adapter.JobStepInfo.TresUsageInTotEntry
+	95, // 47: scow.scheduler_adapter.WatchJobsRequest.filter:type_name -> scow.scheduler_adapter.WatchJobsRequest.Filter
+	3,  // 48: scow.scheduler_adapter.JobEvent.type:type_name -> scow.scheduler_adapter.JobEvent.Type
+	8,  // 49: scow.scheduler_adapter.JobEvent.job:type_name -> scow.scheduler_adapter.JobInfo
+	96, // 50: scow.scheduler_adapter.JobEvent.time:type_name -> google.protobuf.Timestamp
+	64, // 51: scow.scheduler_adapter.WatchJobsResponse.events:type_name -> scow.scheduler_adapter.JobEvent
+	52, // 52: scow.scheduler_adapter.GetJobStepsResponse.steps:type_name -> scow.scheduler_adapter.JobStepInfo
+	68, // 53: scow.scheduler_adapter.GetJobEfficiencyResponse.efficiency:type_name -> scow.scheduler_adapter.JobEfficiency
+	11, // 54: scow.scheduler_adapter.GetEfficiencySummaryRequest.end_time:type_name -> scow.scheduler_adapter.TimeRange
+	4,  // 55: scow.scheduler_adapter.GetEfficiencySummaryRequest.group_by:type_name -> scow.scheduler_adapter.GetEfficiencySummaryRequest.GroupBy
+	72, // 56: scow.scheduler_adapter.GetEfficiencySummaryResponse.summaries:type_name -> scow.scheduler_adapter.EfficiencySummary
+	11, // 57: scow.scheduler_adapter.GetUsageSummaryRequest.time_range:type_name -> scow.scheduler_adapter.TimeRange
+	5,  // 58: scow.scheduler_adapter.GetUsageSummaryRequest.group_by:type_name -> scow.scheduler_adapter.GetUsageSummaryRequest.GroupBy
+	6,  // 59: scow.scheduler_adapter.GetUsageSummaryRequest.bucket:type_name -> scow.scheduler_adapter.GetUsageSummaryRequest.Bucket
+	96, // 60: scow.scheduler_adapter.UsageSummary.bucket_start:type_name -> google.protobuf.Timestamp
+	75, // 61: scow.scheduler_adapter.GetUsageSummaryResponse.summaries:type_name -> scow.scheduler_adapter.UsageSummary
+	78, // 62: scow.scheduler_adapter.GetJobCostResponse.cost:type_name -> scow.scheduler_adapter.JobCost
+	85, // 63: scow.scheduler_adapter.GetJobsCostRequest.filter:type_name -> scow.scheduler_adapter.GetJobsRequest.Filter
+	12, // 64: scow.scheduler_adapter.GetJobsCostRequest.page_info:type_name -> scow.scheduler_adapter.PageInfo
+	78, // 65: scow.scheduler_adapter.GetJobsCostResponse.costs:type_name -> scow.scheduler_adapter.JobCost
+	7,  // 66: scow.scheduler_adapter.TailJobOutputRequest.output_type:type_name -> scow.scheduler_adapter.TailJobOutputRequest.OutputType
+	11, // 67: scow.scheduler_adapter.GetJobsRequest.Filter.submit_time:type_name -> scow.scheduler_adapter.TimeRange
+	11, // 68: scow.scheduler_adapter.GetJobsRequest.Filter.end_time:type_name -> scow.scheduler_adapter.TimeRange
+	14, // 69: scow.scheduler_adapter.JobService.GetJobs:input_type -> scow.scheduler_adapter.GetJobsRequest
+	16, // 70: scow.scheduler_adapter.JobService.StreamJobs:input_type -> scow.scheduler_adapter.StreamJobsRequest
+	18, // 71: scow.scheduler_adapter.JobService.GetJobById:input_type -> scow.scheduler_adapter.GetJobByIdRequest
+	20, // 72: scow.scheduler_adapter.JobService.ChangeJobTimeLimit:input_type -> scow.scheduler_adapter.ChangeJobTimeLimitRequest
+	22, // 73: scow.scheduler_adapter.JobService.UpdateJob:input_type -> scow.scheduler_adapter.UpdateJobRequest
+	24, // 74: scow.scheduler_adapter.JobService.GetJobPriority:input_type -> scow.scheduler_adapter.GetJobPriorityRequest
+	33, // 75: scow.scheduler_adapter.JobService.GetNextAvailableSlot:input_type -> scow.scheduler_adapter.GetNextAvailableSlotRequest
+	27, // 76: scow.scheduler_adapter.JobService.QueryJobTimeLimit:input_type -> scow.scheduler_adapter.QueryJobTimeLimitRequest
+	29, // 77: scow.scheduler_adapter.JobService.SubmitJob:input_type -> scow.scheduler_adapter.SubmitJobRequest
+	41, // 78: scow.scheduler_adapter.JobService.ListJobTemplates:input_type -> scow.scheduler_adapter.ListJobTemplatesRequest
+	43, // 79: scow.scheduler_adapter.JobService.SubmitJobFromTemplate:input_type -> scow.scheduler_adapter.SubmitJobFromTemplateRequest
+	37, // 80: scow.scheduler_adapter.JobService.SubmitWorkflow:input_type -> scow.scheduler_adapter.SubmitWorkflowRequest
+	45, // 81: scow.scheduler_adapter.JobService.CancelJob:input_type -> scow.scheduler_adapter.CancelJobRequest
+	47, // 82: scow.scheduler_adapter.JobService.CancelJobs:input_type -> scow.scheduler_adapter.CancelJobsRequest
+	53, // 83: scow.scheduler_adapter.JobService.HoldJob:input_type -> scow.scheduler_adapter.HoldJobRequest
+	55, // 84: scow.scheduler_adapter.JobService.ReleaseJob:input_type -> scow.scheduler_adapter.ReleaseJobRequest
+	57, // 85: scow.scheduler_adapter.JobService.SuspendJob:input_type -> scow.scheduler_adapter.SuspendJobRequest
+	59, // 86: scow.scheduler_adapter.JobService.ResumeJob:input_type -> scow.scheduler_adapter.ResumeJobRequest
+	61, // 87: scow.scheduler_adapter.JobService.RequeueJob:input_type -> scow.scheduler_adapter.RequeueJobRequest
+	50, // 88: scow.scheduler_adapter.JobService.SubmitScriptAsJob:input_type -> scow.scheduler_adapter.SubmitScriptAsJobRequest
+	82, // 89: scow.scheduler_adapter.JobService.TailJobOutput:input_type -> scow.scheduler_adapter.TailJobOutputRequest
+	63, // 90: scow.scheduler_adapter.JobService.WatchJobs:input_type -> scow.scheduler_adapter.WatchJobsRequest
+	66, // 91: scow.scheduler_adapter.JobService.GetJobSteps:input_type -> scow.scheduler_adapter.GetJobStepsRequest
+	69, // 92: scow.scheduler_adapter.JobService.GetJobEfficiency:input_type -> scow.scheduler_adapter.GetJobEfficiencyRequest
+	71, // 93: scow.scheduler_adapter.JobService.GetEfficiencySummary:input_type -> scow.scheduler_adapter.GetEfficiencySummaryRequest
+	74, // 94: scow.scheduler_adapter.JobService.GetUsageSummary:input_type -> scow.scheduler_adapter.GetUsageSummaryRequest
+	77, // 95: scow.scheduler_adapter.JobService.GetJobCost:input_type -> scow.scheduler_adapter.GetJobCostRequest
+	80, // 96: scow.scheduler_adapter.JobService.GetJobsCost:input_type -> scow.scheduler_adapter.GetJobsCostRequest
+	15, // 97: scow.scheduler_adapter.JobService.GetJobs:output_type -> scow.scheduler_adapter.GetJobsResponse
+	17, // 98: scow.scheduler_adapter.JobService.StreamJobs:output_type -> scow.scheduler_adapter.StreamJobsResponse
+	19, // 99: scow.scheduler_adapter.JobService.GetJobById:output_type -> scow.scheduler_adapter.GetJobByIdResponse
+	21, // 100: scow.scheduler_adapter.JobService.ChangeJobTimeLimit:output_type -> scow.scheduler_adapter.ChangeJobTimeLimitResponse
+	23, // 101: scow.scheduler_adapter.JobService.UpdateJob:output_type -> scow.scheduler_adapter.UpdateJobResponse
+	26, // 102: scow.scheduler_adapter.JobService.GetJobPriority:output_type -> scow.scheduler_adapter.GetJobPriorityResponse
+	34, // 103: scow.scheduler_adapter.JobService.GetNextAvailableSlot:output_type -> scow.scheduler_adapter.GetNextAvailableSlotResponse
+	28, // 104: scow.scheduler_adapter.JobService.QueryJobTimeLimit:output_type -> scow.scheduler_adapter.QueryJobTimeLimitResponse
+	31, // 105: scow.scheduler_adapter.JobService.SubmitJob:output_type -> scow.scheduler_adapter.SubmitJobResponse
+	42, // 106: scow.scheduler_adapter.JobService.ListJobTemplates:output_type -> scow.scheduler_adapter.ListJobTemplatesResponse
+	44, // 107: scow.scheduler_adapter.JobService.SubmitJobFromTemplate:output_type -> scow.scheduler_adapter.SubmitJobFromTemplateResponse
+	38, // 108: scow.scheduler_adapter.JobService.SubmitWorkflow:output_type -> scow.scheduler_adapter.SubmitWorkflowResponse
+	46, // 109: scow.scheduler_adapter.JobService.CancelJob:output_type -> scow.scheduler_adapter.CancelJobResponse
+	48, // 110: scow.scheduler_adapter.JobService.CancelJobs:output_type -> scow.scheduler_adapter.CancelJobsResponse
+	54, // 111: scow.scheduler_adapter.JobService.HoldJob:output_type -> scow.scheduler_adapter.HoldJobResponse
+	56, // 112: scow.scheduler_adapter.JobService.ReleaseJob:output_type -> scow.scheduler_adapter.ReleaseJobResponse
+	58, // 113: scow.scheduler_adapter.JobService.SuspendJob:output_type -> scow.scheduler_adapter.SuspendJobResponse
+	60, // 114: scow.scheduler_adapter.JobService.ResumeJob:output_type -> scow.scheduler_adapter.ResumeJobResponse
+	62, // 115: scow.scheduler_adapter.JobService.RequeueJob:output_type -> scow.scheduler_adapter.RequeueJobResponse
+	51, // 116: scow.scheduler_adapter.JobService.SubmitScriptAsJob:output_type -> scow.scheduler_adapter.SubmitScriptAsJobResponse
+	83, // 117: scow.scheduler_adapter.JobService.TailJobOutput:output_type -> scow.scheduler_adapter.TailJobOutputResponse
+	65, // 118: scow.scheduler_adapter.JobService.WatchJobs:output_type -> scow.scheduler_adapter.WatchJobsResponse
+	67, // 119: scow.scheduler_adapter.JobService.GetJobSteps:output_type -> scow.scheduler_adapter.GetJobStepsResponse
+	70, // 120: scow.scheduler_adapter.JobService.GetJobEfficiency:output_type -> scow.scheduler_adapter.GetJobEfficiencyResponse
+	73, // 121: scow.scheduler_adapter.JobService.GetEfficiencySummary:output_type -> scow.scheduler_adapter.GetEfficiencySummaryResponse
+	76, // 122: scow.scheduler_adapter.JobService.GetUsageSummary:output_type -> scow.scheduler_adapter.GetUsageSummaryResponse
+	79, // 123: scow.scheduler_adapter.JobService.GetJobCost:output_type -> scow.scheduler_adapter.GetJobCostResponse
+	81, // 124: scow.scheduler_adapter.JobService.GetJobsCost:output_type -> scow.scheduler_adapter.GetJobsCostResponse
+	97, // [97:125] is the sub-list for method output_type
+	69, // [69:97] is the sub-list for method input_type
+	69, // [69:69] is the sub-list for extension type_name
+	69, // [69:69] is the sub-list for extension extendee
+	0,  // [0:69] is the sub-list for field type_name
 }
 
 func init() { file_job_proto_init() }
@@ -1758,19 +8002,36 @@ func file_job_proto_init() {
 		return
 	}
 	file_job_proto_msgTypes[0].OneofWrappers = []any{}
-	file_job_proto_msgTypes[1].OneofWrappers = []any{}
-	file_job_proto_msgTypes[4].OneofWrappers = []any{}
-	file_job_proto_msgTypes[5].OneofWrappers = []any{}
-	file_job_proto_msgTypes[12].OneofWrappers = []any{}
-	file_job_proto_msgTypes[16].OneofWrappers = []any{}
-	file_job_proto_msgTypes[18].OneofWrappers = []any{}
+	file_job_proto_msgTypes[3].OneofWrappers = []any{}
+	file_job_proto_msgTypes[6].OneofWrappers = []any{}
+	file_job_proto_msgTypes[7].OneofWrappers = []any{}
+	file_job_proto_msgTypes[8].OneofWrappers = []any{}
+	file_job_proto_msgTypes[14].OneofWrappers = []any{}
+	file_job_proto_msgTypes[21].OneofWrappers = []any{}
+	file_job_proto_msgTypes[22].OneofWrappers = []any{}
+	file_job_proto_msgTypes[25].OneofWrappers = []any{}
+	file_job_proto_msgTypes[32].OneofWrappers = []any{}
+	file_job_proto_msgTypes[35].OneofWrappers = []any{}
+	file_job_proto_msgTypes[37].OneofWrappers = []any{}
+	file_job_proto_msgTypes[41].OneofWrappers = []any{}
+	file_job_proto_msgTypes[42].OneofWrappers = []any{}
+	file_job_proto_msgTypes[44].OneofWrappers = []any{}
+	file_job_proto_msgTypes[45].OneofWrappers = []any{}
+	file_job_proto_msgTypes[47].OneofWrappers = []any{}
+	file_job_proto_msgTypes[49].OneofWrappers = []any{}
+	file_job_proto_msgTypes[51].OneofWrappers = []any{}
+	file_job_proto_msgTypes[53].OneofWrappers = []any{}
+	file_job_proto_msgTypes[72].OneofWrappers = []any{}
+	file_job_proto_msgTypes[74].OneofWrappers = []any{}
+	file_job_proto_msgTypes[77].OneofWrappers = []any{}
+	file_job_proto_msgTypes[82].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_job_proto_rawDesc), len(file_job_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   19,
+			NumEnums:      8,
+			NumMessages:   88,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -30,13 +30,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	JobService_GetJobs_FullMethodName            = "/scow.scheduler_adapter.JobService/GetJobs"
-	JobService_GetJobById_FullMethodName         = "/scow.scheduler_adapter.JobService/GetJobById"
-	JobService_ChangeJobTimeLimit_FullMethodName = "/scow.scheduler_adapter.JobService/ChangeJobTimeLimit"
-	JobService_QueryJobTimeLimit_FullMethodName  = "/scow.scheduler_adapter.JobService/QueryJobTimeLimit"
-	JobService_SubmitJob_FullMethodName          = "/scow.scheduler_adapter.JobService/SubmitJob"
-	JobService_CancelJob_FullMethodName          = "/scow.scheduler_adapter.JobService/CancelJob"
-	JobService_SubmitScriptAsJob_FullMethodName  = "/scow.scheduler_adapter.JobService/SubmitScriptAsJob"
+	JobService_GetJobs_FullMethodName               = "/scow.scheduler_adapter.JobService/GetJobs"
+	JobService_StreamJobs_FullMethodName            = "/scow.scheduler_adapter.JobService/StreamJobs"
+	JobService_GetJobById_FullMethodName            = "/scow.scheduler_adapter.JobService/GetJobById"
+	JobService_ChangeJobTimeLimit_FullMethodName    = "/scow.scheduler_adapter.JobService/ChangeJobTimeLimit"
+	JobService_UpdateJob_FullMethodName             = "/scow.scheduler_adapter.JobService/UpdateJob"
+	JobService_GetJobPriority_FullMethodName        = "/scow.scheduler_adapter.JobService/GetJobPriority"
+	JobService_GetNextAvailableSlot_FullMethodName  = "/scow.scheduler_adapter.JobService/GetNextAvailableSlot"
+	JobService_QueryJobTimeLimit_FullMethodName     = "/scow.scheduler_adapter.JobService/QueryJobTimeLimit"
+	JobService_SubmitJob_FullMethodName             = "/scow.scheduler_adapter.JobService/SubmitJob"
+	JobService_ListJobTemplates_FullMethodName      = "/scow.scheduler_adapter.JobService/ListJobTemplates"
+	JobService_SubmitJobFromTemplate_FullMethodName = "/scow.scheduler_adapter.JobService/SubmitJobFromTemplate"
+	JobService_SubmitWorkflow_FullMethodName        = "/scow.scheduler_adapter.JobService/SubmitWorkflow"
+	JobService_CancelJob_FullMethodName             = "/scow.scheduler_adapter.JobService/CancelJob"
+	JobService_CancelJobs_FullMethodName            = "/scow.scheduler_adapter.JobService/CancelJobs"
+	JobService_HoldJob_FullMethodName               = "/scow.scheduler_adapter.JobService/HoldJob"
+	JobService_ReleaseJob_FullMethodName            = "/scow.scheduler_adapter.JobService/ReleaseJob"
+	JobService_SuspendJob_FullMethodName            = "/scow.scheduler_adapter.JobService/SuspendJob"
+	JobService_ResumeJob_FullMethodName             = "/scow.scheduler_adapter.JobService/ResumeJob"
+	JobService_RequeueJob_FullMethodName            = "/scow.scheduler_adapter.JobService/RequeueJob"
+	JobService_SubmitScriptAsJob_FullMethodName     = "/scow.scheduler_adapter.JobService/SubmitScriptAsJob"
+	JobService_TailJobOutput_FullMethodName         = "/scow.scheduler_adapter.JobService/TailJobOutput"
+	JobService_WatchJobs_FullMethodName             = "/scow.scheduler_adapter.JobService/WatchJobs"
+	JobService_GetJobSteps_FullMethodName           = "/scow.scheduler_adapter.JobService/GetJobSteps"
+	JobService_GetJobEfficiency_FullMethodName      = "/scow.scheduler_adapter.JobService/GetJobEfficiency"
+	JobService_GetEfficiencySummary_FullMethodName  = "/scow.scheduler_adapter.JobService/GetEfficiencySummary"
+	JobService_GetUsageSummary_FullMethodName       = "/scow.scheduler_adapter.JobService/GetUsageSummary"
+	JobService_GetJobCost_FullMethodName            = "/scow.scheduler_adapter.JobService/GetJobCost"
+	JobService_GetJobsCost_FullMethodName           = "/scow.scheduler_adapter.JobService/GetJobsCost"
 )
 
 // JobServiceClient is the client API for JobService service.
@@ -47,8 +68,20 @@ type JobServiceClient interface {
 	// description: get jobs with filter options
 	// special case:
 	// - some of fields not exist, discard them
+	// errors:
+	// - page_token is invalid
+	//   INVALID_ARGUMENT, INVALID_PAGE_TOKEN, {}
+	// - sort.field is not sortable
+	//   INVALID_ARGUMENT, INVALID_SORT_FIELD, {}
 	GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error)
 	//
+	// description: stream all jobs matching the filter in batches, for exporting large result sets.
+	// Jobs are read page by page with keyset pagination
+	// errors:
+	// - sort.field is not sortable
+	//   INVALID_ARGUMENT, INVALID_SORT_FIELD, {}
+	StreamJobs(ctx context.Context, in *StreamJobsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamJobsResponse], error)
+	//
 	// description: get job info by id
 	// special case:
 	// - job id not exist, don't throw
@@ -61,6 +94,43 @@ type JobServiceClient interface {
 	//   NOT_FOUND, JOB_NOT_FOUND, {}
 	ChangeJobTimeLimit(ctx context.Context, in *ChangeJobTimeLimitRequest, opts ...grpc.CallOption) (*ChangeJobTimeLimitResponse, error)
 	//
+	// description: change partition, qos, resources, name or comment of a pending job.
+	// Each field is applied separately, fields rejected by slurm are returned in the response
+	// errors:
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - job is not pending
+	//   FAILED_PRECONDITION, JOB_NOT_PENDING, {}
+	// - partition not found
+	//   NOT_FOUND, PARTITION_NOT_FOUND, {}
+	// - fields conflict with the partition config
+	//   INVALID_ARGUMENT, INVALID_JOB_UPDATE, {
+	//     <field>: reason
+	//   }
+	UpdateJob(ctx context.Context, in *UpdateJobRequest, opts ...grpc.CallOption) (*UpdateJobResponse, error)
+	//
+	// description: get the multifactor priority of a pending job and its rank among
+	// the pending jobs in each of its partitions
+	// errors:
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - job is not pending
+	//   FAILED_PRECONDITION, JOB_NOT_PENDING, {}
+	// - sprio is not available, e.g. with the rest backend
+	//   UNIMPLEMENTED, NOT_SUPPORTED, {}
+	GetJobPriority(ctx context.Context, in *GetJobPriorityRequest, opts ...grpc.CallOption) (*GetJobPriorityResponse, error)
+	//
+	// description: estimate when a job of the given size could start in a partition,
+	// without submitting it. If slurm would reject the job, the reason is in the result
+	// errors:
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - sbatch failed
+	//   UNKNOWN, SBATCH_FAILED, {}
+	// - sbatch --test-only is not available, e.g. with the rest backend
+	//   UNIMPLEMENTED, NOT_SUPPORTED, {}
+	GetNextAvailableSlot(ctx context.Context, in *GetNextAvailableSlotRequest, opts ...grpc.CallOption) (*GetNextAvailableSlotResponse, error)
+	//
 	// description: query time limit
 	// errors:
 	// - job not found
@@ -75,8 +145,41 @@ type JobServiceClient interface {
 	//   }
 	// - user not exist
 	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - a dependency has no type, or a dependency other than SINGLETON has no job ids
+	//   INVALID_ARGUMENT, INVALID_DEPENDENCY, {}
+	// - dry_run is not supported by the slurm backend
+	//   UNIMPLEMENTED, NOT_SUPPORTED, {}
+	// - het_components is used together with array, or a component has no nodes or cores
+	//   INVALID_ARGUMENT, INVALID_HET_JOB, {}
 	SubmitJob(ctx context.Context, in *SubmitJobRequest, opts ...grpc.CallOption) (*SubmitJobResponse, error)
 	//
+	// description: list job templates defined in the config file
+	ListJobTemplates(ctx context.Context, in *ListJobTemplatesRequest, opts ...grpc.CallOption) (*ListJobTemplatesResponse, error)
+	//
+	// description: submit a job from a template, the same as SubmitJob with
+	// the defaults of the template and the script rendered with the variables
+	// errors:
+	// - template not found
+	//   NOT_FOUND, TEMPLATE_NOT_FOUND, {}
+	// - a required variable is not set
+	//   INVALID_ARGUMENT, TEMPLATE_VARIABLE_NOT_SET, {}
+	// - the same errors as SubmitJob
+	SubmitJobFromTemplate(ctx context.Context, in *SubmitJobFromTemplateRequest, opts ...grpc.CallOption) (*SubmitJobFromTemplateResponse, error)
+	//
+	// description: submit jobs of a DAG in topological order, dependencies are
+	// converted to the ids of the submitted jobs. If a submission fails,
+	// jobs already submitted are cancelled.
+	// errors:
+	// - job names are not unique, a dependency is unknown or the workflow has a cycle
+	//   INVALID_ARGUMENT, INVALID_WORKFLOW, {}
+	// - a job failed to submit
+	//   UNKNOWN, SBATCH_FAILED, { "job_name": the name of the job in the workflow }
+	// - a job has an invalid array spec or dependency, or a depends_on has no type
+	//   INVALID_ARGUMENT, INVALID_ARRAY_SPEC or INVALID_DEPENDENCY, { "job_name": the name of the job in the workflow }
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	SubmitWorkflow(ctx context.Context, in *SubmitWorkflowRequest, opts ...grpc.CallOption) (*SubmitWorkflowResponse, error)
+	//
 	// description: cancel a job
 	// errors:
 	// - user not exist
@@ -85,6 +188,96 @@ type JobServiceClient interface {
 	//   NOT_FOUND, JOB_NOT_FOUND, {}
 	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error)
 	//
+	// description: cancel all unfinished jobs matching the filter
+	// errors:
+	// - no filter option is set
+	//   INVALID_ARGUMENT, FILTER_NOT_SET, {}
+	// - user_id is empty and admin is false, or both are set
+	//   INVALID_ARGUMENT, INVALID_OPERATOR, {}
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	CancelJobs(ctx context.Context, in *CancelJobsRequest, opts ...grpc.CallOption) (*CancelJobsResponse, error)
+	//
+	// description: hold a pending job so that it will not be scheduled
+	// errors:
+	// - user_id is empty and admin is false, or both are set
+	//   INVALID_ARGUMENT, INVALID_OPERATOR, {}
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - the job is not owned by user_id
+	//   PERMISSION_DENIED, JOB_NOT_OWNED, {}
+	// - reason is set by a user
+	//   INVALID_ARGUMENT, REASON_NOT_ALLOWED, {}
+	// - slurm rejected the operation
+	//   UNKNOWN, HOLD_JOB_FAILED, {}
+	HoldJob(ctx context.Context, in *HoldJobRequest, opts ...grpc.CallOption) (*HoldJobResponse, error)
+	//
+	// description: release a held job
+	// errors:
+	// - user_id is empty and admin is false, or both are set
+	//   INVALID_ARGUMENT, INVALID_OPERATOR, {}
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - the job is not owned by user_id
+	//   PERMISSION_DENIED, JOB_NOT_OWNED, {}
+	// - reason is set by a user
+	//   INVALID_ARGUMENT, REASON_NOT_ALLOWED, {}
+	// - slurm rejected the operation
+	//   UNKNOWN, RELEASE_JOB_FAILED, {}
+	ReleaseJob(ctx context.Context, in *ReleaseJobRequest, opts ...grpc.CallOption) (*ReleaseJobResponse, error)
+	//
+	// description: suspend a running job, it is done by the adapter since slurm only allows operators to suspend jobs
+	// errors:
+	// - user_id is empty and admin is false, or both are set
+	//   INVALID_ARGUMENT, INVALID_OPERATOR, {}
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - the job is not owned by user_id
+	//   PERMISSION_DENIED, JOB_NOT_OWNED, {}
+	// - reason is set by a user
+	//   INVALID_ARGUMENT, REASON_NOT_ALLOWED, {}
+	// - slurm rejected the operation
+	//   UNKNOWN, SUSPEND_JOB_FAILED, {}
+	SuspendJob(ctx context.Context, in *SuspendJobRequest, opts ...grpc.CallOption) (*SuspendJobResponse, error)
+	//
+	// description: resume a suspended job, it is done by the adapter since slurm only allows operators to resume jobs
+	// errors:
+	// - user_id is empty and admin is false, or both are set
+	//   INVALID_ARGUMENT, INVALID_OPERATOR, {}
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - the job is not owned by user_id
+	//   PERMISSION_DENIED, JOB_NOT_OWNED, {}
+	// - reason is set by a user
+	//   INVALID_ARGUMENT, REASON_NOT_ALLOWED, {}
+	// - slurm rejected the operation
+	//   UNKNOWN, RESUME_JOB_FAILED, {}
+	ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*ResumeJobResponse, error)
+	//
+	// description: requeue a running or ended batch job
+	// errors:
+	// - user_id is empty and admin is false, or both are set
+	//   INVALID_ARGUMENT, INVALID_OPERATOR, {}
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - the job is not owned by user_id
+	//   PERMISSION_DENIED, JOB_NOT_OWNED, {}
+	// - reason is set by a user
+	//   INVALID_ARGUMENT, REASON_NOT_ALLOWED, {}
+	// - slurm rejected the operation
+	//   UNKNOWN, REQUEUE_JOB_FAILED, {}
+	RequeueJob(ctx context.Context, in *RequeueJobRequest, opts ...grpc.CallOption) (*RequeueJobResponse, error)
+	//
 	// description: submit a script  as a job
 	// errors:
 	// - sbatch failed
@@ -93,7 +286,73 @@ type JobServiceClient interface {
 	//   }
 	// - user not exist
 	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - dry_run is not supported by the slurm backend
+	//   UNIMPLEMENTED, NOT_SUPPORTED, {}
 	SubmitScriptAsJob(ctx context.Context, in *SubmitScriptAsJobRequest, opts ...grpc.CallOption) (*SubmitScriptAsJobResponse, error)
+	//
+	// description: stream the stdout or stderr file of a job as the job owner.
+	// new output is sent as it is written, the stream ends when the job
+	// reaches a terminal state or max_bytes is reached
+	// errors:
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - the job is not owned by user_id
+	//   PERMISSION_DENIED, JOB_NOT_OWNED, {}
+	// - output file not exist after the job ended
+	//   NOT_FOUND, OUTPUT_FILE_NOT_FOUND, {}
+	// - read output file failed
+	//   INTERNAL, READ_OUTPUT_FAILED, {}
+	TailJobOutput(ctx context.Context, in *TailJobOutputRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TailJobOutputResponse], error)
+	//
+	// description: stream state changes of the jobs matching the filter.
+	// All watchers share one poller of slurmctld.
+	// errors:
+	// - the client does not receive events fast enough
+	//   RESOURCE_EXHAUSTED, WATCHER_TOO_SLOW, {}
+	WatchJobs(ctx context.Context, in *WatchJobsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchJobsResponse], error)
+	//
+	// description: get all steps of a job
+	// errors:
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	GetJobSteps(ctx context.Context, in *GetJobStepsRequest, opts ...grpc.CallOption) (*GetJobStepsResponse, error)
+	//
+	// description: get cpu, memory and time efficiency of a job,
+	// the same as seff
+	// errors:
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	GetJobEfficiency(ctx context.Context, in *GetJobEfficiencyRequest, opts ...grpc.CallOption) (*GetJobEfficiencyResponse, error)
+	//
+	// description: aggregate efficiency of ended jobs per user or per account
+	// errors:
+	// - end_time not set
+	//   INVALID_ARGUMENT, END_TIME_NOT_SET, {}
+	GetEfficiencySummary(ctx context.Context, in *GetEfficiencySummaryRequest, opts ...grpc.CallOption) (*GetEfficiencySummaryResponse, error)
+	//
+	// description: aggregate job count, cpu, gpu and memory hours of jobs
+	// by user, account, partition, qos and time bucket
+	// errors:
+	// - time_range not set
+	//   INVALID_ARGUMENT, TIME_RANGE_NOT_SET, {}
+	GetUsageSummary(ctx context.Context, in *GetUsageSummaryRequest, opts ...grpc.CallOption) (*GetUsageSummaryResponse, error)
+	//
+	// description: cost of a job by the billing config of the adapter,
+	// jobs not ended are charged until now
+	// errors:
+	// - billing not configured
+	//   FAILED_PRECONDITION, BILLING_NOT_CONFIGURED, {}
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	GetJobCost(ctx context.Context, in *GetJobCostRequest, opts ...grpc.CallOption) (*GetJobCostResponse, error)
+	//
+	// description: costs of the jobs matching the filter
+	// errors:
+	// - billing not configured
+	//   FAILED_PRECONDITION, BILLING_NOT_CONFIGURED, {}
+	GetJobsCost(ctx context.Context, in *GetJobsCostRequest, opts ...grpc.CallOption) (*GetJobsCostResponse, error)
 }
 
 type jobServiceClient struct {
@@ -114,6 +373,25 @@ func (c *jobServiceClient) GetJobs(ctx context.Context, in *GetJobsRequest, opts
 	return out, nil
 }
 
+func (c *jobServiceClient) StreamJobs(ctx context.Context, in *StreamJobsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamJobsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &JobService_ServiceDesc.Streams[0], JobService_StreamJobs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamJobsRequest, StreamJobsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JobService_StreamJobsClient = grpc.ServerStreamingClient[StreamJobsResponse]
+
 func (c *jobServiceClient) GetJobById(ctx context.Context, in *GetJobByIdRequest, opts ...grpc.CallOption) (*GetJobByIdResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetJobByIdResponse)
@@ -134,6 +412,36 @@ func (c *jobServiceClient) ChangeJobTimeLimit(ctx context.Context, in *ChangeJob
 	return out, nil
 }
 
+func (c *jobServiceClient) UpdateJob(ctx context.Context, in *UpdateJobRequest, opts ...grpc.CallOption) (*UpdateJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateJobResponse)
+	err := c.cc.Invoke(ctx, JobService_UpdateJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobPriority(ctx context.Context, in *GetJobPriorityRequest, opts ...grpc.CallOption) (*GetJobPriorityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobPriorityResponse)
+	err := c.cc.Invoke(ctx, JobService_GetJobPriority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetNextAvailableSlot(ctx context.Context, in *GetNextAvailableSlotRequest, opts ...grpc.CallOption) (*GetNextAvailableSlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNextAvailableSlotResponse)
+	err := c.cc.Invoke(ctx, JobService_GetNextAvailableSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jobServiceClient) QueryJobTimeLimit(ctx context.Context, in *QueryJobTimeLimitRequest, opts ...grpc.CallOption) (*QueryJobTimeLimitResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryJobTimeLimitResponse)
@@ -154,6 +462,36 @@ func (c *jobServiceClient) SubmitJob(ctx context.Context, in *SubmitJobRequest,
 	return out, nil
 }
 
+func (c *jobServiceClient) ListJobTemplates(ctx context.Context, in *ListJobTemplatesRequest, opts ...grpc.CallOption) (*ListJobTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobTemplatesResponse)
+	err := c.cc.Invoke(ctx, JobService_ListJobTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) SubmitJobFromTemplate(ctx context.Context, in *SubmitJobFromTemplateRequest, opts ...grpc.CallOption) (*SubmitJobFromTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitJobFromTemplateResponse)
+	err := c.cc.Invoke(ctx, JobService_SubmitJobFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) SubmitWorkflow(ctx context.Context, in *SubmitWorkflowRequest, opts ...grpc.CallOption) (*SubmitWorkflowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitWorkflowResponse)
+	err := c.cc.Invoke(ctx, JobService_SubmitWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jobServiceClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CancelJobResponse)
@@ -164,6 +502,66 @@ func (c *jobServiceClient) CancelJob(ctx context.Context, in *CancelJobRequest,
 	return out, nil
 }
 
+func (c *jobServiceClient) CancelJobs(ctx context.Context, in *CancelJobsRequest, opts ...grpc.CallOption) (*CancelJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelJobsResponse)
+	err := c.cc.Invoke(ctx, JobService_CancelJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) HoldJob(ctx context.Context, in *HoldJobRequest, opts ...grpc.CallOption) (*HoldJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HoldJobResponse)
+	err := c.cc.Invoke(ctx, JobService_HoldJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ReleaseJob(ctx context.Context, in *ReleaseJobRequest, opts ...grpc.CallOption) (*ReleaseJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseJobResponse)
+	err := c.cc.Invoke(ctx, JobService_ReleaseJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) SuspendJob(ctx context.Context, in *SuspendJobRequest, opts ...grpc.CallOption) (*SuspendJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendJobResponse)
+	err := c.cc.Invoke(ctx, JobService_SuspendJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*ResumeJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeJobResponse)
+	err := c.cc.Invoke(ctx, JobService_ResumeJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RequeueJob(ctx context.Context, in *RequeueJobRequest, opts ...grpc.CallOption) (*RequeueJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequeueJobResponse)
+	err := c.cc.Invoke(ctx, JobService_RequeueJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jobServiceClient) SubmitScriptAsJob(ctx context.Context, in *SubmitScriptAsJobRequest, opts ...grpc.CallOption) (*SubmitScriptAsJobResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SubmitScriptAsJobResponse)
@@ -174,6 +572,104 @@ func (c *jobServiceClient) SubmitScriptAsJob(ctx context.Context, in *SubmitScri
 	return out, nil
 }
 
+func (c *jobServiceClient) TailJobOutput(ctx context.Context, in *TailJobOutputRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TailJobOutputResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &JobService_ServiceDesc.Streams[1], JobService_TailJobOutput_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TailJobOutputRequest, TailJobOutputResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JobService_TailJobOutputClient = grpc.ServerStreamingClient[TailJobOutputResponse]
+
+func (c *jobServiceClient) WatchJobs(ctx context.Context, in *WatchJobsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchJobsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &JobService_ServiceDesc.Streams[2], JobService_WatchJobs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchJobsRequest, WatchJobsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JobService_WatchJobsClient = grpc.ServerStreamingClient[WatchJobsResponse]
+
+func (c *jobServiceClient) GetJobSteps(ctx context.Context, in *GetJobStepsRequest, opts ...grpc.CallOption) (*GetJobStepsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobStepsResponse)
+	err := c.cc.Invoke(ctx, JobService_GetJobSteps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobEfficiency(ctx context.Context, in *GetJobEfficiencyRequest, opts ...grpc.CallOption) (*GetJobEfficiencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobEfficiencyResponse)
+	err := c.cc.Invoke(ctx, JobService_GetJobEfficiency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetEfficiencySummary(ctx context.Context, in *GetEfficiencySummaryRequest, opts ...grpc.CallOption) (*GetEfficiencySummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEfficiencySummaryResponse)
+	err := c.cc.Invoke(ctx, JobService_GetEfficiencySummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetUsageSummary(ctx context.Context, in *GetUsageSummaryRequest, opts ...grpc.CallOption) (*GetUsageSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageSummaryResponse)
+	err := c.cc.Invoke(ctx, JobService_GetUsageSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobCost(ctx context.Context, in *GetJobCostRequest, opts ...grpc.CallOption) (*GetJobCostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobCostResponse)
+	err := c.cc.Invoke(ctx, JobService_GetJobCost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobsCost(ctx context.Context, in *GetJobsCostRequest, opts ...grpc.CallOption) (*GetJobsCostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobsCostResponse)
+	err := c.cc.Invoke(ctx, JobService_GetJobsCost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JobServiceServer is the server API for JobService service.
 // All implementations should embed UnimplementedJobServiceServer
 // for forward compatibility.
@@ -182,8 +678,20 @@ type JobServiceServer interface {
 	// description: get jobs with filter options
 	// special case:
 	// - some of fields not exist, discard them
+	// errors:
+	// - page_token is invalid
+	//   INVALID_ARGUMENT, INVALID_PAGE_TOKEN, {}
+	// - sort.field is not sortable
+	//   INVALID_ARGUMENT, INVALID_SORT_FIELD, {}
 	GetJobs(context.Context, *GetJobsRequest) (*GetJobsResponse, error)
 	//
+	// description: stream all jobs matching the filter in batches, for exporting large result sets.
+	// Jobs are read page by page with keyset pagination
+	// errors:
+	// - sort.field is not sortable
+	//   INVALID_ARGUMENT, INVALID_SORT_FIELD, {}
+	StreamJobs(*StreamJobsRequest, grpc.ServerStreamingServer[StreamJobsResponse]) error
+	//
 	// description: get job info by id
 	// special case:
 	// - job id not exist, don't throw
@@ -196,13 +704,50 @@ type JobServiceServer interface {
 	//   NOT_FOUND, JOB_NOT_FOUND, {}
 	ChangeJobTimeLimit(context.Context, *ChangeJobTimeLimitRequest) (*ChangeJobTimeLimitResponse, error)
 	//
-	// description: query time limit
+	// description: change partition, qos, resources, name or comment of a pending job.
+	// Each field is applied separately, fields rejected by slurm are returned in the response
 	// errors:
 	// - job not found
 	//   NOT_FOUND, JOB_NOT_FOUND, {}
-	QueryJobTimeLimit(context.Context, *QueryJobTimeLimitRequest) (*QueryJobTimeLimitResponse, error)
+	// - job is not pending
+	//   FAILED_PRECONDITION, JOB_NOT_PENDING, {}
+	// - partition not found
+	//   NOT_FOUND, PARTITION_NOT_FOUND, {}
+	// - fields conflict with the partition config
+	//   INVALID_ARGUMENT, INVALID_JOB_UPDATE, {
+	//     <field>: reason
+	//   }
+	UpdateJob(context.Context, *UpdateJobRequest) (*UpdateJobResponse, error)
 	//
-	// description: submit job
+	// description: get the multifactor priority of a pending job and its rank among
+	// the pending jobs in each of its partitions
+	// errors:
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - job is not pending
+	//   FAILED_PRECONDITION, JOB_NOT_PENDING, {}
+	// - sprio is not available, e.g. with the rest backend
+	//   UNIMPLEMENTED, NOT_SUPPORTED, {}
+	GetJobPriority(context.Context, *GetJobPriorityRequest) (*GetJobPriorityResponse, error)
+	//
+	// description: estimate when a job of the given size could start in a partition,
+	// without submitting it. If slurm would reject the job, the reason is in the result
+	// errors:
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - sbatch failed
+	//   UNKNOWN, SBATCH_FAILED, {}
+	// - sbatch --test-only is not available, e.g. with the rest backend
+	//   UNIMPLEMENTED, NOT_SUPPORTED, {}
+	GetNextAvailableSlot(context.Context, *GetNextAvailableSlotRequest) (*GetNextAvailableSlotResponse, error)
+	//
+	// description: query time limit
+	// errors:
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	QueryJobTimeLimit(context.Context, *QueryJobTimeLimitRequest) (*QueryJobTimeLimitResponse, error)
+	//
+	// description: submit job
 	// errors:
 	// - sbatch failed
 	//   UNKNOWN, SBATCH_FAILED, {
@@ -210,8 +755,41 @@ type JobServiceServer interface {
 	//   }
 	// - user not exist
 	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - a dependency has no type, or a dependency other than SINGLETON has no job ids
+	//   INVALID_ARGUMENT, INVALID_DEPENDENCY, {}
+	// - dry_run is not supported by the slurm backend
+	//   UNIMPLEMENTED, NOT_SUPPORTED, {}
+	// - het_components is used together with array, or a component has no nodes or cores
+	//   INVALID_ARGUMENT, INVALID_HET_JOB, {}
 	SubmitJob(context.Context, *SubmitJobRequest) (*SubmitJobResponse, error)
 	//
+	// description: list job templates defined in the config file
+	ListJobTemplates(context.Context, *ListJobTemplatesRequest) (*ListJobTemplatesResponse, error)
+	//
+	// description: submit a job from a template, the same as SubmitJob with
+	// the defaults of the template and the script rendered with the variables
+	// errors:
+	// - template not found
+	//   NOT_FOUND, TEMPLATE_NOT_FOUND, {}
+	// - a required variable is not set
+	//   INVALID_ARGUMENT, TEMPLATE_VARIABLE_NOT_SET, {}
+	// - the same errors as SubmitJob
+	SubmitJobFromTemplate(context.Context, *SubmitJobFromTemplateRequest) (*SubmitJobFromTemplateResponse, error)
+	//
+	// description: submit jobs of a DAG in topological order, dependencies are
+	// converted to the ids of the submitted jobs. If a submission fails,
+	// jobs already submitted are cancelled.
+	// errors:
+	// - job names are not unique, a dependency is unknown or the workflow has a cycle
+	//   INVALID_ARGUMENT, INVALID_WORKFLOW, {}
+	// - a job failed to submit
+	//   UNKNOWN, SBATCH_FAILED, { "job_name": the name of the job in the workflow }
+	// - a job has an invalid array spec or dependency, or a depends_on has no type
+	//   INVALID_ARGUMENT, INVALID_ARRAY_SPEC or INVALID_DEPENDENCY, { "job_name": the name of the job in the workflow }
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	SubmitWorkflow(context.Context, *SubmitWorkflowRequest) (*SubmitWorkflowResponse, error)
+	//
 	// description: cancel a job
 	// errors:
 	// - user not exist
@@ -220,6 +798,96 @@ type JobServiceServer interface {
 	//   NOT_FOUND, JOB_NOT_FOUND, {}
 	CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error)
 	//
+	// description: cancel all unfinished jobs matching the filter
+	// errors:
+	// - no filter option is set
+	//   INVALID_ARGUMENT, FILTER_NOT_SET, {}
+	// - user_id is empty and admin is false, or both are set
+	//   INVALID_ARGUMENT, INVALID_OPERATOR, {}
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	CancelJobs(context.Context, *CancelJobsRequest) (*CancelJobsResponse, error)
+	//
+	// description: hold a pending job so that it will not be scheduled
+	// errors:
+	// - user_id is empty and admin is false, or both are set
+	//   INVALID_ARGUMENT, INVALID_OPERATOR, {}
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - the job is not owned by user_id
+	//   PERMISSION_DENIED, JOB_NOT_OWNED, {}
+	// - reason is set by a user
+	//   INVALID_ARGUMENT, REASON_NOT_ALLOWED, {}
+	// - slurm rejected the operation
+	//   UNKNOWN, HOLD_JOB_FAILED, {}
+	HoldJob(context.Context, *HoldJobRequest) (*HoldJobResponse, error)
+	//
+	// description: release a held job
+	// errors:
+	// - user_id is empty and admin is false, or both are set
+	//   INVALID_ARGUMENT, INVALID_OPERATOR, {}
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - the job is not owned by user_id
+	//   PERMISSION_DENIED, JOB_NOT_OWNED, {}
+	// - reason is set by a user
+	//   INVALID_ARGUMENT, REASON_NOT_ALLOWED, {}
+	// - slurm rejected the operation
+	//   UNKNOWN, RELEASE_JOB_FAILED, {}
+	ReleaseJob(context.Context, *ReleaseJobRequest) (*ReleaseJobResponse, error)
+	//
+	// description: suspend a running job, it is done by the adapter since slurm only allows operators to suspend jobs
+	// errors:
+	// - user_id is empty and admin is false, or both are set
+	//   INVALID_ARGUMENT, INVALID_OPERATOR, {}
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - the job is not owned by user_id
+	//   PERMISSION_DENIED, JOB_NOT_OWNED, {}
+	// - reason is set by a user
+	//   INVALID_ARGUMENT, REASON_NOT_ALLOWED, {}
+	// - slurm rejected the operation
+	//   UNKNOWN, SUSPEND_JOB_FAILED, {}
+	SuspendJob(context.Context, *SuspendJobRequest) (*SuspendJobResponse, error)
+	//
+	// description: resume a suspended job, it is done by the adapter since slurm only allows operators to resume jobs
+	// errors:
+	// - user_id is empty and admin is false, or both are set
+	//   INVALID_ARGUMENT, INVALID_OPERATOR, {}
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - the job is not owned by user_id
+	//   PERMISSION_DENIED, JOB_NOT_OWNED, {}
+	// - reason is set by a user
+	//   INVALID_ARGUMENT, REASON_NOT_ALLOWED, {}
+	// - slurm rejected the operation
+	//   UNKNOWN, RESUME_JOB_FAILED, {}
+	ResumeJob(context.Context, *ResumeJobRequest) (*ResumeJobResponse, error)
+	//
+	// description: requeue a running or ended batch job
+	// errors:
+	// - user_id is empty and admin is false, or both are set
+	//   INVALID_ARGUMENT, INVALID_OPERATOR, {}
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - the job is not owned by user_id
+	//   PERMISSION_DENIED, JOB_NOT_OWNED, {}
+	// - reason is set by a user
+	//   INVALID_ARGUMENT, REASON_NOT_ALLOWED, {}
+	// - slurm rejected the operation
+	//   UNKNOWN, REQUEUE_JOB_FAILED, {}
+	RequeueJob(context.Context, *RequeueJobRequest) (*RequeueJobResponse, error)
+	//
 	// description: submit a script  as a job
 	// errors:
 	// - sbatch failed
@@ -228,7 +896,73 @@ type JobServiceServer interface {
 	//   }
 	// - user not exist
 	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - dry_run is not supported by the slurm backend
+	//   UNIMPLEMENTED, NOT_SUPPORTED, {}
 	SubmitScriptAsJob(context.Context, *SubmitScriptAsJobRequest) (*SubmitScriptAsJobResponse, error)
+	//
+	// description: stream the stdout or stderr file of a job as the job owner.
+	// new output is sent as it is written, the stream ends when the job
+	// reaches a terminal state or max_bytes is reached
+	// errors:
+	// - user not exist
+	//   NOT_FOUND, USER_NOT_FOUND, {}
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	// - the job is not owned by user_id
+	//   PERMISSION_DENIED, JOB_NOT_OWNED, {}
+	// - output file not exist after the job ended
+	//   NOT_FOUND, OUTPUT_FILE_NOT_FOUND, {}
+	// - read output file failed
+	//   INTERNAL, READ_OUTPUT_FAILED, {}
+	TailJobOutput(*TailJobOutputRequest, grpc.ServerStreamingServer[TailJobOutputResponse]) error
+	//
+	// description: stream state changes of the jobs matching the filter.
+	// All watchers share one poller of slurmctld.
+	// errors:
+	// - the client does not receive events fast enough
+	//   RESOURCE_EXHAUSTED, WATCHER_TOO_SLOW, {}
+	WatchJobs(*WatchJobsRequest, grpc.ServerStreamingServer[WatchJobsResponse]) error
+	//
+	// description: get all steps of a job
+	// errors:
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	GetJobSteps(context.Context, *GetJobStepsRequest) (*GetJobStepsResponse, error)
+	//
+	// description: get cpu, memory and time efficiency of a job,
+	// the same as seff
+	// errors:
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	GetJobEfficiency(context.Context, *GetJobEfficiencyRequest) (*GetJobEfficiencyResponse, error)
+	//
+	// description: aggregate efficiency of ended jobs per user or per account
+	// errors:
+	// - end_time not set
+	//   INVALID_ARGUMENT, END_TIME_NOT_SET, {}
+	GetEfficiencySummary(context.Context, *GetEfficiencySummaryRequest) (*GetEfficiencySummaryResponse, error)
+	//
+	// description: aggregate job count, cpu, gpu and memory hours of jobs
+	// by user, account, partition, qos and time bucket
+	// errors:
+	// - time_range not set
+	//   INVALID_ARGUMENT, TIME_RANGE_NOT_SET, {}
+	GetUsageSummary(context.Context, *GetUsageSummaryRequest) (*GetUsageSummaryResponse, error)
+	//
+	// description: cost of a job by the billing config of the adapter,
+	// jobs not ended are charged until now
+	// errors:
+	// - billing not configured
+	//   FAILED_PRECONDITION, BILLING_NOT_CONFIGURED, {}
+	// - job not found
+	//   NOT_FOUND, JOB_NOT_FOUND, {}
+	GetJobCost(context.Context, *GetJobCostRequest) (*GetJobCostResponse, error)
+	//
+	// description: costs of the jobs matching the filter
+	// errors:
+	// - billing not configured
+	//   FAILED_PRECONDITION, BILLING_NOT_CONFIGURED, {}
+	GetJobsCost(context.Context, *GetJobsCostRequest) (*GetJobsCostResponse, error)
 }
 
 // UnimplementedJobServiceServer should be embedded to have
@@ -241,24 +975,87 @@ type UnimplementedJobServiceServer struct{}
 func (UnimplementedJobServiceServer) GetJobs(context.Context, *GetJobsRequest) (*GetJobsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJobs not implemented")
 }
+func (UnimplementedJobServiceServer) StreamJobs(*StreamJobsRequest, grpc.ServerStreamingServer[StreamJobsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamJobs not implemented")
+}
 func (UnimplementedJobServiceServer) GetJobById(context.Context, *GetJobByIdRequest) (*GetJobByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJobById not implemented")
 }
 func (UnimplementedJobServiceServer) ChangeJobTimeLimit(context.Context, *ChangeJobTimeLimitRequest) (*ChangeJobTimeLimitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeJobTimeLimit not implemented")
 }
+func (UnimplementedJobServiceServer) UpdateJob(context.Context, *UpdateJobRequest) (*UpdateJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJob not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobPriority(context.Context, *GetJobPriorityRequest) (*GetJobPriorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobPriority not implemented")
+}
+func (UnimplementedJobServiceServer) GetNextAvailableSlot(context.Context, *GetNextAvailableSlotRequest) (*GetNextAvailableSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextAvailableSlot not implemented")
+}
 func (UnimplementedJobServiceServer) QueryJobTimeLimit(context.Context, *QueryJobTimeLimitRequest) (*QueryJobTimeLimitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryJobTimeLimit not implemented")
 }
 func (UnimplementedJobServiceServer) SubmitJob(context.Context, *SubmitJobRequest) (*SubmitJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitJob not implemented")
 }
+func (UnimplementedJobServiceServer) ListJobTemplates(context.Context, *ListJobTemplatesRequest) (*ListJobTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobTemplates not implemented")
+}
+func (UnimplementedJobServiceServer) SubmitJobFromTemplate(context.Context, *SubmitJobFromTemplateRequest) (*SubmitJobFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitJobFromTemplate not implemented")
+}
+func (UnimplementedJobServiceServer) SubmitWorkflow(context.Context, *SubmitWorkflowRequest) (*SubmitWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitWorkflow not implemented")
+}
 func (UnimplementedJobServiceServer) CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
 }
+func (UnimplementedJobServiceServer) CancelJobs(context.Context, *CancelJobsRequest) (*CancelJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobs not implemented")
+}
+func (UnimplementedJobServiceServer) HoldJob(context.Context, *HoldJobRequest) (*HoldJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldJob not implemented")
+}
+func (UnimplementedJobServiceServer) ReleaseJob(context.Context, *ReleaseJobRequest) (*ReleaseJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseJob not implemented")
+}
+func (UnimplementedJobServiceServer) SuspendJob(context.Context, *SuspendJobRequest) (*SuspendJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendJob not implemented")
+}
+func (UnimplementedJobServiceServer) ResumeJob(context.Context, *ResumeJobRequest) (*ResumeJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJob not implemented")
+}
+func (UnimplementedJobServiceServer) RequeueJob(context.Context, *RequeueJobRequest) (*RequeueJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueJob not implemented")
+}
 func (UnimplementedJobServiceServer) SubmitScriptAsJob(context.Context, *SubmitScriptAsJobRequest) (*SubmitScriptAsJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitScriptAsJob not implemented")
 }
+func (UnimplementedJobServiceServer) TailJobOutput(*TailJobOutputRequest, grpc.ServerStreamingServer[TailJobOutputResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method TailJobOutput not implemented")
+}
+func (UnimplementedJobServiceServer) WatchJobs(*WatchJobsRequest, grpc.ServerStreamingServer[WatchJobsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJobs not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobSteps(context.Context, *GetJobStepsRequest) (*GetJobStepsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobSteps not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobEfficiency(context.Context, *GetJobEfficiencyRequest) (*GetJobEfficiencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobEfficiency not implemented")
+}
+func (UnimplementedJobServiceServer) GetEfficiencySummary(context.Context, *GetEfficiencySummaryRequest) (*GetEfficiencySummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEfficiencySummary not implemented")
+}
+func (UnimplementedJobServiceServer) GetUsageSummary(context.Context, *GetUsageSummaryRequest) (*GetUsageSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageSummary not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobCost(context.Context, *GetJobCostRequest) (*GetJobCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobCost not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobsCost(context.Context, *GetJobsCostRequest) (*GetJobsCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobsCost not implemented")
+}
 func (UnimplementedJobServiceServer) testEmbeddedByValue() {}
 
 // UnsafeJobServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -297,6 +1094,17 @@ func _JobService_GetJobs_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobService_StreamJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobServiceServer).StreamJobs(m, &grpc.GenericServerStream[StreamJobsRequest, StreamJobsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JobService_StreamJobsServer = grpc.ServerStreamingServer[StreamJobsResponse]
+
 func _JobService_GetJobById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetJobByIdRequest)
 	if err := dec(in); err != nil {
@@ -333,6 +1141,60 @@ func _JobService_ChangeJobTimeLimit_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobService_UpdateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).UpdateJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_UpdateJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).UpdateJob(ctx, req.(*UpdateJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetJobPriority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobPriority(ctx, req.(*GetJobPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetNextAvailableSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextAvailableSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetNextAvailableSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetNextAvailableSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetNextAvailableSlot(ctx, req.(*GetNextAvailableSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JobService_QueryJobTimeLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryJobTimeLimitRequest)
 	if err := dec(in); err != nil {
@@ -369,6 +1231,60 @@ func _JobService_SubmitJob_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobService_ListJobTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListJobTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListJobTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListJobTemplates(ctx, req.(*ListJobTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_SubmitJobFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitJobFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).SubmitJobFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_SubmitJobFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).SubmitJobFromTemplate(ctx, req.(*SubmitJobFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_SubmitWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).SubmitWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_SubmitWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).SubmitWorkflow(ctx, req.(*SubmitWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JobService_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelJobRequest)
 	if err := dec(in); err != nil {
@@ -387,6 +1303,114 @@ func _JobService_CancelJob_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobService_CancelJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).CancelJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_CancelJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CancelJobs(ctx, req.(*CancelJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_HoldJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).HoldJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_HoldJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).HoldJob(ctx, req.(*HoldJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ReleaseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ReleaseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ReleaseJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ReleaseJob(ctx, req.(*ReleaseJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_SuspendJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).SuspendJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_SuspendJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).SuspendJob(ctx, req.(*SuspendJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ResumeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ResumeJob(ctx, req.(*ResumeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_RequeueJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequeueJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RequeueJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_RequeueJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RequeueJob(ctx, req.(*RequeueJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JobService_SubmitScriptAsJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitScriptAsJobRequest)
 	if err := dec(in); err != nil {
@@ -405,6 +1429,136 @@ func _JobService_SubmitScriptAsJob_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobService_TailJobOutput_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailJobOutputRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobServiceServer).TailJobOutput(m, &grpc.GenericServerStream[TailJobOutputRequest, TailJobOutputResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JobService_TailJobOutputServer = grpc.ServerStreamingServer[TailJobOutputResponse]
+
+func _JobService_WatchJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobServiceServer).WatchJobs(m, &grpc.GenericServerStream[WatchJobsRequest, WatchJobsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JobService_WatchJobsServer = grpc.ServerStreamingServer[WatchJobsResponse]
+
+func _JobService_GetJobSteps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobStepsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobSteps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetJobSteps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobSteps(ctx, req.(*GetJobStepsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobEfficiency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobEfficiencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobEfficiency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetJobEfficiency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobEfficiency(ctx, req.(*GetJobEfficiencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetEfficiencySummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEfficiencySummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetEfficiencySummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetEfficiencySummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetEfficiencySummary(ctx, req.(*GetEfficiencySummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetUsageSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetUsageSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetUsageSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetUsageSummary(ctx, req.(*GetUsageSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetJobCost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobCost(ctx, req.(*GetJobCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobsCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobsCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobsCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetJobsCost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobsCost(ctx, req.(*GetJobsCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JobService_ServiceDesc is the grpc.ServiceDesc for JobService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -424,6 +1578,18 @@ var JobService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeJobTimeLimit",
 			Handler:    _JobService_ChangeJobTimeLimit_Handler,
 		},
+		{
+			MethodName: "UpdateJob",
+			Handler:    _JobService_UpdateJob_Handler,
+		},
+		{
+			MethodName: "GetJobPriority",
+			Handler:    _JobService_GetJobPriority_Handler,
+		},
+		{
+			MethodName: "GetNextAvailableSlot",
+			Handler:    _JobService_GetNextAvailableSlot_Handler,
+		},
 		{
 			MethodName: "QueryJobTimeLimit",
 			Handler:    _JobService_QueryJobTimeLimit_Handler,
@@ -432,15 +1598,91 @@ var JobService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitJob",
 			Handler:    _JobService_SubmitJob_Handler,
 		},
+		{
+			MethodName: "ListJobTemplates",
+			Handler:    _JobService_ListJobTemplates_Handler,
+		},
+		{
+			MethodName: "SubmitJobFromTemplate",
+			Handler:    _JobService_SubmitJobFromTemplate_Handler,
+		},
+		{
+			MethodName: "SubmitWorkflow",
+			Handler:    _JobService_SubmitWorkflow_Handler,
+		},
 		{
 			MethodName: "CancelJob",
 			Handler:    _JobService_CancelJob_Handler,
 		},
+		{
+			MethodName: "CancelJobs",
+			Handler:    _JobService_CancelJobs_Handler,
+		},
+		{
+			MethodName: "HoldJob",
+			Handler:    _JobService_HoldJob_Handler,
+		},
+		{
+			MethodName: "ReleaseJob",
+			Handler:    _JobService_ReleaseJob_Handler,
+		},
+		{
+			MethodName: "SuspendJob",
+			Handler:    _JobService_SuspendJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _JobService_ResumeJob_Handler,
+		},
+		{
+			MethodName: "RequeueJob",
+			Handler:    _JobService_RequeueJob_Handler,
+		},
 		{
 			MethodName: "SubmitScriptAsJob",
 			Handler:    _JobService_SubmitScriptAsJob_Handler,
 		},
+		{
+			MethodName: "GetJobSteps",
+			Handler:    _JobService_GetJobSteps_Handler,
+		},
+		{
+			MethodName: "GetJobEfficiency",
+			Handler:    _JobService_GetJobEfficiency_Handler,
+		},
+		{
+			MethodName: "GetEfficiencySummary",
+			Handler:    _JobService_GetEfficiencySummary_Handler,
+		},
+		{
+			MethodName: "GetUsageSummary",
+			Handler:    _JobService_GetUsageSummary_Handler,
+		},
+		{
+			MethodName: "GetJobCost",
+			Handler:    _JobService_GetJobCost_Handler,
+		},
+		{
+			MethodName: "GetJobsCost",
+			Handler:    _JobService_GetJobsCost_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamJobs",
+			Handler:       _JobService_StreamJobs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TailJobOutput",
+			Handler:       _JobService_TailJobOutput_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchJobs",
+			Handler:       _JobService_WatchJobs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "job.proto",
 }
@@ -28,9 +28,17 @@ func main() {
 		return
 	}
 
+	// 大量作业可以用GetJobs分页或者StreamJobs获取, 不需要很大的消息
+	maxMessageSize := caller.ConfigValue.Service.MaxMessageSize
+	if maxMessageSize == 0 {
+		maxMessageSize = 1024
+	}
 	s := grpc.NewServer(
-		grpc.MaxRecvMsgSize(1024*1024*1024), // 最大接受size 1GB
-		grpc.MaxSendMsgSize(1024*1024*1024), // 最大发送size 1GB
+		grpc.MaxRecvMsgSize(maxMessageSize*1024*1024), // 最大接受size
+		grpc.MaxSendMsgSize(maxMessageSize*1024*1024), // 最大发送size
+		// 根据metadata中的cluster选择请求所在的集群
+		grpc.UnaryInterceptor(caller.UnaryClusterInterceptor),
+		grpc.StreamInterceptor(caller.StreamClusterInterceptor),
 	) // 创建gRPC服务器
 	pb.RegisterUserServiceServer(s, &user.ServerUser{})
 	pb.RegisterAccountServiceServer(s, &account.ServerAccount{})
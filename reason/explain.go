@@ -0,0 +1,132 @@
+package reason
+
+import (
+	"strings"
+)
+
+// 默认语言, 请求没有指定或者指定了不支持的语言时使用
+const DefaultLocale = "en"
+
+// 一种语言的说明文字, 模板中的{name}替换为同名参数
+type catalog struct {
+	codes      map[string]string   // 优先使用原因代码对应的说明
+	categories map[Category]string // 其次使用分类的说明
+	scopes     map[string]string   // 限制层级的名字
+}
+
+// 添加语言时在这里增加一个catalog, 每种语言只能有一个catalog, 如不能同时有zh-CN和zh-TW
+var catalogs = map[string]*catalog{
+	"en": {
+		codes: map[string]string{
+			"ReqNodeNotAvail":          "The requested nodes are not available.",
+			"BeginTime":                "Waiting for the requested start time.",
+			"DependencyNeverSatisfied": "The dependency can never be satisfied, the job will not start unless its dependency is changed.",
+			"JobHeldUser":              "The job is held by its user, release it to let it run.",
+			"JobHeldAdmin":             "The job is held by an administrator.",
+			"PartitionInactive":        "The partition is inactive and does not start new jobs.",
+			"InvalidAccount":           "The account is invalid or has been blocked.",
+			"InvalidQOS":               "The QOS is invalid or not allowed for the account.",
+			"JobArrayTaskLimit":        "The job array has reached its limit of simultaneously running tasks.",
+			"AccountingPolicy":         "A limit of the accounting policy has been reached.",
+			"MaxMemPerLimit":           "The requested memory exceeds the MaxMemPerCPU or MaxMemPerNode limit.",
+			"QOSUsageThreshold":        "The QOS usage has reached its threshold.",
+			"QOSMinCpuNotSatisfied":    "The job requests fewer CPUs than the minimum of the QOS.",
+			"BadConstraints":           "The constraints of the job cannot be satisfied by the nodes.",
+			AccountNotPermitted:        "The account {account} is not allowed to use partition {partition}, it may have been blocked.",
+		},
+		categories: map[Category]string{
+			CategoryOther:          "The job is pending ({code}).",
+			CategoryResources:      "Waiting for resources to become available.",
+			CategoryPriority:       "Waiting for jobs with higher priority to start first.",
+			CategoryLimits:         "The {scope} limit {limit} has been reached, the job will start when usage drops below it.",
+			CategoryDependency:     "Waiting for the jobs it depends on.",
+			CategoryHeld:           "The job is held and must be released before it can start.",
+			CategoryPartitionDown:  "The partition is down.",
+			CategoryAccountBlocked: "The account is not allowed to use the partition, it may have been blocked.",
+		},
+		scopes: map[string]string{
+			"association": "association",
+			"qos":         "QOS",
+			"partition":   "partition",
+			"array":       "job array",
+		},
+	},
+	"zh-CN": {
+		codes: map[string]string{
+			"ReqNodeNotAvail":          "作业请求的节点不可用。",
+			"BeginTime":                "等待作业指定的开始时间。",
+			"DependencyNeverSatisfied": "作业的依赖永远无法满足, 修改依赖前作业不会开始运行。",
+			"JobHeldUser":              "作业被用户挂起, 释放后才能运行。",
+			"JobHeldAdmin":             "作业被管理员挂起。",
+			"PartitionInactive":        "分区未启用, 不会开始新的作业。",
+			"InvalidAccount":           "账户无效或者已被封锁。",
+			"InvalidQOS":               "QOS无效或者账户不能使用该QOS。",
+			"JobArrayTaskLimit":        "作业数组同时运行的任务数达到了限制。",
+			"AccountingPolicy":         "达到了记账策略中的限制。",
+			"MaxMemPerLimit":           "请求的内存超过了MaxMemPerCPU或MaxMemPerNode限制。",
+			"QOSUsageThreshold":        "QOS的用量达到了阈值。",
+			"QOSMinCpuNotSatisfied":    "作业请求的CPU少于QOS的最小值。",
+			"BadConstraints":           "节点不能满足作业的约束条件。",
+			AccountNotPermitted:        "账户{account}不能使用分区{partition}, 可能已被封锁。",
+		},
+		categories: map[Category]string{
+			CategoryOther:          "作业正在排队({code})。",
+			CategoryResources:      "正在等待资源。",
+			CategoryPriority:       "正在等待优先级更高的作业先运行。",
+			CategoryLimits:         "达到了{scope}的{limit}限制, 用量降低后作业才会开始运行。",
+			CategoryDependency:     "正在等待依赖的作业。",
+			CategoryHeld:           "作业被挂起, 释放后才能运行。",
+			CategoryPartitionDown:  "分区不可用。",
+			CategoryAccountBlocked: "账户不能使用该分区, 可能已被封锁。",
+		},
+		scopes: map[string]string{
+			"association": "关联",
+			"qos":         "QOS",
+			"partition":   "分区",
+			"array":       "作业数组",
+		},
+	},
+}
+
+// 按Accept-Language选择支持的语言, 如zh-CN,zh;q=0.9,en;q=0.8选择zh-CN.
+// 只按语言匹配, 忽略地区和q值, 按出现的顺序选择第一个支持的语言
+func MatchLocale(acceptLanguage string) string {
+	for _, item := range strings.Split(acceptLanguage, ",") {
+		tag, _, _ := strings.Cut(strings.TrimSpace(item), ";")
+		language, _, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+		if language == "" {
+			continue
+		}
+		for locale := range catalogs {
+			if prefix, _, _ := strings.Cut(locale, "-"); strings.EqualFold(prefix, language) {
+				return locale
+			}
+		}
+	}
+	return DefaultLocale
+}
+
+// 排队原因的说明, 不支持的语言使用默认语言.
+// 原因代码的说明中缺少参数时使用分类的说明
+func (r *Reason) Explain(locale string) string {
+	c, ok := catalogs[locale]
+	if !ok {
+		c = catalogs[DefaultLocale]
+	}
+	replacements := []string{"{code}", r.Code, "{scope}", c.scopes[r.Params["scope"]]}
+	for name, value := range r.Params {
+		if name != "scope" {
+			replacements = append(replacements, "{"+name+"}", value)
+		}
+	}
+	replacer := strings.NewReplacer(replacements...)
+	explanation := replacer.Replace(c.codes[r.Code])
+	if explanation == "" || strings.Contains(explanation, "{") {
+		explanation = replacer.Replace(c.categories[r.Category])
+	}
+	// ReqNodeNotAvail等原因带上不可用的节点
+	if nodes := r.Params["unavailable_nodes"]; nodes != "" {
+		explanation = strings.TrimRight(explanation, ".。") + ": " + nodes
+	}
+	return explanation
+}
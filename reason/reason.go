@@ -0,0 +1,294 @@
+package reason
+
+import (
+	"regexp"
+	"strings"
+)
+
+// 排队原因的分类, 值和PendingReason.Category中的名字一致, 不随slurm版本变化
+type Category string
+
+const (
+	CategoryOther          Category = "other"
+	CategoryResources      Category = "resources"
+	CategoryPriority       Category = "priority"
+	CategoryLimits         Category = "limits"
+	CategoryDependency     Category = "dependency"
+	CategoryHeld           Category = "held"
+	CategoryPartitionDown  Category = "partition_down"
+	CategoryAccountBlocked Category = "account_blocked"
+)
+
+// 分类后的排队原因
+type Reason struct {
+	Code     string // slurm的原因代码, 去掉了逗号后面的参数, 如ReqNodeNotAvail
+	Category Category
+	Params   map[string]string // 从原始原因中解析出的参数, 如unavailable_nodes、scope、limit
+}
+
+// 账户没有分区权限时slurm返回的是一句话, 后面带上分区允许的账户, 如
+// Job's account not permitted to use this partition (compute allows a,b not c)
+const AccountNotPermitted = "Job's account not permitted to use this partition"
+
+// squeue的原因去掉外面的括号时可能把最后的右括号也去掉了
+var accountNotPermittedRegexp = regexp.MustCompile(`\((\S+) allows (.*) not ([^\s)]+)\)?`)
+
+// slurm的job_state_reason中的原因代码, 见slurm_protocol_defs.c中的job_state_reason_string.
+// 列出所有代码保证分类不依赖前缀的猜测, 新版本增加的Assoc和QOS限制按前缀分到limits
+var codeCategories = map[string]Category{
+	// 资源不足或者节点不可用
+	"WaitingForScheduling": CategoryResources,
+	"Resources":            CategoryResources,
+	"ReqNodeNotAvail":      CategoryResources,
+	"NodeDown":             CategoryResources,
+	"BadConstraints":       CategoryResources,
+	"Reservation":          CategoryResources,
+	"Licenses":             CategoryResources,
+	"BurstBufferResources": CategoryResources,
+	"BurstBufferStageIn":   CategoryResources,
+	"FrontEndDown":         CategoryResources,
+	"PowerNotAvail":        CategoryResources,
+	"PowerReserved":        CategoryResources,
+	"Prolog":               CategoryResources,
+	"Cleaning":             CategoryResources,
+	"Nodes required for job are DOWN, DRAINED or reserved for jobs in higher priority partitions": CategoryResources,
+	"Priority":                 CategoryPriority,
+	"Dependency":               CategoryDependency,
+	"DependencyNeverSatisfied": CategoryDependency,
+	// 挂起, 包括启动失败后重新排队并挂起的作业
+	"JobHeldUser":                 CategoryHeld,
+	"JobHeldAdmin":                CategoryHeld,
+	"JobHoldMaxRequeue":           CategoryHeld,
+	"launch failed requeued held": CategoryHeld,
+	"job requeued in held state":  CategoryHeld,
+	"PartitionDown":               CategoryPartitionDown,
+	"PartitionInactive":           CategoryPartitionDown,
+	"InvalidAccount":              CategoryAccountBlocked,
+	"AccountNotAllowed":           CategoryAccountBlocked,
+	AccountNotPermitted:           CategoryAccountBlocked,
+	// 分区、作业数组、关联和QOS上的限制, 关联和QOS的限制名见limitParams
+	"PartitionNodeLimit":              CategoryLimits,
+	"PartitionTimeLimit":              CategoryLimits,
+	"JobArrayTaskLimit":               CategoryLimits,
+	"AccountingPolicy":                CategoryLimits,
+	"InvalidQOS":                      CategoryLimits,
+	"MaxMemPerLimit":                  CategoryLimits,
+	"AssociationJobLimit":             CategoryLimits,
+	"AssociationResourceLimit":        CategoryLimits,
+	"AssociationTimeLimit":            CategoryLimits,
+	"AssocGrpCpuLimit":                CategoryLimits,
+	"AssocGrpCPUMinutesLimit":         CategoryLimits,
+	"AssocGrpCPURunMinutesLimit":      CategoryLimits,
+	"AssocGrpJobsLimit":               CategoryLimits,
+	"AssocGrpMemLimit":                CategoryLimits,
+	"AssocGrpNodeLimit":               CategoryLimits,
+	"AssocGrpSubmitJobsLimit":         CategoryLimits,
+	"AssocGrpWallLimit":               CategoryLimits,
+	"AssocMaxJobsLimit":               CategoryLimits,
+	"AssocMaxCpuPerJobLimit":          CategoryLimits,
+	"AssocMaxCpuMinutesPerJobLimit":   CategoryLimits,
+	"AssocMaxNodePerJobLimit":         CategoryLimits,
+	"AssocMaxWallDurationPerJobLimit": CategoryLimits,
+	"AssocMaxSubmitJobLimit":          CategoryLimits,
+	"AssocGrpEnergy":                  CategoryLimits,
+	"AssocGrpEnergyMinutes":           CategoryLimits,
+	"AssocGrpEnergyRunMinutes":        CategoryLimits,
+	"AssocGrpGRES":                    CategoryLimits,
+	"AssocGrpGRESMinutes":             CategoryLimits,
+	"AssocGrpGRESRunMinutes":          CategoryLimits,
+	"AssocGrpLicense":                 CategoryLimits,
+	"AssocGrpLicenseMinutes":          CategoryLimits,
+	"AssocGrpLicenseRunMinutes":       CategoryLimits,
+	"AssocGrpMemMinutes":              CategoryLimits,
+	"AssocGrpMemRunMinutes":           CategoryLimits,
+	"AssocGrpNodeMinutes":             CategoryLimits,
+	"AssocGrpNodeRunMinutes":          CategoryLimits,
+	"AssocGrpBB":                      CategoryLimits,
+	"AssocGrpBBMinutes":               CategoryLimits,
+	"AssocGrpBBRunMinutes":            CategoryLimits,
+	"AssocGrpBilling":                 CategoryLimits,
+	"AssocGrpBillingMinutes":          CategoryLimits,
+	"AssocGrpBillingRunMinutes":       CategoryLimits,
+	"AssocMaxEnergyPerJob":            CategoryLimits,
+	"AssocMaxEnergyMinutesPerJob":     CategoryLimits,
+	"AssocMaxGRESPerJob":              CategoryLimits,
+	"AssocMaxGRESMinutesPerJob":       CategoryLimits,
+	"AssocMaxLicensePerJob":           CategoryLimits,
+	"AssocMaxLicenseMinutesPerJob":    CategoryLimits,
+	"AssocMaxMemPerJob":               CategoryLimits,
+	"AssocMaxMemMinutesPerJob":        CategoryLimits,
+	"AssocMaxNodeMinutesPerJob":       CategoryLimits,
+	"AssocMaxBBPerJob":                CategoryLimits,
+	"AssocMaxBBMinutesPerJob":         CategoryLimits,
+	"AssocMaxBillingPerJob":           CategoryLimits,
+	"AssocMaxBillingMinutesPerJob":    CategoryLimits,
+	"QOSJobLimit":                     CategoryLimits,
+	"QOSResourceLimit":                CategoryLimits,
+	"QOSTimeLimit":                    CategoryLimits,
+	"QOSUsageThreshold":               CategoryLimits,
+	"QOSGrpCpuLimit":                  CategoryLimits,
+	"QOSGrpCPUMinutesLimit":           CategoryLimits,
+	"QOSGrpCPURunMinutesLimit":        CategoryLimits,
+	"QOSGrpJobsLimit":                 CategoryLimits,
+	"QOSGrpMemLimit":                  CategoryLimits,
+	"QOSGrpNodeLimit":                 CategoryLimits,
+	"QOSGrpSubmitJobsLimit":           CategoryLimits,
+	"QOSGrpWallLimit":                 CategoryLimits,
+	"QOSGrpEnergy":                    CategoryLimits,
+	"QOSGrpEnergyMinutes":             CategoryLimits,
+	"QOSGrpEnergyRunMinutes":          CategoryLimits,
+	"QOSGrpGRES":                      CategoryLimits,
+	"QOSGrpGRESMinutes":               CategoryLimits,
+	"QOSGrpGRESRunMinutes":            CategoryLimits,
+	"QOSGrpLicense":                   CategoryLimits,
+	"QOSGrpLicenseMinutes":            CategoryLimits,
+	"QOSGrpLicenseRunMinutes":         CategoryLimits,
+	"QOSGrpMemoryMinutes":             CategoryLimits,
+	"QOSGrpMemoryRunMinutes":          CategoryLimits,
+	"QOSGrpNodeMinutes":               CategoryLimits,
+	"QOSGrpNodeRunMinutes":            CategoryLimits,
+	"QOSGrpBB":                        CategoryLimits,
+	"QOSGrpBBMinutes":                 CategoryLimits,
+	"QOSGrpBBRunMinutes":              CategoryLimits,
+	"QOSGrpBilling":                   CategoryLimits,
+	"QOSGrpBillingMinutes":            CategoryLimits,
+	"QOSGrpBillingRunMinutes":         CategoryLimits,
+	"QOSMaxCpuPerJobLimit":            CategoryLimits,
+	"QOSMaxCpuMinutesPerJobLimit":     CategoryLimits,
+	"QOSMaxNodePerJobLimit":           CategoryLimits,
+	"QOSMaxWallDurationPerJobLimit":   CategoryLimits,
+	"QOSMaxCpuPerUserLimit":           CategoryLimits,
+	"QOSMaxJobsPerUserLimit":          CategoryLimits,
+	"QOSMaxNodePerUserLimit":          CategoryLimits,
+	"QOSMaxSubmitJobPerUserLimit":     CategoryLimits,
+	"QOSMinCpuNotSatisfied":           CategoryLimits,
+	"QOSMaxEnergyPerJob":              CategoryLimits,
+	"QOSMaxEnergyMinutesPerJob":       CategoryLimits,
+	"QOSMaxEnergyPerNode":             CategoryLimits,
+	"QOSMaxEnergyPerUser":             CategoryLimits,
+	"QOSMaxGRESPerJob":                CategoryLimits,
+	"QOSMaxGRESMinutesPerJob":         CategoryLimits,
+	"QOSMaxGRESPerNode":               CategoryLimits,
+	"QOSMaxGRESPerUser":               CategoryLimits,
+	"QOSMaxLicensePerJob":             CategoryLimits,
+	"QOSMaxLicenseMinutesPerJob":      CategoryLimits,
+	"QOSMaxMemoryPerJob":              CategoryLimits,
+	"QOSMaxMemoryMinutesPerJob":       CategoryLimits,
+	"QOSMaxMemoryPerNode":             CategoryLimits,
+	"QOSMaxMemoryPerUser":             CategoryLimits,
+	"QOSMaxNodeMinutesPerJob":         CategoryLimits,
+	"QOSMaxBBPerJob":                  CategoryLimits,
+	"QOSMaxBBMinutesPerJob":           CategoryLimits,
+	"QOSMaxBBPerNode":                 CategoryLimits,
+	"QOSMaxBBPerUser":                 CategoryLimits,
+	"QOSMaxBillingPerJob":             CategoryLimits,
+	"QOSMaxBillingMinutesPerJob":      CategoryLimits,
+	"QOSMaxBillingPerNode":            CategoryLimits,
+	"QOSMaxBillingPerUser":            CategoryLimits,
+	"QOSMaxCpuPerNode":                CategoryLimits,
+	"QOSMinEnergy":                    CategoryLimits,
+	"QOSMinGRES":                      CategoryLimits,
+	"QOSMinLicense":                   CategoryLimits,
+	"QOSMinMemory":                    CategoryLimits,
+	"QOSMinNode":                      CategoryLimits,
+	"QOSMinBB":                        CategoryLimits,
+	"QOSMinBilling":                   CategoryLimits,
+	"QOSMaxBBPerAccount":              CategoryLimits,
+	"QOSMaxBillingPerAccount":         CategoryLimits,
+	"QOSMaxCpuPerAccount":             CategoryLimits,
+	"QOSMaxEnergyPerAccount":          CategoryLimits,
+	"QOSMaxGRESPerAccount":            CategoryLimits,
+	"QOSMaxNodePerAccount":            CategoryLimits,
+	"QOSMaxLicensePerAccount":         CategoryLimits,
+	"QOSMaxMemoryPerAccount":          CategoryLimits,
+	"QOSMaxUnknownPerAccount":         CategoryLimits,
+	"QOSMaxJobsPerAccount":            CategoryLimits,
+	"QOSMaxSubmitJobsPerAccount":      CategoryLimits,
+	// 等待开始时间、分区配置不允许以及作业失败后重新排队等其他原因
+	"BeginTime":            CategoryOther,
+	"PartitionConfig":      CategoryOther,
+	"SchedDefer":           CategoryOther,
+	"SystemFailure":        CategoryOther,
+	"JobLaunchFailure":     CategoryOther,
+	"NonZeroExitCode":      CategoryOther,
+	"RaisedSignal":         CategoryOther,
+	"TimeLimit":            CategoryOther,
+	"InactiveLimit":        CategoryOther,
+	"BurstBufferOperation": CategoryOther,
+	"DeadLine":             CategoryOther,
+	"FedJobLock":           CategoryOther,
+	"OutOfMemory":          CategoryOther,
+	"ReservationDeleted":   CategoryOther,
+}
+
+// 按前缀区分限制所在的层级, 长的前缀在前
+var limitScopes = []struct {
+	prefix string
+	scope  string
+}{
+	{"Association", "association"},
+	{"Assoc", "association"},
+	{"QOS", "qos"},
+	{"Partition", "partition"},
+	{"JobArrayTask", "array"},
+}
+
+// 限制类原因的层级和限制名, 如AssocGrpCPUMinutesLimit为association和GrpCPUMinutes
+func limitParams(code string) map[string]string {
+	for _, scope := range limitScopes {
+		if limit, ok := strings.CutPrefix(code, scope.prefix); ok {
+			params := map[string]string{"scope": scope.scope}
+			if limit = strings.TrimSuffix(limit, "Limit"); limit != "" {
+				params["limit"] = limit
+			}
+			return params
+		}
+	}
+	return nil
+}
+
+// 把squeue %r中的排队原因分类, 外面的括号可以不去掉, 没有原因时返回nil
+func Classify(raw string) *Reason {
+	raw = strings.TrimSpace(raw)
+	if strings.HasPrefix(raw, "(") && strings.HasSuffix(raw, ")") {
+		raw = raw[1 : len(raw)-1]
+	}
+	if raw == "" || raw == "None" {
+		return nil
+	}
+	if strings.HasPrefix(raw, AccountNotPermitted) {
+		reason := &Reason{Code: AccountNotPermitted, Category: CategoryAccountBlocked}
+		if match := accountNotPermittedRegexp.FindStringSubmatch(raw); match != nil {
+			reason.Params = map[string]string{"partition": match[1], "allowed_accounts": match[2], "account": match[3]}
+		}
+		return reason
+	}
+	if category, ok := codeCategories[raw]; ok {
+		reason := &Reason{Code: raw, Category: category}
+		if category == CategoryLimits {
+			reason.Params = limitParams(raw)
+		}
+		return reason
+	}
+	// 带参数的原因, 如ReqNodeNotAvail, UnavailableNodes:cn[01-04]
+	code, detail, _ := strings.Cut(raw, ", ")
+	reason := &Reason{Code: code, Category: CategoryOther}
+	if category, ok := codeCategories[code]; ok {
+		reason.Category = category
+	} else if strings.HasPrefix(code, "Assoc") || strings.HasPrefix(code, "QOS") {
+		// 关联和QOS上的限制种类很多, 如AssocGrpGRESMinutes、QOSMaxCpuPerUserLimit
+		reason.Category = CategoryLimits
+		reason.Params = limitParams(code)
+	}
+	if nodes, ok := strings.CutPrefix(detail, "UnavailableNodes:"); ok {
+		if nodes != "" {
+			reason.Params = map[string]string{"unavailable_nodes": nodes}
+		}
+	} else if detail != "" {
+		if reason.Params == nil {
+			reason.Params = make(map[string]string)
+		}
+		reason.Params["detail"] = detail
+	}
+	return reason
+}
@@ -2,7 +2,9 @@ package account
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"scow-slurm-adapter/backend"
 	"scow-slurm-adapter/caller"
 	pb "scow-slurm-adapter/gen/go"
 	"scow-slurm-adapter/utils"
@@ -24,9 +26,7 @@ type ServerAccount struct {
 
 func (s *ServerAccount) ListAccounts(ctx context.Context, in *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
 	var (
-		userName  string
-		assocAcct string
-		acctList  []string
+		acctList []string
 	)
 	caller.Logger.Infof("Received request ListAccounts: %v", in)
 	// 检查用户名中是否包含大写字母
@@ -40,13 +40,10 @@ func (s *ServerAccount) ListAccounts(ctx context.Context, in *pb.ListAccountsReq
 		caller.Logger.Errorf("ListAccounts failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	// 获取集群名
-	clusterName := caller.ConfigValue.MySQLConfig.ClusterName
 
 	// 判断用户在slurm中是否存在
-	userSqlConfig := "SELECT name FROM user_table WHERE name = ? AND deleted = 0"
-	err := caller.DB.QueryRow(userSqlConfig, in.UserId).Scan(&userName)
-	if err != nil {
+	exists, err := caller.GetBackend(ctx).UserExists(in.UserId)
+	if err != nil || !exists {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "USER_NOT_FOUND",
 		}
@@ -57,8 +54,7 @@ func (s *ServerAccount) ListAccounts(ctx context.Context, in *pb.ListAccountsReq
 		return nil, st.Err()
 	}
 	// 查询用户相关联的所有账户信息
-	assocSqlConfig := fmt.Sprintf("SELECT DISTINCT acct FROM %s_assoc_table WHERE user = ? AND deleted = 0", clusterName)
-	rows, err := caller.DB.Query(assocSqlConfig, in.UserId)
+	assocs, err := caller.GetBackend(ctx).ListAssociations(&backend.AssociationFilter{User: in.UserId})
 	if err != nil {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "SQL_QUERY_FAILED",
@@ -68,40 +64,16 @@ func (s *ServerAccount) ListAccounts(ctx context.Context, in *pb.ListAccountsReq
 		caller.Logger.Errorf("ListAccounts failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	defer rows.Close()
-	for rows.Next() {
-		err := rows.Scan(&assocAcct)
-		if err != nil {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "SQL_QUERY_FAILED",
-			}
-			st := status.New(codes.Internal, err.Error())
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("ListAccounts failed: %v", st.Err())
-			return nil, st.Err()
+	for _, assoc := range assocs {
+		if arrays.ContainsString(acctList, assoc.Account) == -1 {
+			acctList = append(acctList, assoc.Account)
 		}
-		acctList = append(acctList, assocAcct)
-	}
-	err = rows.Err()
-	if err != nil {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "SQL_QUERY_FAILED",
-		}
-		st := status.New(codes.Internal, err.Error())
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("ListAccounts failed: %v", st.Err())
-		return nil, st.Err()
 	}
 	caller.Logger.Tracef("ListAccounts Response: %v", &pb.ListAccountsResponse{Accounts: acctList})
 	return &pb.ListAccountsResponse{Accounts: acctList}, nil
 }
 
 func (s *ServerAccount) CreateAccount(ctx context.Context, in *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
-	var (
-		acctName string
-		qosName  string
-		qosList  []string
-	)
 	caller.Logger.Infof("Received request CreateAccount: %v", in)
 	// 检查账户名、用户名是否包含大写字母
 	resultAcct := utils.CheckAccountOrUserStrings(in.AccountName)
@@ -118,110 +90,99 @@ func (s *ServerAccount) CreateAccount(ctx context.Context, in *pb.CreateAccountR
 	// 获取系统中默认的Qos信息
 	defaultQos := caller.ConfigValue.Slurm.DefaultQOS
 	// 检查账户是否在slurm中
-	acctSqlConfig := "SELECT name FROM acct_table WHERE name = ? AND deleted = 0"
-	err := caller.DB.QueryRow(acctSqlConfig, in.AccountName).Scan(&acctName)
+	exists, err := caller.GetBackend(ctx).AccountExists(in.AccountName)
+	if err == nil && exists {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "ACCOUNT_ALREADY_EXISTS",
+		}
+		message := fmt.Sprintf("The %s is already exists.", in.AccountName)
+		st := status.New(codes.AlreadyExists, message)
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("CreateAccount failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	partitions, err := caller.GetBackend(ctx).ListPartitions() // 获取系统中计算分区信息
+	if err != nil || len(partitions) == 0 {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "COMMAND_EXEC_FAILED",
+		}
+		st := status.New(codes.Internal, "Exec command failed or don't set partitions.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("CreateAccount failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	// 获取系统中Qos
+	qosList, err := caller.GetBackend(ctx).ListQos()
 	if err != nil {
-		partitions, err := utils.GetPartitionInfo() // 获取系统中计算分区信息
-		if err != nil || len(partitions) == 0 {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "SQL_QUERY_FAILED",
+		}
+		st := status.New(codes.Internal, err.Error())
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("CreateAccount failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	if err := caller.GetBackend(ctx).CreateAccount(in.AccountName); err != nil {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "COMMAND_EXEC_FAILED",
+		}
+		st := status.New(codes.Internal, "Exec command failed.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("CreateAccount failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	for _, p := range partitions {
+		if err := caller.GetBackend(ctx).AddUserToAccount(in.OwnerUserId, in.AccountName, p.Name); err != nil {
 			errInfo := &errdetails.ErrorInfo{
 				Reason: "COMMAND_EXEC_FAILED",
 			}
-			st := status.New(codes.Internal, "Exec command failed or don't set partitions.")
+			st := status.New(codes.Internal, "Exec command failed.")
 			st, _ = st.WithDetails(errInfo)
 			caller.Logger.Errorf("CreateAccount failed: %v", st.Err())
 			return nil, st.Err()
 		}
-		// 获取系统中Qos
-		qosSqlConfig := "SELECT name FROM qos_table WHERE deleted = 0"
-		rows, err := caller.DB.Query(qosSqlConfig)
-		if err != nil {
+		if err := caller.GetBackend(ctx).SetUserQos(in.OwnerUserId, qosList, defaultQos); err != nil {
 			errInfo := &errdetails.ErrorInfo{
-				Reason: "SQL_QUERY_FAILED",
+				Reason: "COMMAND_EXEC_FAILED",
 			}
-			st := status.New(codes.Internal, err.Error())
+			st := status.New(codes.Internal, "Exec command failed.")
 			st, _ = st.WithDetails(errInfo)
 			caller.Logger.Errorf("CreateAccount failed: %v", st.Err())
 			return nil, st.Err()
 		}
-		defer rows.Close()
-		for rows.Next() {
-			err := rows.Scan(&qosName)
-			if err != nil {
+	}
+	caller.Logger.Infof("CreateAccount sucess! account is: %v, owerUserId is: %v", in.AccountName, in.OwnerUserId)
+	return &pb.CreateAccountResponse{}, nil
+}
+
+// 更新所有计算分区的AllowAccounts, 后端不支持修改分区时返回Unimplemented
+func updateAllowAccounts(ctx context.Context, rpc string, partitions []*backend.Partition, allowAcct string) error {
+	for _, p := range partitions {
+		if err := caller.GetBackend(ctx).UpdatePartitionAllowAccounts(p.Name, allowAcct); err != nil {
+			if errors.Is(err, backend.ErrNotSupported) {
 				errInfo := &errdetails.ErrorInfo{
-					Reason: "SQL_QUERY_FAILED",
+					Reason: "NOT_SUPPORTED",
 				}
-				st := status.New(codes.Internal, err.Error())
+				st := status.New(codes.Unimplemented, err.Error())
 				st, _ = st.WithDetails(errInfo)
-				caller.Logger.Errorf("CreateAccount failed: %v", st.Err())
-				return nil, st.Err()
+				caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
+				return st.Err()
 			}
-			qosList = append(qosList, qosName)
-		}
-
-		err = rows.Err()
-		if err != nil {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "SQL_QUERY_FAILED",
-			}
-			st := status.New(codes.Internal, err.Error())
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("CreateAccount failed: %v", st.Err())
-			return nil, st.Err()
-		}
-		baseQos := strings.Join(qosList, ",")
-		createAccountCmd := fmt.Sprintf("sacctmgr -i create account name=%s", in.AccountName)
-		retcode := utils.ExecuteShellCommand(createAccountCmd)
-		if retcode != 0 {
 			errInfo := &errdetails.ErrorInfo{
 				Reason: "COMMAND_EXEC_FAILED",
 			}
 			st := status.New(codes.Internal, "Exec command failed.")
 			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("CreateAccount failed: %v", st.Err())
-			return nil, st.Err()
+			caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
+			return st.Err()
 		}
-		for _, p := range partitions {
-			createUserCmd := fmt.Sprintf("sacctmgr -i create user name=%s partition=%s account=%s", in.OwnerUserId, p, in.AccountName)
-			modifyUserCmd := fmt.Sprintf("sacctmgr -i modify user %s set qos=%s DefaultQOS=%s", in.OwnerUserId, baseQos, defaultQos)
-			retcode01 := utils.ExecuteShellCommand(createUserCmd)
-			if retcode01 != 0 {
-				errInfo := &errdetails.ErrorInfo{
-					Reason: "COMMAND_EXEC_FAILED",
-				}
-				st := status.New(codes.Internal, "Exec command failed.")
-				st, _ = st.WithDetails(errInfo)
-				caller.Logger.Errorf("CreateAccount failed: %v", st.Err())
-				return nil, st.Err()
-			}
-			retcode02 := utils.ExecuteShellCommand(modifyUserCmd)
-			if retcode02 != 0 {
-				errInfo := &errdetails.ErrorInfo{
-					Reason: "COMMAND_EXEC_FAILED",
-				}
-				st := status.New(codes.Internal, "Exec command failed.")
-				st, _ = st.WithDetails(errInfo)
-				caller.Logger.Errorf("CreateAccount failed: %v", st.Err())
-				return nil, st.Err()
-			}
-		}
-		caller.Logger.Infof("CreateAccount sucess! account is: %v, owerUserId is: %v", in.AccountName, in.OwnerUserId)
-		return &pb.CreateAccountResponse{}, nil
-	}
-	errInfo := &errdetails.ErrorInfo{
-		Reason: "ACCOUNT_ALREADY_EXISTS",
 	}
-	message := fmt.Sprintf("The %s is already exists.", in.AccountName)
-	st := status.New(codes.AlreadyExists, message)
-	st, _ = st.WithDetails(errInfo)
-	caller.Logger.Errorf("CreateAccount failed: %v", st.Err())
-	return nil, st.Err()
+	return nil
 }
 
 func (s *ServerAccount) BlockAccount(ctx context.Context, in *pb.BlockAccountRequest) (*pb.BlockAccountResponse, error) {
 	var (
-		acctName      string
-		assocAcctName string
-		acctList      []string
+		acctList []string
 	)
 	// 记录日志
 	caller.Logger.Infof("Received request BlockAccount: %v", in)
@@ -239,11 +200,9 @@ func (s *ServerAccount) BlockAccount(ctx context.Context, in *pb.BlockAccountReq
 		return nil, st.Err()
 	}
 
-	clusterName := caller.ConfigValue.MySQLConfig.ClusterName
 	// 检查账户是否在slurm中
-	acctSqlConfig := "SELECT name FROM acct_table WHERE name = ? AND deleted = 0"
-	err := caller.DB.QueryRow(acctSqlConfig, in.AccountName).Scan(&acctName)
-	if err != nil {
+	exists, err := caller.GetBackend(ctx).AccountExists(in.AccountName)
+	if err != nil || !exists {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "ACCOUNT_NOT_FOUND",
 		}
@@ -254,7 +213,7 @@ func (s *ServerAccount) BlockAccount(ctx context.Context, in *pb.BlockAccountReq
 		return nil, st.Err()
 	}
 	// 获取系统中计算分区信息
-	partitions, err := utils.GetPartitionInfo()
+	partitions, err := caller.GetBackend(ctx).ListPartitions()
 	if err != nil || len(partitions) == 0 {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "COMMAND_EXEC_FAILED",
@@ -264,23 +223,10 @@ func (s *ServerAccount) BlockAccount(ctx context.Context, in *pb.BlockAccountReq
 		caller.Logger.Errorf("BlockAccount failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	// 获取计算分区AllowAccounts的值
-	getAllowAcctCmd := fmt.Sprintf("scontrol show partition %s | grep AllowAccounts | awk '{print $2}' | awk -F '=' '{print $2}'", partitions[0])
-	// getAllowAcctCmd := fmt.Sprintf("scontrol show partition %s | grep AllowAccounts | awk '{print $2}' | awk -F '=' '{print $2}'", "compute")
-
-	output, err := utils.RunCommand(getAllowAcctCmd)
-	if err != nil || utils.CheckSlurmStatus(output) {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "COMMAND_EXEC_FAILED",
-		}
-		st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("BlockAccount failed: %v", st.Err())
-		return nil, st.Err()
-	}
+	// 计算分区AllowAccounts的值
+	output := partitions[0].AllowAccounts
 	if output == "ALL" {
-		acctSqlConfig := fmt.Sprintf("SELECT DISTINCT acct FROM %s_assoc_table WHERE deleted = 0 AND acct != ?", clusterName)
-		rows, err := caller.DB.Query(acctSqlConfig, in.AccountName)
+		assocs, err := caller.GetBackend(ctx).ListAssociations(nil)
 		if err != nil {
 			errInfo := &errdetails.ErrorInfo{
 				Reason: "SQL_QUERY_FAILED",
@@ -290,43 +236,13 @@ func (s *ServerAccount) BlockAccount(ctx context.Context, in *pb.BlockAccountReq
 			caller.Logger.Errorf("BlockAccount failed: %v", st.Err())
 			return nil, st.Err()
 		}
-		defer rows.Close()
-		for rows.Next() {
-			err := rows.Scan(&assocAcctName)
-			if err != nil {
-				errInfo := &errdetails.ErrorInfo{
-					Reason: "SQL_QUERY_FAILED",
-				}
-				st := status.New(codes.Internal, err.Error())
-				st, _ = st.WithDetails(errInfo)
-				caller.Logger.Errorf("BlockAccount failed: %v", st.Err())
-				return nil, st.Err()
+		for _, assoc := range assocs {
+			if assoc.Account != in.AccountName && arrays.ContainsString(acctList, assoc.Account) == -1 {
+				acctList = append(acctList, assoc.Account)
 			}
-			acctList = append(acctList, assocAcctName)
 		}
-		err = rows.Err()
-		if err != nil {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "SQL_QUERY_FAILED",
-			}
-			st := status.New(codes.Internal, err.Error())
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("BlockAccount failed: %v", st.Err())
-			return nil, st.Err()
-		}
-		allowAcct := strings.Join(acctList, ",")
-		for _, v := range partitions {
-			updatePartitionAllowAcctCmd := fmt.Sprintf("scontrol update partition='%s' AllowAccounts='%s'", v, allowAcct)
-			retcode := utils.ExecuteShellCommand(updatePartitionAllowAcctCmd)
-			if retcode != 0 {
-				errInfo := &errdetails.ErrorInfo{
-					Reason: "COMMAND_EXEC_FAILED",
-				}
-				st := status.New(codes.Internal, "Exec command failed.")
-				st, _ = st.WithDetails(errInfo)
-				caller.Logger.Errorf("BlockAccount failed: %v", st.Err())
-				return nil, st.Err()
-			}
+		if err := updateAllowAccounts(ctx, "BlockAccount", partitions, strings.Join(acctList, ",")); err != nil {
+			return nil, err
 		}
 		return &pb.BlockAccountResponse{}, nil
 	}
@@ -339,27 +255,14 @@ func (s *ServerAccount) BlockAccount(ctx context.Context, in *pb.BlockAccountReq
 	}
 	// 账户存在AllowAcctList中，则删除账户后更新计算分区AllowAccounts
 	updateAllowAcct := utils.DeleteSlice(AllowAcctList, in.AccountName)
-	for _, p := range partitions {
-		updatePartitionAllowAcctCmd := fmt.Sprintf("scontrol update partition=%s AllowAccounts=%s", p, strings.Join(updateAllowAcct, ","))
-		code := utils.ExecuteShellCommand(updatePartitionAllowAcctCmd)
-		if code != 0 {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "COMMAND_EXEC_FAILED",
-			}
-			st := status.New(codes.Internal, "Exec command failed.")
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("BlockAccount failed: %v", st.Err())
-			return nil, st.Err()
-		}
+	if err := updateAllowAccounts(ctx, "BlockAccount", partitions, strings.Join(updateAllowAcct, ",")); err != nil {
+		return nil, err
 	}
 	caller.Logger.Infof("BlockAccount sucess! account is: %v", in.AccountName)
 	return &pb.BlockAccountResponse{}, nil
 }
 
 func (s *ServerAccount) UnblockAccount(ctx context.Context, in *pb.UnblockAccountRequest) (*pb.UnblockAccountResponse, error) {
-	var (
-		acctName string
-	)
 	// 记录日志
 	caller.Logger.Infof("Received request UnblockAccount: %v", in)
 	s.muUnBlock.Lock() // 加锁操作
@@ -376,9 +279,8 @@ func (s *ServerAccount) UnblockAccount(ctx context.Context, in *pb.UnblockAccoun
 		return nil, st.Err()
 	}
 	// 检查账户名是否在slurm中
-	acctSqlConfig := "SELECT name FROM acct_table WHERE name = ? AND deleted = 0"
-	err := caller.DB.QueryRow(acctSqlConfig, in.AccountName).Scan(&acctName)
-	if err != nil {
+	exists, err := caller.GetBackend(ctx).AccountExists(in.AccountName)
+	if err != nil || !exists {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "ACCOUNT_NOT_FOUND",
 		}
@@ -389,7 +291,7 @@ func (s *ServerAccount) UnblockAccount(ctx context.Context, in *pb.UnblockAccoun
 		return nil, st.Err()
 	}
 	// 获取系统中计算分区信息
-	partitions, err := utils.GetPartitionInfo()
+	partitions, err := caller.GetBackend(ctx).ListPartitions()
 	if err != nil || len(partitions) == 0 {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "COMMAND_EXEC_FAILED",
@@ -399,17 +301,7 @@ func (s *ServerAccount) UnblockAccount(ctx context.Context, in *pb.UnblockAccoun
 		caller.Logger.Errorf("UnblockAccount failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	getAllowAcctCmd := fmt.Sprintf("scontrol show partition %s | grep AllowAccounts | awk '{print $2}' | awk -F '=' '{print $2}'", partitions[0])
-	output, err := utils.RunCommand(getAllowAcctCmd)
-	if err != nil || utils.CheckSlurmStatus(output) {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "COMMAND_EXEC_FAILED",
-		}
-		st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("UnblockAccount failed: %v", st.Err())
-		return nil, st.Err()
-	}
+	output := partitions[0].AllowAccounts
 	if output == "ALL" {
 		caller.Logger.Infof("Accout %v is Unblocked!", in.AccountName)
 		return &pb.UnblockAccountResponse{}, nil
@@ -419,18 +311,8 @@ func (s *ServerAccount) UnblockAccount(ctx context.Context, in *pb.UnblockAccoun
 	if index == -1 {
 		// 不在里面的话需要解封
 		AllowAcctList = append(AllowAcctList, in.AccountName)
-		for _, p := range partitions {
-			updatePartitionAllowAcctCmd := fmt.Sprintf("scontrol update partition=%s AllowAccounts=%s", p, strings.Join(AllowAcctList, ","))
-			retcode := utils.ExecuteShellCommand(updatePartitionAllowAcctCmd)
-			if retcode != 0 {
-				errInfo := &errdetails.ErrorInfo{
-					Reason: "COMMAND_EXEC_FAILED",
-				}
-				st := status.New(codes.Internal, "Exec command failed.")
-				st, _ = st.WithDetails(errInfo)
-				caller.Logger.Errorf("UnblockAccount failed: %v", st.Err())
-				return nil, st.Err()
-			}
+		if err := updateAllowAccounts(ctx, "UnblockAccount", partitions, strings.Join(AllowAcctList, ",")); err != nil {
+			return nil, err
 		}
 		caller.Logger.Infof("Accout %v Unblocked sucess!", in.AccountName)
 		return &pb.UnblockAccountResponse{}, nil
@@ -440,44 +322,13 @@ func (s *ServerAccount) UnblockAccount(ctx context.Context, in *pb.UnblockAccoun
 
 func (s *ServerAccount) GetAllAccountsWithUsers(ctx context.Context, in *pb.GetAllAccountsWithUsersRequest) (*pb.GetAllAccountsWithUsersResponse, error) {
 	var (
-		acctName      string
-		userName      string
-		maxSubmitJobs int
-		acctList      []string
-		acctInfo      []*pb.ClusterAccountInfo
+		acctInfo []*pb.ClusterAccountInfo
 	)
 	// 记录日志
 	caller.Logger.Infof("Received request GetAllAccountsWithUsers: %v", in)
-	// 获取集群名
-	clusterName := caller.ConfigValue.MySQLConfig.ClusterName
 
 	// 获取系统中所有账户信息
-	acctSqlConfig := fmt.Sprintf("SELECT name FROM acct_table WHERE deleted = 0")
-	rows, err := caller.DB.Query(acctSqlConfig)
-	if err != nil {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "SQL_QUERY_FAILED",
-		}
-		st := status.New(codes.Internal, err.Error())
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("GetAllAccountsWithUsers failed: %v", st.Err())
-		return nil, st.Err()
-	}
-	defer rows.Close()
-	for rows.Next() {
-		err := rows.Scan(&acctName)
-		if err != nil {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "SQL_QUERY_FAILED",
-			}
-			st := status.New(codes.Internal, err.Error())
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("GetAllAccountsWithUsers failed: %v", st.Err())
-			return nil, st.Err()
-		}
-		acctList = append(acctList, acctName)
-	}
-	err = rows.Err()
+	acctList, err := caller.GetBackend(ctx).ListAccounts()
 	if err != nil {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "SQL_QUERY_FAILED",
@@ -489,7 +340,7 @@ func (s *ServerAccount) GetAllAccountsWithUsers(ctx context.Context, in *pb.GetA
 	}
 
 	// 查询allowAcct的值(ALL和具体的acct列表)
-	partitions, err := utils.GetPartitionInfo()
+	partitions, err := caller.GetBackend(ctx).ListPartitions()
 	if err != nil || len(partitions) == 0 {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "COMMAND_EXEC_FAILED",
@@ -499,22 +350,14 @@ func (s *ServerAccount) GetAllAccountsWithUsers(ctx context.Context, in *pb.GetA
 		caller.Logger.Errorf("GetAllAccountsWithUsers failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	getAllowAcctCmd := fmt.Sprintf("scontrol show partition %s | grep AllowAccounts | awk '{print $2}' | awk -F '=' '{print $2}'", partitions[0])
-	output, err := utils.RunCommand(getAllowAcctCmd)
-	if err != nil || utils.CheckSlurmStatus(output) {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "COMMAND_EXEC_FAILED",
-		}
-		st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("GetAllAccountsWithUsers failed: %v", st.Err())
-		return nil, st.Err()
-	}
+	output := partitions[0].AllowAccounts
 	// 获取和每个账户关联的用户的信息
 	for _, v := range acctList {
-		var userInfo []*pb.ClusterAccountInfo_UserInAccount
-		assocSqlConfig := fmt.Sprintf("SELECT DISTINCT user, max_submit_jobs FROM %s_assoc_table WHERE deleted = 0 AND acct = ? AND user != ''", clusterName)
-		rows, err := caller.DB.Query(assocSqlConfig, v)
+		var (
+			userInfo  []*pb.ClusterAccountInfo_UserInAccount
+			userNames []string
+		)
+		assocs, err := caller.GetBackend(ctx).ListAssociations(&backend.AssociationFilter{Account: v})
 		if err != nil {
 			errInfo := &errdetails.ErrorInfo{
 				Reason: "SQL_QUERY_FAILED",
@@ -524,34 +367,26 @@ func (s *ServerAccount) GetAllAccountsWithUsers(ctx context.Context, in *pb.GetA
 			caller.Logger.Errorf("GetAllAccountsWithUsers failed: %v", st.Err())
 			return nil, st.Err()
 		}
-		defer rows.Close()
-		for rows.Next() {
-			err := rows.Scan(&userName, &maxSubmitJobs)
-			if err != nil {
+		for _, assoc := range assocs {
+			// 同一个用户在不同分区中有多条关联关系
+			if assoc.User == "" || arrays.ContainsString(userNames, assoc.User) != -1 {
+				continue
+			}
+			if assoc.MaxSubmitJobs == nil {
+				userNames = append(userNames, assoc.User)
 				userInfo = append(userInfo, &pb.ClusterAccountInfo_UserInAccount{
-					UserId:   userName,
-					UserName: userName,
+					UserId:   assoc.User,
+					UserName: assoc.User,
 					Blocked:  false,
 				})
-			} else {
-				if maxSubmitJobs == 0 {
-					userInfo = append(userInfo, &pb.ClusterAccountInfo_UserInAccount{
-						UserId:   userName,
-						UserName: userName,
-						Blocked:  true,
-					})
-				}
-			}
-		}
-		err = rows.Err()
-		if err != nil {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "SQL_QUERY_FAILED",
+			} else if *assoc.MaxSubmitJobs == 0 {
+				userNames = append(userNames, assoc.User)
+				userInfo = append(userInfo, &pb.ClusterAccountInfo_UserInAccount{
+					UserId:   assoc.User,
+					UserName: assoc.User,
+					Blocked:  true,
+				})
 			}
-			st := status.New(codes.Internal, err.Error())
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("GetAllAccountsWithUsers failed: %v", st.Err())
-			return nil, st.Err()
 		}
 		if output == "ALL" {
 			acctInfo = append(acctInfo, &pb.ClusterAccountInfo{
@@ -582,9 +417,6 @@ func (s *ServerAccount) GetAllAccountsWithUsers(ctx context.Context, in *pb.GetA
 }
 
 func (s *ServerAccount) QueryAccountBlockStatus(ctx context.Context, in *pb.QueryAccountBlockStatusRequest) (*pb.QueryAccountBlockStatusResponse, error) {
-	var (
-		acctName string
-	)
 	// 记录日志
 	caller.Logger.Infof("Received request QueryAccountBlockStatus: %v", in)
 	// 检查用户名中是否包含大写字母
@@ -599,9 +431,8 @@ func (s *ServerAccount) QueryAccountBlockStatus(ctx context.Context, in *pb.Quer
 		return nil, st.Err()
 	}
 	// 检查账户名是否在slurm中
-	acctSqlConfig := "SELECT name FROM acct_table WHERE name = ? AND deleted = 0"
-	err := caller.DB.QueryRow(acctSqlConfig, in.AccountName).Scan(&acctName)
-	if err != nil {
+	exists, err := caller.GetBackend(ctx).AccountExists(in.AccountName)
+	if err != nil || !exists {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "ACCOUNT_NOT_FOUND",
 		}
@@ -612,7 +443,7 @@ func (s *ServerAccount) QueryAccountBlockStatus(ctx context.Context, in *pb.Quer
 		return nil, st.Err()
 	}
 	// 获取系统中计算分区信息
-	partitions, err := utils.GetPartitionInfo()
+	partitions, err := caller.GetBackend(ctx).ListPartitions()
 	if err != nil || len(partitions) == 0 {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "COMMAND_EXEC_FAILED",
@@ -622,18 +453,8 @@ func (s *ServerAccount) QueryAccountBlockStatus(ctx context.Context, in *pb.Quer
 		caller.Logger.Errorf("QueryAccountBlockStatus failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	// 获取系统中分区AllowAccounts信息
-	getAllowAcctCmd := fmt.Sprintf("scontrol show partition %s | grep AllowAccounts | awk '{print $2}' | awk -F '=' '{print $2}'", partitions[0])
-	output, err := utils.RunCommand(getAllowAcctCmd)
-	if err != nil || utils.CheckSlurmStatus(output) {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "COMMAND_EXEC_FAILED",
-		}
-		st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("QueryAccountBlockStatus failed: %v", st.Err())
-		return nil, st.Err()
-	}
+	// 系统中分区AllowAccounts信息
+	output := partitions[0].AllowAccounts
 	if output == "ALL" {
 		return &pb.QueryAccountBlockStatusResponse{Blocked: false}, nil
 	}
@@ -649,13 +470,9 @@ func (s *ServerAccount) QueryAccountBlockStatus(ctx context.Context, in *pb.Quer
 
 // 删除账户
 func (s *ServerAccount) DeleteAccount(ctx context.Context, in *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
-	var (
-		acctName string
-	)
 	// 检查账户名是否在slurm中
-	acctSqlConfig := "SELECT name FROM acct_table WHERE name = ? AND deleted = 0"
-	err := caller.DB.QueryRow(acctSqlConfig, in.AccountName).Scan(&acctName)
-	if err != nil {
+	exists, err := caller.GetBackend(ctx).AccountExists(in.AccountName)
+	if err != nil || !exists {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "ACCOUNT_NOT_FOUND",
 		}
@@ -666,8 +483,7 @@ func (s *ServerAccount) DeleteAccount(ctx context.Context, in *pb.DeleteAccountR
 		return nil, st.Err()
 	}
 	// 作业的判断
-	accountRunningJobInfoCmd := fmt.Sprintf("squeue --noheader -A %s", in.AccountName)
-	runningJobInfo, err := utils.RunCommand(accountRunningJobInfoCmd)
+	runningJobs, err := caller.GetBackend(ctx).ListQueueJobs(&backend.QueueFilter{Accounts: []string{in.AccountName}})
 	if err != nil {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "CMD_EXECUTE_FAILED",
@@ -677,11 +493,10 @@ func (s *ServerAccount) DeleteAccount(ctx context.Context, in *pb.DeleteAccountR
 		caller.Logger.Errorf("DeleteAccount failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	if len(runningJobInfo) == 0 {
+	if len(runningJobs) == 0 {
 		// 可以删
 		// 具体的删除操作
-		deleteAccountCmd := fmt.Sprintf("sacctmgr -i delete account name=%s", in.AccountName)
-		_, err = utils.RunCommand(deleteAccountCmd)
+		err = caller.GetBackend(ctx).DeleteAccount(in.AccountName)
 		if err != nil {
 			// 删除失败
 			errInfo := &errdetails.ErrorInfo{
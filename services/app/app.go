@@ -2,14 +2,131 @@ package app
 
 import (
 	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"scow-slurm-adapter/backend"
+	"scow-slurm-adapter/caller"
 	pb "scow-slurm-adapter/gen/go"
+	"scow-slurm-adapter/utils"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ServerAppServer struct {
 	pb.UnimplementedAppServiceServer
 }
 
+// 连接信息文件的最大字节数
+const connectionInfoMaxBytes = 64 * 1024
+
+// 连接信息文件的路径, 相对路径基于作业的工作目录
+func connectionInfoPath(workDir string) string {
+	fileName := caller.ConfigValue.App.ConnectionInfoFile
+	if fileName == "" {
+		fileName = utils.DefaultConnectionInfoFile
+	}
+	if filepath.IsAbs(fileName) {
+		return fileName
+	}
+	return filepath.Join(workDir, fileName)
+}
+
 // appservice
 func (s *ServerAppServer) GetAppConnectionInfo(ctx context.Context, in *pb.GetAppConnectionInfoRequest) (*pb.GetAppConnectionInfoResponse, error) {
-	return &pb.GetAppConnectionInfoResponse{}, nil
+	var job *backend.QueueJob
+	caller.Logger.Infof("Received request GetAppConnectionInfo: %v", in)
+	// 分配的节点从slurmctld中获取
+	queueJobs, err := caller.GetBackend(ctx).ListQueueJobs(&backend.QueueFilter{JobIds: []uint32{in.JobId}})
+	if err != nil {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "COMMAND_EXEC_FAILED",
+		}
+		st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("GetAppConnectionInfo failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	for _, queueJob := range queueJobs {
+		if queueJob.JobId == in.JobId {
+			job = queueJob
+			break
+		}
+	}
+	if job == nil {
+		// 已经离开slurmctld的作业在记账数据库中还能查到
+		if _, err := caller.GetBackend(ctx).GetJob(in.JobId); err == nil {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "JOB_NOT_RUNNING",
+			}
+			st := status.New(codes.FailedPrecondition, "The job has ended.")
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("GetAppConnectionInfo failed: %v", st.Err())
+			return nil, st.Err()
+		}
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "JOB_NOT_FOUND",
+		}
+		st := status.New(codes.NotFound, "The job does not exist.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("GetAppConnectionInfo failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	if job.State != "RUNNING" {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "JOB_NOT_RUNNING",
+		}
+		st := status.New(codes.FailedPrecondition, "The job is "+job.State+".")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("GetAppConnectionInfo failed: %v", st.Err())
+		return nil, st.Err()
+	}
+
+	// 以作业用户的身份读取应用启动脚本写入的连接信息
+	path := connectionInfoPath(job.WorkingDirectory)
+	data, err := utils.ReadFileAsUser(job.User, path, 0, connectionInfoMaxBytes)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "CONNECTION_INFO_NOT_FOUND",
+			}
+			st := status.New(codes.NotFound, "The connection info file "+path+" does not exist.")
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("GetAppConnectionInfo failed: %v", st.Err())
+			return nil, st.Err()
+		}
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "READ_CONNECTION_INFO_FAILED",
+		}
+		st := status.New(codes.Internal, err.Error())
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("GetAppConnectionInfo failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	info, port, err := utils.ParseAppConnectionInfo(data)
+	if err != nil {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "CONNECTION_INFO_NOT_READY",
+		}
+		st := status.New(codes.Unavailable, "The app is starting, connection info is not ready yet.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("GetAppConnectionInfo failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	// 文件中没有主机时使用作业分配的第一个节点
+	host := info.Host
+	if host == "" {
+		host = utils.FirstHost(job.NodeList)
+	}
+	connectionInfo := &pb.GetAppConnectionInfoResponse_AppConnectionInfo{
+		Host:     host,
+		Port:     port,
+		Password: info.Password,
+	}
+	caller.Logger.Tracef("GetAppConnectionInfo host: %s, port: %d", host, port)
+	return &pb.GetAppConnectionInfoResponse{
+		Response: &pb.GetAppConnectionInfoResponse_AppConnectionInfo_{AppConnectionInfo: connectionInfo},
+	}, nil
 }
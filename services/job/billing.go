@@ -0,0 +1,109 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"scow-slurm-adapter/backend"
+	"scow-slurm-adapter/billing"
+	"scow-slurm-adapter/caller"
+	pb "scow-slurm-adapter/gen/go"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func billingNotConfiguredError(rpc string) error {
+	errInfo := &errdetails.ErrorInfo{
+		Reason: "BILLING_NOT_CONFIGURED",
+	}
+	st := status.New(codes.FailedPrecondition, "Billing is not configured.")
+	st, _ = st.WithDetails(errInfo)
+	caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
+	return st.Err()
+}
+
+// 作业的费用, 异构作业按各组件的分区和QOS分别计算后相加
+func jobCost(engine *billing.Engine, job *backend.Job, now int64) *pb.JobCost {
+	components := job.HetComponents
+	if len(components) == 0 {
+		components = []*backend.Job{job}
+	}
+	var cost billing.Cost
+	for _, component := range components {
+		cost = cost.Add(engine.Cost(&billing.Usage{
+			Partition: component.Partition,
+			Qos:       component.Qos,
+			Cpus:      component.CpusAlloc,
+			Gpus:      component.GpusAlloc,
+			MemMb:     component.MemAllocMb,
+			StartTime: component.StartTime,
+			EndTime:   component.EndTime,
+		}, now))
+	}
+	return &pb.JobCost{
+		JobId:     job.JobId,
+		User:      job.User,
+		Account:   job.Account,
+		Partition: job.Partition,
+		Qos:       job.Qos,
+		CpuCost:   cost.CpuCost,
+		GpuCost:   cost.GpuCost,
+		MemCost:   cost.MemCost,
+		TotalCost: cost.Total(),
+		Priced:    cost.Priced,
+	}
+}
+
+func (s *ServerJob) GetJobCost(ctx context.Context, in *pb.GetJobCostRequest) (*pb.GetJobCostResponse, error) {
+	caller.Logger.Infof("Received request GetJobCost: %v", in)
+	if caller.Billing == nil {
+		return nil, billingNotConfiguredError("GetJobCost")
+	}
+	job, err := caller.GetBackend(ctx).GetJob(in.JobId)
+	if err != nil {
+		if errors.Is(err, backend.ErrNotFound) {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "JOB_NOT_FOUND",
+			}
+			st := status.New(codes.NotFound, "The job does not exist.")
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("GetJobCost failed: %v", st.Err())
+			return nil, st.Err()
+		}
+		return nil, queryJobsFailedError("GetJobCost", err)
+	}
+	cost := jobCost(caller.Billing, job, time.Now().Unix())
+	caller.Logger.Tracef("GetJobCost GetJobCostResponse is: %v", cost)
+	return &pb.GetJobCostResponse{Cost: cost}, nil
+}
+
+func (s *ServerJob) GetJobsCost(ctx context.Context, in *pb.GetJobsCostRequest) (*pb.GetJobsCostResponse, error) {
+	caller.Logger.Infof("Received request GetJobsCost: %v", in)
+	if caller.Billing == nil {
+		return nil, billingNotConfiguredError("GetJobsCost")
+	}
+	// 费用只根据记账数据库中的作业计算
+	query := jobQuery(in.Filter, nil)
+	if pageSize := in.PageInfo.GetPageSize(); pageSize != 0 {
+		query.Limit = pageSize
+		if in.PageInfo.Page > 1 {
+			query.Offset = uint64(in.PageInfo.Page-1) * pageSize
+		}
+	}
+	jobs, count, err := caller.GetBackend(ctx).QueryJobs(query)
+	if err != nil {
+		return nil, queryJobsFailedError("GetJobsCost", err)
+	}
+	now := time.Now().Unix()
+	resp := &pb.GetJobsCostResponse{TotalCount: count}
+	for _, job := range jobs {
+		cost := jobCost(caller.Billing, job, now)
+		resp.Costs = append(resp.Costs, cost)
+		resp.TotalCost += cost.TotalCost
+	}
+	caller.Logger.Tracef("GetJobsCost GetJobsCostResponse is: %v", resp)
+	return resp, nil
+}
@@ -2,21 +2,23 @@ package job
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math"
+	"os"
 	"path/filepath"
-	"regexp"
+	"scow-slurm-adapter/backend"
 	"scow-slurm-adapter/caller"
 	pb "scow-slurm-adapter/gen/go"
 	"scow-slurm-adapter/utils"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/sirupsen/logrus"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -24,11 +26,54 @@ type ServerJob struct {
 	pb.UnimplementedJobServiceServer
 }
 
+// 读取作业输出文件的参数
+const (
+	tailChunkSize       = 64 * 1024          // 每次发送的最大字节数
+	tailDefaultMaxBytes = 16 * 1024 * 1024   // 请求中没有指定max_bytes时最多发送的字节数
+	tailMaxBytes        = 1024 * 1024 * 1024 // 一次请求最多发送的字节数, 更大的max_bytes按这个值处理
+	tailPollInterval    = 2 * time.Second    // 没有新输出时检查作业状态的间隔
+)
+
+// 作业的终止状态
+var terminalStates = backend.TerminalStates
+
+// 检查作业是否还在slurmctld中
+func jobInQueue(ctx context.Context, jobId uint32) bool {
+	jobs, err := caller.GetBackend(ctx).ListQueueJobs(&backend.QueueFilter{JobIds: []uint32{jobId}})
+	return err == nil && len(jobs) != 0
+}
+
+// 检查作业数组中的任务是否还在slurmctld中, 还未拆分的排队任务按下标范围判断
+func arrayTaskInQueue(ctx context.Context, arrayJobId uint32, arrayTaskId uint32) bool {
+	jobs, err := caller.GetBackend(ctx).ListQueueJobs(&backend.QueueFilter{ArrayJobId: &arrayJobId})
+	if err != nil {
+		return false
+	}
+	for _, job := range jobs {
+		if utils.ArraySpecContains(job.ArrayTaskId, arrayTaskId) {
+			return true
+		}
+	}
+	return false
+}
+
+// 作业数组的字段, 不是作业数组时返回nil
+func arrayFields(arrayJobId uint32, arrayTaskId string) (*uint32, *string) {
+	if arrayJobId == 0 {
+		return nil, nil
+	}
+	return &arrayJobId, &arrayTaskId
+}
+
+// 异构作业的字段, 不是异构作业时返回nil
+func hetFields(hetJobId uint32, hetJobOffset uint32) (*uint32, *uint32) {
+	if hetJobId == 0 {
+		return nil, nil
+	}
+	return &hetJobId, &hetJobOffset
+}
+
 func (s *ServerJob) CancelJob(ctx context.Context, in *pb.CancelJobRequest) (*pb.CancelJobResponse, error) {
-	var (
-		userName string
-		// idJob    int
-	)
 	caller.Logger.Infof("Received request CancelJob: %v", in)
 	// 检查用户名中是否包含大写字母
 	resultUser := utils.CheckAccountOrUserStrings(in.UserId)
@@ -42,9 +87,8 @@ func (s *ServerJob) CancelJob(ctx context.Context, in *pb.CancelJobRequest) (*pb
 		return nil, st.Err()
 	}
 	// 判断用户是否存在
-	userSqlConfig := "SELECT name FROM user_table WHERE name = ? AND deleted = 0"
-	err := caller.DB.QueryRow(userSqlConfig, in.UserId).Scan(&userName)
-	if err != nil {
+	exists, err := caller.GetBackend(ctx).UserExists(in.UserId)
+	if err != nil || !exists {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "USER_NOT_FOUND",
 		}
@@ -54,10 +98,14 @@ func (s *ServerJob) CancelJob(ctx context.Context, in *pb.CancelJobRequest) (*pb
 		caller.Logger.Errorf("CancelJob failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	// 从squeue来获取对应的作业信息
-	getJobInfoCmd := fmt.Sprintf("squeue --noheader -j %d", in.JobId) // 直接从slurm的运行时中获取作业的信息
-	_, err = utils.RunCommand(getJobInfoCmd)
-	if err != nil {
+	// 直接从slurm的运行时中获取作业的信息
+	var inQueue bool
+	if in.ArrayTaskId != nil {
+		inQueue = arrayTaskInQueue(ctx, uint32(in.JobId), *in.ArrayTaskId)
+	} else {
+		inQueue = jobInQueue(ctx, uint32(in.JobId))
+	}
+	if !inQueue {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "JOB_NOT_FOUND",
 		}
@@ -67,12 +115,11 @@ func (s *ServerJob) CancelJob(ctx context.Context, in *pb.CancelJobRequest) (*pb
 		return nil, st.Err()
 	}
 	// 取消作业
-	response, err := utils.LocalCancelJob(in.UserId, int(in.JobId))
-	if err != nil {
+	if err := caller.GetBackend(ctx).CancelJob(in.UserId, uint32(in.JobId), in.ArrayTaskId); err != nil {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "CANCEL_JOB_FAILED",
 		}
-		st := status.New(codes.Unknown, response)
+		st := status.New(codes.Unknown, err.Error())
 		st, _ = st.WithDetails(errInfo)
 		caller.Logger.Errorf("CancelJob failed: %v", st.Err())
 		return nil, st.Err()
@@ -80,1106 +127,953 @@ func (s *ServerJob) CancelJob(ctx context.Context, in *pb.CancelJobRequest) (*pb
 	return &pb.CancelJobResponse{}, nil
 }
 
-func (s *ServerJob) QueryJobTimeLimit(ctx context.Context, in *pb.QueryJobTimeLimitRequest) (*pb.QueryJobTimeLimitResponse, error) {
-	var (
-		timeLimit uint64
-	)
-	caller.Logger.Infof("Received request QueryJobTimeLimit: %v", in)
-	clusterName := caller.ConfigValue.MySQLConfig.ClusterName
-	// 通过jobId来查找作业信息
-	jobSqlConfig := fmt.Sprintf("SELECT timelimit FROM %s_job_table WHERE id_job = ? AND state IN (0, 1, 2)", clusterName)
-	err := caller.DB.QueryRow(jobSqlConfig, in.JobId).Scan(&timeLimit)
-	if err != nil {
+// 检查操作作业的用户, admin为true时以管理员身份操作, 否则必须指定存在的user_id.
+// proto3中没有设置的字段也是空字符串, 所以不能用空的user_id表示管理员
+func checkJobOperator(ctx context.Context, rpc string, userId string, admin bool) error {
+	if admin == (userId != "") {
 		errInfo := &errdetails.ErrorInfo{
-			Reason: "JOB_NOT_FOUND",
+			Reason: "INVALID_OPERATOR",
 		}
-		st := status.New(codes.NotFound, "The job does not exist.")
+		st := status.New(codes.InvalidArgument, "Either user_id or admin must be set.")
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("QueryJobTimeLimit failed: %v", st.Err())
-		return nil, st.Err()
+		caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
+		return st.Err()
 	}
-	return &pb.QueryJobTimeLimitResponse{TimeLimitMinutes: timeLimit}, nil
-}
-
-func (s *ServerJob) ChangeJobTimeLimit(ctx context.Context, in *pb.ChangeJobTimeLimitRequest) (*pb.ChangeJobTimeLimitResponse, error) {
-	// 记录日志
-	caller.Logger.Infof("Received request ChangeJobTimeLimit: %v", in)
-	// 从slurm的运行时取作业的信息
-	getJobInfoCmd := fmt.Sprintf("squeue --noheader -j %d", in.JobId) // 构造获取作业的命令行
-	_, err := utils.RunCommand(getJobInfoCmd)
-	if err != nil {
+	if admin {
+		return nil
+	}
+	// 检查用户名中是否包含大写字母
+	if !utils.CheckAccountOrUserStrings(userId) {
 		errInfo := &errdetails.ErrorInfo{
-			Reason: "JOB_NOT_FOUND",
+			Reason: "USER_CONTAIN_ILLEGAL_CHARACTERS",
 		}
-		st := status.New(codes.NotFound, "The job does not exist.")
+		st := status.New(codes.Internal, "The username contains illegal characters.")
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("ChangeJobTimeLimit failed: %v", st.Err())
-		return nil, st.Err()
+		caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
+		return st.Err()
 	}
-	if in.DeltaMinutes >= 0 {
-		updateTimeLimitCmd := fmt.Sprintf("scontrol update job=%d TimeLimit+=%d", in.JobId, in.DeltaMinutes)
-		result, err := utils.RunCommand(updateTimeLimitCmd)
-		if err != nil || utils.CheckSlurmStatus(result) {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "COMMAND_EXEC_FAILED",
-			}
-			st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("ChangeJobTimeLimit failed: %v", st.Err())
-			return nil, st.Err()
+	exists, err := caller.GetBackend(ctx).UserExists(userId)
+	if err != nil || !exists {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "USER_NOT_FOUND",
 		}
-	} else {
-		minitues := int64(math.Abs(float64(in.DeltaMinutes)))
-		updateTimeLimitCmd := fmt.Sprintf("scontrol update job=%d TimeLimit-=%d", in.JobId, minitues)
-		result, err := utils.RunCommand(updateTimeLimitCmd)
-		if err != nil || utils.CheckSlurmStatus(result) {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "COMMAND_EXEC_FAILED",
+		message := fmt.Sprintf("%s does not exists.", userId)
+		st := status.New(codes.NotFound, message)
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
+		return st.Err()
+	}
+	return nil
+}
+
+// 在slurmctld中查找作业或者作业数组中的任务, 指定作业数组id时返回数组中的第一个任务
+func findQueueJob(ctx context.Context, jobId uint32, arrayTaskId *uint32) *backend.QueueJob {
+	filter := &backend.QueueFilter{JobIds: []uint32{jobId}}
+	if arrayTaskId != nil {
+		filter = &backend.QueueFilter{ArrayJobId: &jobId}
+	}
+	jobs, err := caller.GetBackend(ctx).ListQueueJobs(filter)
+	if err != nil {
+		return nil
+	}
+	for _, job := range jobs {
+		if arrayTaskId != nil {
+			if utils.ArraySpecContains(job.ArrayTaskId, *arrayTaskId) {
+				return job
 			}
-			st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("ChangeJobTimeLimit failed: %v", st.Err())
-			return nil, st.Err()
+		} else if job.JobId == jobId || job.ArrayJobId == jobId {
+			return job
 		}
 	}
-	return &pb.ChangeJobTimeLimitResponse{}, nil
+	return nil
 }
 
-func (s *ServerJob) GetJobById(ctx context.Context, in *pb.GetJobByIdRequest) (*pb.GetJobByIdResponse, error) {
-	var (
-		jobId            int
-		jobName          string
-		account          string
-		partition        string
-		idQos            int
-		state            int
-		cpusReq          int32
-		memReq           int64
-		nodeReq          int32
-		timeLimitMinutes int64
-		submitTime       int64
-		startTime        int64
-		timeSuspended    int64
-		gresUsed         string
-		elapsedSeconds   int64
-		reason           string
-		nodeList         string
-		gpusAlloc        int32
-		cpusAlloc        int32
-		memAllocMb       int64
-		nodesAlloc       int32
-		endTime          int64
-		workingDirectory string
-		qosName          string
-		stateString      string
-		gpuId            int
-		tresAlloc        string
-		tresReq          string
-		idUser           int
-		cpuTresId        int
-		memTresId        int
-		nodeTresId       int
-		stdoutPath       string
-		stderrPath       string
-		gpuIdList        []int
-		fields           []string = in.Fields
-	)
-	caller.Logger.Infof("Received request GetJobById: %v", in)
-	clusterName := caller.ConfigValue.MySQLConfig.ClusterName
-	// 根据jobid查询作业详细信息
-	jobSqlConfig := fmt.Sprintf("SELECT account, id_user, cpus_req, job_name, id_job, id_qos, nodelist, nodes_alloc, `partition`, state, timelimit, time_submit, time_start, time_end, time_suspended, gres_used, work_dir, tres_alloc, tres_req FROM %s_job_table WHERE id_job = ?", clusterName)
-	err := caller.DB.QueryRow(jobSqlConfig, in.JobId).Scan(&account, &idUser, &cpusReq, &jobName, &jobId, &idQos, &nodeList, &nodesAlloc, &partition, &state, &timeLimitMinutes, &submitTime, &startTime, &endTime, &timeSuspended, &gresUsed, &workingDirectory, &tresAlloc, &tresReq)
-	if err != nil {
+// hold、release等作业控制操作的公共流程, admin为true时以管理员身份操作任意作业并记录原因
+func controlJob(ctx context.Context, rpc string, action backend.JobAction, userId string, admin bool, jobId uint32, arrayTaskId *uint32, reason *string) (*backend.QueueJob, error) {
+	if err := checkJobOperator(ctx, rpc, userId, admin); err != nil {
+		return nil, err
+	}
+	if !admin && reason != nil {
 		errInfo := &errdetails.ErrorInfo{
-			Reason: "JOB_NOT_FOUND",
+			Reason: "REASON_NOT_ALLOWED",
 		}
-		st := status.New(codes.NotFound, "The job does not exist.")
+		st := status.New(codes.InvalidArgument, "Only administrators can set the reason.")
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("Failed get job by id, error is: %v", st.Err())
+		caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
 		return nil, st.Err()
 	}
-	// 查询cputresId、memTresId、nodeTresId值
-	cpuTresSqlConfig := "SELECT id FROM tres_table WHERE type = 'cpu'"
-	memTresSqlConfig := "SELECT id FROM tres_table WHERE type = 'mem'"
-	nodeTresSqlConfig := "SELECT id FROM tres_table WHERE type = 'node'"
-	caller.DB.QueryRow(cpuTresSqlConfig).Scan(&cpuTresId)
-	caller.DB.QueryRow(memTresSqlConfig).Scan(&memTresId)
-	caller.DB.QueryRow(nodeTresSqlConfig).Scan(&nodeTresId)
-
-	stateString = utils.ChangeState(state)
-	submitTimeTimestamp := &timestamppb.Timestamp{Seconds: int64(time.Unix(submitTime, 0).Unix())}
-	startTimeTimestamp := &timestamppb.Timestamp{Seconds: int64(time.Unix(startTime, 0).Unix())}
-	endTimeTimestamp := &timestamppb.Timestamp{Seconds: int64(time.Unix(endTime, 0).Unix())}
-
-	// username 转换，需要从ldap中拿数据
-	userName, _ := utils.GetUserNameByUid(idUser)
-
-	// 查询qos的名字
-	qosSqlConfig := "SELECT name FROM qos_table WHERE id = ?"
-	caller.DB.QueryRow(qosSqlConfig, idQos).Scan(&qosName)
-
-	// 查找SelectType插件的值
-	slurmConfigCmd := "scontrol show config | grep 'SelectType ' | awk -F'=' '{print $2}' | awk -F'/' '{print $2}'"
-	output, err := utils.RunCommand(slurmConfigCmd)
-	if err != nil || utils.CheckSlurmStatus(output) {
+	job := findQueueJob(ctx, jobId, arrayTaskId)
+	if job == nil {
 		errInfo := &errdetails.ErrorInfo{
-			Reason: "COMMAND_EXEC_FAILED",
+			Reason: "JOB_NOT_FOUND",
 		}
-		st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
+		st := status.New(codes.NotFound, "The job does not exist.")
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("Failed get job by id, error is: %v", st.Err())
+		caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
 		return nil, st.Err()
 	}
-
-	// 查询gpu对应的id信息
-	gpuSqlConfig := "SELECT id FROM tres_table WHERE type = 'gres' AND deleted = 0"
-	rows, err := caller.DB.Query(gpuSqlConfig)
-	if err != nil {
+	if !admin && job.User != userId {
 		errInfo := &errdetails.ErrorInfo{
-			Reason: "SQL_QUERY_FAILED",
+			Reason: "JOB_NOT_OWNED",
 		}
-		st := status.New(codes.Internal, err.Error())
+		message := fmt.Sprintf("The job is not owned by %s.", userId)
+		st := status.New(codes.PermissionDenied, message)
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("Failed get job by id, error is: %v", st.Err())
+		caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
 		return nil, st.Err()
 	}
-	defer rows.Close()
-	for rows.Next() {
-		err := rows.Scan(&gpuId)
-		if err != nil {
+	// slurm只允许管理员suspend和resume作业, 检查完所有权后由适配器执行
+	runAs := userId
+	if action == backend.JobActionSuspend || action == backend.JobActionResume {
+		runAs = ""
+	}
+	err := caller.GetBackend(ctx).ControlJob(runAs, jobId, arrayTaskId, action)
+	if err == nil && reason != nil {
+		err = caller.GetBackend(ctx).SetJobAdminComment(jobId, arrayTaskId, *reason)
+	}
+	if err != nil {
+		if errors.Is(err, backend.ErrNotSupported) {
 			errInfo := &errdetails.ErrorInfo{
-				Reason: "SQL_QUERY_FAILED",
+				Reason: "NOT_SUPPORTED",
 			}
-			st := status.New(codes.Internal, err.Error())
+			st := status.New(codes.Unimplemented, err.Error())
 			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("Failed get job by id, error is: %v", st.Err())
+			caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
 			return nil, st.Err()
 		}
-		gpuIdList = append(gpuIdList, gpuId)
-	}
-	err = rows.Err()
-	if err != nil {
 		errInfo := &errdetails.ErrorInfo{
-			Reason: "SQL_QUERY_FAILED",
+			Reason: strings.ToUpper(string(action)) + "_JOB_FAILED",
 		}
-		st := status.New(codes.Internal, err.Error())
+		st := status.New(codes.Unknown, err.Error())
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("Failed get job by id, error is: %v", st.Err())
+		caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
 		return nil, st.Err()
 	}
-
-	// 状态为排队和挂起的作业信息
-	if state == 0 || state == 2 {
-		getReasonCmd := fmt.Sprintf("scontrol show job=%d |grep 'Reason=' | awk '{print $2}'| awk -F'=' '{print $2}'", jobId)
-		output, err := utils.RunCommand(getReasonCmd)
-		if err != nil || utils.CheckSlurmStatus(output) {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "COMMAND_EXEC_FAILED",
-			}
-			st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("Failed get job by id, error is: %v", st.Err())
-			return nil, st.Err()
-		}
-		reason = output
-
-		if state == 0 {
-			cpusAlloc = 0
-			memAllocMb = 0
-			nodeReq = int32(utils.GetResInfoNumFromTresInfo(tresReq, nodeTresId))
-			elapsedSeconds = 0
-			gpusAlloc = 0
-		} else {
-			cpusAlloc = int32(utils.GetResInfoNumFromTresInfo(tresAlloc, cpuTresId))
-			memAllocMb = int64(utils.GetResInfoNumFromTresInfo(tresAlloc, memTresId))
-			nodeReq = int32(utils.GetResInfoNumFromTresInfo(tresReq, nodeTresId))
-			elapsedSeconds = time.Now().Unix() - startTime
-
-			if output == "cons_tres" || output == "cons_res" {
-				if len(gpuIdList) == 0 {
-					gpusAlloc = 0
-				} else {
-					gpusAlloc = utils.GetGpuAllocsFromGpuIdList(tresAlloc, gpuIdList)
-				}
-			} else {
-				gpusAlloc = 0
-			}
-		}
-	} else if state == 1 {
-		reason = "Running" // 正在运行的作业的信息
-		cpusAlloc = int32(utils.GetResInfoNumFromTresInfo(tresAlloc, cpuTresId))
-		memAllocMb = int64(utils.GetResInfoNumFromTresInfo(tresAlloc, memTresId))
-		nodeReq = int32(utils.GetResInfoNumFromTresInfo(tresReq, nodeTresId))
-
-		elapsedSeconds = time.Now().Unix() - startTime
-		if output == "cons_tres" || output == "cons_res" {
-			if len(gpuIdList) == 0 {
-				gpusAlloc = 0
-			} else {
-				// 从tres_alloc中解析出gpu对应的卡数
-				gpusAlloc = utils.GetGpuAllocsFromGpuIdList(tresAlloc, gpuIdList)
-			}
-		} else {
-			gpusAlloc = 0
-		}
-	} else {
-		reason = "end of job" // 结束状态的作业信息
-		cpusAlloc = int32(utils.GetResInfoNumFromTresInfo(tresAlloc, cpuTresId))
-		memAllocMb = int64(utils.GetResInfoNumFromTresInfo(tresAlloc, memTresId))
-		nodeReq = int32(utils.GetResInfoNumFromTresInfo(tresReq, nodeTresId))
-		elapsedSeconds = endTime - startTime
-		if output == "cons_tres" || output == "cons_res" {
-			if len(gpuIdList) == 0 {
-				gpusAlloc = 0
-			} else {
-				gpusAlloc = utils.GetGpuAllocsFromGpuIdList(tresAlloc, gpuIdList)
-			}
-		} else {
-			gpusAlloc = 0
-		}
+	if reason != nil {
+		caller.Logger.Infof("%s job %d by administrator: %s", action, jobId, *reason)
 	}
-	if len(fields) == 0 {
-		jobInfo := &pb.JobInfo{
-			JobId:            in.JobId,
-			Name:             jobName,
-			User:             userName,
-			Reason:           &reason,
-			Account:          account,
-			Partition:        partition,
-			Qos:              qosName,
-			State:            stateString,
-			CpusReq:          cpusReq,
-			MemReqMb:         memReq,
-			TimeLimitMinutes: timeLimitMinutes,
-			SubmitTime:       submitTimeTimestamp,
-			WorkingDirectory: workingDirectory,
-			NodeList:         &nodeList,
-			StartTime:        startTimeTimestamp,
-			EndTime:          endTimeTimestamp,
-			NodesAlloc:       &nodesAlloc,
-			CpusAlloc:        &cpusAlloc,
-			MemAllocMb:       &memAllocMb,
-			NodesReq:         nodeReq,
-			StdoutPath:       &stdoutPath,
-			StderrPath:       &stderrPath,
-			ElapsedSeconds:   &elapsedSeconds,
-			GpusAlloc:        &gpusAlloc,
-		}
-		caller.Logger.Infof("GetJobByIdResponse: %v", jobInfo)
-		return &pb.GetJobByIdResponse{Job: jobInfo}, nil
-	} else {
-		jobInfo := &pb.JobInfo{}
-		for _, field := range fields {
-			switch field {
-			case "job_id":
-				jobInfo.JobId = in.JobId
-			case "name":
-				jobInfo.Name = jobName
-			case "account":
-				jobInfo.Account = account
-			case "user":
-				jobInfo.User = userName
-			case "partition":
-				jobInfo.Partition = partition
-			case "qos":
-				jobInfo.Qos = qosName
-			case "state":
-				jobInfo.State = stateString
-			case "cpus_req":
-				jobInfo.CpusReq = cpusReq
-			case "mem_req_mb":
-				jobInfo.MemReqMb = memReq
-			case "nodes_req":
-				jobInfo.NodesReq = nodeReq
-			case "time_limit_minutes":
-				jobInfo.TimeLimitMinutes = timeLimitMinutes
-			case "submit_time":
-				jobInfo.SubmitTime = submitTimeTimestamp
-			case "working_directory":
-				jobInfo.WorkingDirectory = workingDirectory
-			case "stdout_path":
-				jobInfo.StdoutPath = &stdoutPath
-			case "stderr_path":
-				jobInfo.StderrPath = &stderrPath
-			case "start_time":
-				jobInfo.StartTime = startTimeTimestamp
-			case "elapsed_seconds":
-				jobInfo.ElapsedSeconds = &elapsedSeconds
-			case "reason":
-				jobInfo.Reason = &reason
-			case "node_list":
-				jobInfo.NodeList = &nodeList
-			case "gpus_alloc":
-				jobInfo.GpusAlloc = &gpusAlloc
-			case "cpus_alloc":
-				jobInfo.CpusAlloc = &cpusAlloc
-			case "mem_alloc_mb":
-				jobInfo.MemAllocMb = &memAllocMb
-			case "nodes_alloc":
-				jobInfo.NodesAlloc = &nodesAlloc
-			case "end_time":
-				jobInfo.EndTime = endTimeTimestamp
-			}
-		}
-		caller.Logger.Infof("GetJobByIdResponse: %v", jobInfo)
-		return &pb.GetJobByIdResponse{Job: jobInfo}, nil
+	// 返回操作后的作业状态, 作业已经离开slurmctld时沿用操作前的状态
+	if result := findQueueJob(ctx, jobId, arrayTaskId); result != nil {
+		job = result
 	}
+	return job, nil
 }
 
-func (s *ServerJob) GetJobs(ctx context.Context, in *pb.GetJobsRequest) (*pb.GetJobsResponse, error) {
-	var (
-		jobId             int
-		jobName           string
-		account           string
-		partition         string
-		idQos             int
-		state             int
-		cpusReq           int32
-		memReq            uint64
-		timeLimitMinutes  int64
-		idUser            int
-		submitTime        int64
-		startTime         int64
-		timeSuspended     int64
-		gresUsed          string
-		nodeList          string
-		nodesAlloc        int32
-		endTime           int64
-		workingDirectory  string
-		qosName           string
-		stateString       string
-		gpuId             int
-		tresAlloc         string
-		tresReq           string
-		jobSqlConfig      string
-		jobSqlTotalConfig string
-		startTimeFilter   int64
-		endTimeFilter     int64
-		submitStartTime   int64
-		submitEndTime     int64
-		count             int
-		pageLimit         int
-		totalCount        uint32
-		cpuTresId         int
-		memTresId         int
-		nodeTresId        int
-		gpuIdList         []int
-		uidList           []int
-		stateIdList       []int
-		jobInfo           []*pb.JobInfo
-		params            []interface{}
-		totalParams       []interface{}
-		pendingMap        map[int]string
-		pendingUserMap    map[int]string
-		orderStr          string
-		accountsString    string
-		uidListString     string
-		stateIdListString string
-	)
-	// 记录日志
-	caller.Logger.Infof("Received request GetJobs: %v", in)
-	clusterName := caller.ConfigValue.MySQLConfig.ClusterName
-	var fields []string = in.Fields
-
-	var filterStates = in.Filter.States // 这个是筛选的
-	var baseStates = []string{"RUNNING", "PENDING", "SUSPENDED"}
-	var submitUser = in.Filter.Users
-	var getJobInfoCmdLine string
-	setBool := utils.IsSubSet(baseStates, filterStates)
-
-	pendingUserCmdTemp := fmt.Sprintf("squeue -t pending -u %s", strings.Join(submitUser, ","))
-	pendingUserCmd := pendingUserCmdTemp + " --noheader --format='%i=%R' | tr '\\n' ';'"
-	pendingUserResult, err := utils.RunCommand(pendingUserCmd)
-	if err != nil || utils.CheckSlurmStatus(pendingUserResult) {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "COMMAND_EXEC_FAILED",
-		}
-		st := status.New(codes.Internal, "slurmctld down.")
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("GetJobs failed: %v", st.Err())
-		return nil, st.Err()
-	}
-	if len(pendingUserResult) != 0 {
-		pendingUserMap = utils.GetPendingMapInfo(pendingUserResult)
+func (s *ServerJob) HoldJob(ctx context.Context, in *pb.HoldJobRequest) (*pb.HoldJobResponse, error) {
+	caller.Logger.Infof("Received request HoldJob: %v", in)
+	job, err := controlJob(ctx, "HoldJob", backend.JobActionHold, in.UserId, in.Admin, in.JobId, in.ArrayTaskId, in.Reason)
+	if err != nil {
+		return nil, err
 	}
+	return &pb.HoldJobResponse{State: job.State, StateReason: job.Reason}, nil
+}
 
-	if setBool && len(filterStates) != 0 && len(submitUser) != 0 {
-		if len(in.Filter.Accounts) == 0 {
-			if in.Filter.JobId == nil && in.Filter.JobName == nil {
-				getJobInfoCmdLine = fmt.Sprintf("squeue -u %s --noheader -t %s", strings.Join(submitUser, ","), strings.ToLower(strings.Join(in.Filter.States, ",")))
-			} else if in.Filter.JobId == nil && in.Filter.JobName != nil {
-				getJobInfoCmdLine = fmt.Sprintf("squeue -u %s --noheader -t %s -n %s", strings.Join(submitUser, ","), strings.ToLower(strings.Join(in.Filter.States, ",")), *in.Filter.JobName)
-			} else if in.Filter.JobId != nil && in.Filter.JobName == nil {
-				getJobInfoCmdLine = fmt.Sprintf("squeue -u %s --noheader -t %s -j %d", strings.Join(submitUser, ","), strings.ToLower(strings.Join(in.Filter.States, ",")), *in.Filter.JobId)
-			} else {
-				getJobInfoCmdLine = fmt.Sprintf("squeue -u %s --noheader -t %s -n %s -j %d", strings.Join(submitUser, ","), strings.ToLower(strings.Join(in.Filter.States, ",")), *in.Filter.JobName, *in.Filter.JobId)
-			}
-			// getJobInfoCmdLine = fmt.Sprintf("squeue -u %s --noheader -t %s", strings.Join(submitUser, ","), strings.ToLower(strings.Join(in.Filter.States, ",")))
-		} else {
-			if in.Filter.JobId == nil && in.Filter.JobName == nil {
-				getJobInfoCmdLine = fmt.Sprintf("squeue -u %s --noheader -t %s", strings.Join(submitUser, ","), strings.ToLower(strings.Join(in.Filter.States, ",")))
-			} else if in.Filter.JobId == nil && in.Filter.JobName != nil {
-				getJobInfoCmdLine = fmt.Sprintf("squeue -u %s --noheader -t %s -n %s", strings.Join(submitUser, ","), strings.ToLower(strings.Join(in.Filter.States, ",")), *in.Filter.JobName)
-			} else if in.Filter.JobId != nil && in.Filter.JobName == nil {
-				getJobInfoCmdLine = fmt.Sprintf("squeue -u %s --noheader -t %s -j %d", strings.Join(submitUser, ","), strings.ToLower(strings.Join(in.Filter.States, ",")), *in.Filter.JobId)
-			} else {
-				getJobInfoCmdLine = fmt.Sprintf("squeue -u %s --noheader -t %s -n %s -j %d", strings.Join(submitUser, ","), strings.ToLower(strings.Join(in.Filter.States, ",")), *in.Filter.JobName, *in.Filter.JobId)
-			}
-			// getJobInfoCmdLine = fmt.Sprintf("squeue -u %s -A %s --noheader -t %s", strings.Join(submitUser, ","), strings.Join(in.Filter.Accounts, ","), strings.ToLower(strings.Join(in.Filter.States, ",")))
-		}
-		getFullCmdLine := getJobInfoCmdLine + " " + "--format='%b %a %A %C %D %j %l %m %M %P %q %S %T %u %V %Z %N' | tr '\n' ';'"
+func (s *ServerJob) ReleaseJob(ctx context.Context, in *pb.ReleaseJobRequest) (*pb.ReleaseJobResponse, error) {
+	caller.Logger.Infof("Received request ReleaseJob: %v", in)
+	job, err := controlJob(ctx, "ReleaseJob", backend.JobActionRelease, in.UserId, in.Admin, in.JobId, in.ArrayTaskId, in.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReleaseJobResponse{State: job.State, StateReason: job.Reason}, nil
+}
 
-		caller.Logger.Tracef("GetJobs get jobs command: %v", getFullCmdLine)
-		runningjobInfo, err := utils.RunCommand(getFullCmdLine)
-		if err != nil || utils.CheckSlurmStatus(runningjobInfo) {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "COMMAND_EXEC_FAILED",
-			}
-			st := status.New(codes.Internal, "slurmctld down.")
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("GetJobs Failed: %v", st.Err())
-			return nil, st.Err()
-		}
-		// runningJobInfoList := strings.Split(runningjobInfo, ",")
-		runningJobInfoList := strings.Split(runningjobInfo, ";")
-		if len(runningJobInfoList) == 0 {
-			return &pb.GetJobsResponse{Jobs: jobInfo}, nil
-		}
-		for _, v := range runningJobInfoList {
-			var singerJobJobNodesAlloc int32
-			var singerJobJobReason string
-			var singerJobCpusAlloc int32
-			var singerJobElapsedSeconds int64
-			var singerJobInfoNodeList string
-			var timeSubmit int64
-			var timeLimit int64
-			var singerJobGpusAlloc int32
-			var singerJobTimeSubmit *timestamppb.Timestamp
-			var singerJobtimeLimitMinutes int64
-			if len(strings.Split(v, " ")) == 17 {
-				singerJobInfo := strings.Split(v, " ")
-				singerJobAccount := singerJobInfo[1]
-				singerJobUserName := singerJobInfo[13]
-				singerJobJobId, _ := strconv.Atoi(singerJobInfo[2])
-				singerJobState := singerJobInfo[12]
-				singerJobJobPartition := singerJobInfo[9]
-				singerJobJobName := singerJobInfo[5]
-				singerJobQos := singerJobInfo[10]
-				singerJobWorkingDirectory := singerJobInfo[15]
-				if singerJobInfo[6] == "UNLIMITED" {
-					singerJobtimeLimitMinutes = 0
-				} else if singerJobInfo[6] == "INVALID" { //INVALID 要另起逻辑
-					timeLimitSqlConfig := fmt.Sprintf("SELECT timelimit FROM %s_job_table WHERE id_job = ?", clusterName)
-					caller.DB.QueryRow(timeLimitSqlConfig, singerJobJobId).Scan(&timeLimit)
-					singerJobtimeLimitMinutes = timeLimit
-				} else {
-					singerJobtimeLimitMinutes = utils.GetTimeLimit(singerJobInfo[6])
-				}
-				submittimeSqlConfig := fmt.Sprintf("SELECT time_submit FROM %s_job_table WHERE id_job = ?", clusterName)
-				caller.DB.QueryRow(submittimeSqlConfig, singerJobJobId).Scan(&timeSubmit)
-				if timeSubmit == 0 {
-					singerJobTimeSubmit = &timestamppb.Timestamp{Seconds: int64(time.Now().Unix())}
-				} else {
-					singerJobTimeSubmit = &timestamppb.Timestamp{Seconds: int64(time.Unix(timeSubmit, 0).Unix())}
-				}
-				if singerJobState == "PENDING" {
-					singerJobJobNodesAlloc = 0
-					// singerJobJobReason = singerJobInfo[17]
-					if _, ok := pendingUserMap[singerJobJobId]; ok {
-						// singerJobJobReason = pendingUserMap[singerJobJobId] // 这个reason的值需要更新配置
-						reason := pendingUserMap[singerJobJobId] // 这个reason的值需要更新配置
-						re := regexp.MustCompile(`Job's account not permitted to use this partition`)
-						match := re.FindString(reason)
-						if match != "" {
-							singerJobJobReason = match
-						} else {
-							singerJobJobReason = reason
-						}
-					}
-					singerJobCpusAlloc = 0
-					singerJobElapsedSeconds = 0
-					singerJobInfoNodeList = "None assigned"
-				} else {
-					singerJobJobNodesAllocTemp, _ := strconv.Atoi(singerJobInfo[4])
-					singerJobJobNodesAlloc = int32(singerJobJobNodesAllocTemp)
-					singerJobJobReason = singerJobInfo[12]
-					singerJobCpusAllocTemp, _ := strconv.Atoi(singerJobInfo[3])
-					singerJobCpusAlloc = int32(singerJobCpusAllocTemp)
-					singerJobElapsedSeconds = utils.GetRunningElapsedSeconds(singerJobInfo[8])
-					singerJobInfoNodeList = singerJobInfo[16]
-				}
+func (s *ServerJob) SuspendJob(ctx context.Context, in *pb.SuspendJobRequest) (*pb.SuspendJobResponse, error) {
+	caller.Logger.Infof("Received request SuspendJob: %v", in)
+	job, err := controlJob(ctx, "SuspendJob", backend.JobActionSuspend, in.UserId, in.Admin, in.JobId, in.ArrayTaskId, in.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SuspendJobResponse{State: job.State, StateReason: job.Reason}, nil
+}
 
-				// 新加代码，在正在运行中的作业添加gpu分配逻辑
-				if singerJobInfo[0] == "N/A" {
-					singerJobGpusAlloc = 0
-				} else {
-					perNodeGresAlloc := strings.Split(singerJobInfo[0], ":")
-					// 求长度
-					lastIndex := len(perNodeGresAlloc) - 1
-					lastElement := perNodeGresAlloc[lastIndex]
-					perNodeGpusNum, _ := strconv.Atoi(lastElement)
-					// perNodeGpusNum, _ := strconv.Atoi(perNodeGresAlloc[2])
-					singerJobGpusAlloc = int32(perNodeGpusNum) * singerJobJobNodesAlloc
-				}
+func (s *ServerJob) ResumeJob(ctx context.Context, in *pb.ResumeJobRequest) (*pb.ResumeJobResponse, error) {
+	caller.Logger.Infof("Received request ResumeJob: %v", in)
+	job, err := controlJob(ctx, "ResumeJob", backend.JobActionResume, in.UserId, in.Admin, in.JobId, in.ArrayTaskId, in.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResumeJobResponse{State: job.State, StateReason: job.Reason}, nil
+}
 
-				jobInfo = append(jobInfo, &pb.JobInfo{
-					JobId:            uint32(singerJobJobId),
-					Name:             singerJobJobName,
-					Account:          singerJobAccount,
-					User:             singerJobUserName,
-					Partition:        singerJobJobPartition,
-					Qos:              singerJobQos,
-					State:            singerJobState,
-					TimeLimitMinutes: singerJobtimeLimitMinutes,
-					WorkingDirectory: singerJobWorkingDirectory,
-					Reason:           &singerJobJobReason,
-					CpusAlloc:        &singerJobCpusAlloc,
-					NodesAlloc:       &singerJobJobNodesAlloc,
-					ElapsedSeconds:   &singerJobElapsedSeconds,
-					NodeList:         &singerJobInfoNodeList,
-					SubmitTime:       singerJobTimeSubmit,
-					GpusAlloc:        &singerJobGpusAlloc,
-				})
-			} else {
-				continue
-			}
-		}
-		// 返回
-		if len(jobInfo) == 0 {
-			return &pb.GetJobsResponse{Jobs: jobInfo}, nil
-		}
-
-		if in.Sort != nil && len(jobInfo) != 0 {
-			// 排序
-			var sortKey string
-			if in.Sort.GetField() == "" {
-				sortKey = "JobId"
-			} else {
-				sortKey = in.Sort.GetField()
-				// 字段转换
-				words := strings.Split(sortKey, "_")
-				for i := 0; i < len(words); i++ {
-					words[i] = strings.Title(words[i])
-				}
-				sortKey = strings.Join(words, "")
-			}
-			sortOrder := in.Sort.GetOrder().String()
-			sortJobinfo := utils.SortJobInfo(sortKey, sortOrder, jobInfo)
-			return &pb.GetJobsResponse{Jobs: sortJobinfo}, nil
-		}
-		caller.Logger.Tracef("GetJobs GetJobsResponse is: %v", &pb.GetJobsResponse{Jobs: jobInfo})
-		return &pb.GetJobsResponse{Jobs: jobInfo}, nil
+func (s *ServerJob) RequeueJob(ctx context.Context, in *pb.RequeueJobRequest) (*pb.RequeueJobResponse, error) {
+	caller.Logger.Infof("Received request RequeueJob: %v", in)
+	job, err := controlJob(ctx, "RequeueJob", backend.JobActionRequeue, in.UserId, in.Admin, in.JobId, in.ArrayTaskId, in.Reason)
+	if err != nil {
+		return nil, err
 	}
+	return &pb.RequeueJobResponse{State: job.State, StateReason: job.Reason}, nil
+}
+
+// 可以取消的作业状态
+var cancelableStates = []string{"PENDING", "RUNNING", "SUSPENDED"}
 
-	// 查找SelectType插件的值
-	slurmSelectTypeConfigCmd := "scontrol show config | grep 'SelectType ' | awk -F'=' '{print $2}' | awk -F'/' '{print $2}'"
-	output, err := utils.RunCommand(slurmSelectTypeConfigCmd)
-	if err != nil || utils.CheckSlurmStatus(output) {
+func (s *ServerJob) CancelJobs(ctx context.Context, in *pb.CancelJobsRequest) (*pb.CancelJobsResponse, error) {
+	caller.Logger.Infof("Received request CancelJobs: %v", in)
+	filter := in.Filter
+	// 不允许不带条件取消全部作业
+	if filter == nil || (len(filter.Users) == 0 && len(filter.Accounts) == 0 && len(filter.States) == 0 &&
+		filter.JobName == nil && len(filter.Partitions) == 0 && len(filter.JobIds) == 0) {
 		errInfo := &errdetails.ErrorInfo{
-			Reason: "COMMAND_EXEC_FAILED",
+			Reason: "FILTER_NOT_SET",
 		}
-		st := status.New(codes.Internal, "slurmctld down.")
+		st := status.New(codes.InvalidArgument, "At least one filter option must be set.")
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("GetJobs failed: %v", st.Err())
+		caller.Logger.Errorf("CancelJobs failed: %v", st.Err())
 		return nil, st.Err()
 	}
-
-	// cputresId、memTresId、nodeTresId
-	cpuTresSqlConfig := "SELECT id FROM tres_table WHERE type = 'cpu'"
-	memTresSqlConfig := "SELECT id FROM tres_table WHERE type = 'mem'"
-	nodeTresSqlConfig := "SELECT id FROM tres_table WHERE type = 'node'"
-	caller.DB.QueryRow(cpuTresSqlConfig).Scan(&cpuTresId)
-	caller.DB.QueryRow(memTresSqlConfig).Scan(&memTresId)
-	caller.DB.QueryRow(nodeTresSqlConfig).Scan(&nodeTresId)
-
-	gpuSqlConfig := "SELECT id FROM tres_table WHERE type = 'gres' AND deleted = 0"
-	rowList, err := caller.DB.Query(gpuSqlConfig)
+	queueFilter := &backend.QueueFilter{
+		Users:      filter.Users,
+		Accounts:   filter.Accounts,
+		States:     filter.States,
+		Partitions: filter.Partitions,
+		JobIds:     filter.JobIds,
+		JobName:    filter.JobName,
+	}
+	if len(queueFilter.States) == 0 {
+		queueFilter.States = cancelableStates
+	}
+	if err := checkJobOperator(ctx, "CancelJobs", in.UserId, in.Admin); err != nil {
+		return nil, err
+	}
+	if !in.Admin {
+		// 用户只能取消自己的作业
+		if len(filter.Users) != 0 && !slices.Contains(filter.Users, in.UserId) {
+			return &pb.CancelJobsResponse{}, nil
+		}
+		queueFilter.Users = []string{in.UserId}
+	}
+	queueJobs, err := caller.GetBackend(ctx).ListQueueJobs(queueFilter)
 	if err != nil {
 		errInfo := &errdetails.ErrorInfo{
-			Reason: "SQL_QUERY_FAILED",
+			Reason: "COMMAND_EXEC_FAILED",
 		}
-		st := status.New(codes.Internal, err.Error())
+		st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("GetJobs Failed: %v", st.Err())
+		caller.Logger.Errorf("CancelJobs failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	defer rowList.Close()
-	for rowList.Next() {
-		err := rowList.Scan(&gpuId)
-		if err != nil {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "SQL_QUERY_FAILED",
-			}
-			st := status.New(codes.Internal, err.Error())
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("GetJobs Failed: %v", st.Err())
-			return nil, st.Err()
+	// 筛选条件中可能有已经结束的状态, 只取消未结束的作业
+	var jobs []*backend.QueueJob
+	for _, job := range queueJobs {
+		if slices.Contains(cancelableStates, job.State) {
+			jobs = append(jobs, job)
 		}
-		gpuIdList = append(gpuIdList, gpuId)
 	}
-	err = rowList.Err()
+	if in.DryRun {
+		caller.Logger.Infof("CancelJobs dry run matched %d jobs", len(jobs))
+		return &pb.CancelJobsResponse{MatchedCount: uint32(len(jobs))}, nil
+	}
+
+	jobSpecs := make([]string, len(jobs))
+	for i, job := range jobs {
+		jobSpecs[i] = job.JobSpec()
+	}
+	cancelErrors, err := caller.GetBackend(ctx).CancelJobs(in.UserId, jobSpecs)
 	if err != nil {
 		errInfo := &errdetails.ErrorInfo{
-			Reason: "SQL_QUERY_FAILED",
+			Reason: "CANCEL_JOB_FAILED",
 		}
-		st := status.New(codes.Internal, err.Error())
+		st := status.New(codes.Unknown, err.Error())
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("GetJobs Failed: %v", st.Err())
+		caller.Logger.Errorf("CancelJobs failed: %v", st.Err())
 		return nil, st.Err()
 	}
-
-	if in.Sort != nil {
-		orderStr = fmt.Sprintf("ORDER BY job_db_inx %s", in.Sort.GetOrder().String())
-	} else {
-		orderStr = "ORDER BY job_db_inx ASC" // 默认就是升序排序
+	var results []*pb.CancelJobResult
+	for i, job := range jobs {
+		result := &pb.CancelJobResult{JobId: job.JobId, User: job.User, Cancelled: true}
+		if job.ArrayJobId != 0 {
+			result.JobId = job.ArrayJobId
+			result.ArrayTaskId = &job.ArrayTaskId
+		}
+		if cancelErr, ok := cancelErrors[jobSpecs[i]]; ok {
+			result.Cancelled = false
+			result.Error = cancelErr.Error()
+		}
+		results = append(results, result)
 	}
+	caller.Logger.Tracef("CancelJobs CancelJobsResponse is: %v", results)
+	return &pb.CancelJobsResponse{MatchedCount: uint32(len(jobs)), Results: results}, nil
+}
 
-	// 从这里开始改造
-	if in.Filter != nil {
-		// 有搜索条件的
-		if in.Filter.EndTime != nil {
-			startTimeFilter = in.Filter.EndTime.StartTime.GetSeconds()
-			endTimeFilter = in.Filter.EndTime.EndTime.GetSeconds()
-		}
-		if in.Filter.SubmitTime != nil {
-			submitStartTime = in.Filter.SubmitTime.StartTime.GetSeconds()
-			submitEndTime = in.Filter.SubmitTime.EndTime.GetSeconds()
-		}
-		if in.Filter.Accounts != nil {
-			accountsString = "'" + strings.Join(in.Filter.Accounts, "','") + "'"
-		}
-		if in.Filter.Users != nil { // 用户
-			for _, user := range in.Filter.Users {
-				uid, _, _ := utils.GetUserUidGid(user)
-				uidList = append(uidList, uid)
-			}
-			uidListString = strings.Trim(strings.Join(strings.Fields(fmt.Sprint(uidList)), ","), "[]")
-		}
-		if in.Filter.States != nil { // 状态
-			for _, state := range in.Filter.States {
-				stateId := utils.GetStateId(state)
-				stateIdList = append(stateIdList, stateId)
-			}
-			stateIdListString = strings.Trim(strings.Join(strings.Fields(fmt.Sprint(stateIdList)), ","), "[]")
-		}
-
-		baseSQL := fmt.Sprintf("SELECT account, id_user, cpus_req, job_name, id_job, id_qos, mem_req, nodelist, nodes_alloc, `partition`, state, timelimit, time_submit, time_start, time_end, time_suspended, gres_used, work_dir, tres_alloc, tres_req FROM %s_job_table WHERE ", clusterName)
-		databaseEncode := caller.ConfigValue.MySQLConfig.DatabaseEncode
-		caller.Logger.Tracef("Database encode is: %s", databaseEncode)
-		// 正常情况下，数据库编码格式是latin1，环境部署时config.yaml中databaseEncode也会配成latin1。 但是为了防止config.yaml中databaseEncode配成utf8，查询时需要做这样的转换。
-		if strings.Contains(databaseEncode, "utf8") {
-			baseSQL = fmt.Sprintf("SELECT account, id_user, cpus_req, CONVERT(CAST(job_name AS BINARY) USING utf8) AS job_name, id_job, id_qos, mem_req, nodelist, nodes_alloc, `partition`, state, timelimit, time_submit, time_start, time_end, time_suspended, gres_used, CONVERT(CAST(work_dir AS BINARY) USING utf8) AS work_dir, tres_alloc, tres_req FROM %s_job_table WHERE ", clusterName)
-		}
-
-		conditions := []string{}
-
-		if uidListString != "" {
-			conditions = append(conditions, fmt.Sprintf("id_user IN (%s)", uidListString))
-		}
-		if stateIdListString != "" {
-			conditions = append(conditions, fmt.Sprintf("state IN (%s)", stateIdListString))
-		}
-		if accountsString != "" {
-			conditions = append(conditions, fmt.Sprintf("account IN (%s)", accountsString))
-		}
-		if startTimeFilter != 0 {
-			conditions = append(conditions, "(time_end >= ? OR ? = 0)")
-			params = append(params, startTimeFilter, startTimeFilter)
-			totalParams = append(totalParams, startTimeFilter, startTimeFilter)
-		}
-		if endTimeFilter != 0 {
-			conditions = append(conditions, "(time_end <= ? OR ? = 0)")
-			params = append(params, endTimeFilter, endTimeFilter)
-			totalParams = append(totalParams, endTimeFilter, endTimeFilter)
-		}
-		if submitStartTime != 0 {
-			conditions = append(conditions, "(time_submit >= ? OR ? = 0)")
-			params = append(params, submitStartTime, submitStartTime)
-			totalParams = append(totalParams, submitStartTime, submitStartTime)
-		}
-		if submitEndTime != 0 {
-			conditions = append(conditions, "(time_submit <= ? OR ? = 0)")
-			params = append(params, submitEndTime, submitEndTime)
-			totalParams = append(totalParams, submitEndTime, submitEndTime)
-		}
-		// 加了按作业名和作业id来搜索作业的逻辑
-		if in.Filter.JobId != nil {
-			conditions = append(conditions, "id_job = ?")
-			params = append(params, *in.Filter.JobId)
-			totalParams = append(totalParams, *in.Filter.JobId)
-		}
-		if in.Filter.JobName != nil {
-			conditions = append(conditions, "CONVERT(CAST(job_name AS BINARY) USING utf8) = ?")
-			params = append(params, *in.Filter.JobName)
-			totalParams = append(totalParams, *in.Filter.JobName)
-		}
-		queryConditions := strings.Join(conditions, " AND ")
-		if in.PageInfo != nil {
-			// 分页的情况
-			page := in.PageInfo.Page
-			pageSize := in.PageInfo.PageSize
-			pageLimit = int(pageSize)
-			if page == 1 {
-				pageSize = 0
-			} else {
-				pageSize = pageSize * uint64(page-1)
-			}
-			jobSqlConfig = fmt.Sprintf("%s %s %s LIMIT ? OFFSET ?", baseSQL, queryConditions, orderStr)
-			params = append(params, pageLimit, pageSize)
-			jobSqlTotalConfig = fmt.Sprintf("SELECT count(*) FROM %s_job_table WHERE %s", clusterName, queryConditions)
-		} else {
-			// 不分页的情况
-			jobSqlConfig = fmt.Sprintf("%s %s %s", baseSQL, queryConditions, orderStr)
-			jobSqlTotalConfig = fmt.Sprintf("SELECT count(*) FROM %s_job_table WHERE %s", clusterName, queryConditions)
-		}
-	} else {
-		// 没有搜索条件
-		baseSQL := fmt.Sprintf("SELECT account, id_user, cpus_req, job_name, id_job, id_qos, mem_req, nodelist, nodes_alloc, `partition`, state, timelimit, time_submit, time_start, time_end, time_suspended, gres_used, work_dir, tres_alloc, tres_req FROM %s_job_table ", clusterName)
-		databaseEncode := caller.ConfigValue.MySQLConfig.DatabaseEncode
-		caller.Logger.Tracef("Database encode is: %s", databaseEncode)
-		// 正常情况下，数据库编码格式是latin1，环境部署时config.yaml中databaseEncode也会配成latin1。 但是为了防止config.yaml中databaseEncode配成utf8，查询时需要做这样的转换。
-		if strings.Contains(databaseEncode, "utf8") {
-			baseSQL = fmt.Sprintf("SELECT account, id_user, cpus_req, CONVERT(CAST(job_name AS BINARY) USING utf8) AS job_name, id_job, id_qos, mem_req, nodelist, nodes_alloc, `partition`, state, timelimit, time_submit, time_start, time_end, time_suspended, gres_used, CONVERT(CAST(work_dir AS BINARY) USING utf8) AS work_dir, tres_alloc, tres_req FROM %s_job_table WHERE ", clusterName)
-		}
-
-		if in.PageInfo != nil {
-			// 分页的情况 没有搜索的情况
-			page := in.PageInfo.Page
-			pageSize := in.PageInfo.PageSize
-			pageLimit = int(pageSize)
-			if page == 1 {
-				pageSize = 0
-			} else {
-				pageSize = pageSize * uint64(page-1)
-			}
-			jobSqlConfig = fmt.Sprintf("%s %s LIMIT ? OFFSET ?", baseSQL, orderStr)
-			params = append(params, pageLimit, pageSize)
-			jobSqlTotalConfig = fmt.Sprintf("SELECT count(*) FROM %s_job_table", clusterName) // 总的作业条数
-		} else {
-			// 不分页的情况 没有搜索的情况
-			jobSqlConfig = fmt.Sprintf("%s %s", baseSQL, orderStr)
-			jobSqlTotalConfig = fmt.Sprintf("SELECT count(*) FROM %s_job_table", clusterName) // 总的作业条数
+func (s *ServerJob) QueryJobTimeLimit(ctx context.Context, in *pb.QueryJobTimeLimitRequest) (*pb.QueryJobTimeLimitResponse, error) {
+	caller.Logger.Infof("Received request QueryJobTimeLimit: %v", in)
+	// 通过jobId来查找未结束的作业信息
+	job, err := caller.GetBackend(ctx).GetJob(in.JobId)
+	if err != nil || (job.State != "PENDING" && job.State != "RUNNING" && job.State != "SUSPENDED") {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "JOB_NOT_FOUND",
 		}
+		st := status.New(codes.NotFound, "The job does not exist.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("QueryJobTimeLimit failed: %v", st.Err())
+		return nil, st.Err()
 	}
-	caller.Logger.Tracef("GetJobs sql: %v, params: %v", jobSqlConfig, params)
-	rows, err := caller.DB.Query(jobSqlConfig, params...)
-	if err != nil {
+	return &pb.QueryJobTimeLimitResponse{TimeLimitMinutes: uint64(job.TimeLimitMinutes)}, nil
+}
+
+func (s *ServerJob) ChangeJobTimeLimit(ctx context.Context, in *pb.ChangeJobTimeLimitRequest) (*pb.ChangeJobTimeLimitResponse, error) {
+	// 记录日志
+	caller.Logger.Infof("Received request ChangeJobTimeLimit: %v", in)
+	// 从slurm的运行时取作业的信息
+	if !jobInQueue(ctx, in.JobId) {
 		errInfo := &errdetails.ErrorInfo{
-			Reason: "SQL_QUERY_FAILED",
+			Reason: "JOB_NOT_FOUND",
 		}
-		st := status.New(codes.Internal, err.Error())
+		st := status.New(codes.NotFound, "The job does not exist.")
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("GetJobs Failed: %v", st.Err())
+		caller.Logger.Errorf("ChangeJobTimeLimit failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	defer rows.Close()
-
-	pendingCmd := "squeue -t pending --noheader --format='%i %R' | tr '\n' ','"
-	pendingResult, err := utils.RunCommand(pendingCmd)
-	if err != nil || utils.CheckSlurmStatus(pendingResult) {
+	if err := caller.GetBackend(ctx).ChangeJobTimeLimit(in.JobId, in.DeltaMinutes); err != nil {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "COMMAND_EXEC_FAILED",
 		}
-		st := status.New(codes.Internal, "slurmctld down.")
+		st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("GetJobs Failed: %v", st.Err())
+		caller.Logger.Errorf("ChangeJobTimeLimit failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	if len(pendingResult) != 0 {
-		pendingMap = utils.GetMapInfo(pendingResult)
-	}
-	for rows.Next() {
-		err := rows.Scan(&account, &idUser, &cpusReq, &jobName, &jobId, &idQos, &memReq, &nodeList, &nodesAlloc, &partition, &state, &timeLimitMinutes, &submitTime, &startTime, &endTime, &timeSuspended, &gresUsed, &workingDirectory, &tresAlloc, &tresReq)
-		if err != nil {
-			caller.Logger.Errorf("GetJobs Failed: %v ", err)
-			continue
-		}
-		caller.Logger.Tracef("GetJobs account: %v, idUser: %v, cpusReq: %v, jobName: %v, jobId: %v, idQos: %v, memReq: %v, nodeList: %v, nodesAlloc: %v, partition: %v, state: %v, "+
-			"timeLimitMinutes: %v, submitTime: %v, startTime: %v, endTime: %v, timeSuspended: %v, gresUsed: %v, workingDirectory: %v, tresAlloc: %v, tresReq: %v", account, idUser,
-			cpusReq, jobName, jobId, idQos, memReq, nodeList, nodesAlloc, partition, state, timeLimitMinutes, submitTime, startTime, endTime, timeSuspended, gresUsed, workingDirectory, tresAlloc, tresReq)
-		var (
-			elapsedSeconds     int64
-			reason             string
-			stdoutPath         string
-			stderrPath         string
-			gpusAlloc          int32
-			cpusAlloc          int32
-			memAllocMb         int64
-			nodeReq            int32
-			nodesAllocTemp     int32
-			nodeListTemp       string
-			startTimeTimestamp *timestamppb.Timestamp
-			endTimeTimestamp   *timestamppb.Timestamp
-		)
-		stateString = utils.ChangeState(state)
-		submitTimeTimestamp := &timestamppb.Timestamp{Seconds: int64(time.Unix(submitTime, 0).Unix())}
-		if startTime != 0 {
-			startTimeTimestamp = &timestamppb.Timestamp{Seconds: int64(time.Unix(startTime, 0).Unix())}
-		}
-		if endTime != 0 {
-			endTimeTimestamp = &timestamppb.Timestamp{Seconds: int64(time.Unix(endTime, 0).Unix())}
-		}
-
-		userName, _ := utils.GetUserNameByUid(idUser)
-
-		qosSqlconfig := "SELECT name FROM qos_table WHERE id = ? AND deleted = 0"
-		caller.DB.QueryRow(qosSqlconfig, idQos).Scan(&qosName)
-
-		nodesAllocTemp = nodesAlloc
-		nodeListTemp = nodeList
-		if state == 0 || state == 2 {
-			if _, ok := pendingMap[jobId]; ok {
-				reason = pendingMap[jobId]
-			} else {
-				getReasonCmdTmp := fmt.Sprintf("squeue -j %d --noheader ", jobId)
-				getReasonCmd := getReasonCmdTmp + " --format='%R'"
-				reason, err = utils.RunCommand(getReasonCmd)
-
-				re := regexp.MustCompile(`Job's account not permitted to use this partition`)
-				match := re.FindString(reason)
-				if match != "" {
-					reason = match
-				}
+	return &pb.ChangeJobTimeLimitResponse{}, nil
+}
 
-				if utils.CheckSlurmStatus(reason) {
-					errInfo := &errdetails.ErrorInfo{
-						Reason: "SLURMCTLD_FAILED",
-					}
-					st := status.New(codes.Internal, "slurmctld down.")
-					st, _ = st.WithDetails(errInfo)
-					caller.Logger.Errorf("GetJobs Failed: %v ", st.Err())
-					return nil, st.Err()
-				}
+// 根据作业状态计算作业已分配的资源和运行时间, 排队的作业没有分配资源
+func jobAllocInfo(job *backend.Job) (cpusAlloc int32, memAllocMb int64, gpusAlloc int32, elapsedSeconds int64) {
+	if job.State == "PENDING" {
+		return 0, 0, 0, 0
+	}
+	return job.CpusAlloc, job.MemAllocMb, job.GpusAlloc, job.ElapsedSeconds(time.Now().Unix())
+}
 
-				if err != nil {
-					logrus.Infof("Received err job info: %v", jobId)
-					continue // 一般是数据库中有数据但是squeue中没有数据导致执行命令行失败
-				}
-			}
+// 只保留请求中指定的字段, fields为空时返回全部字段
+func selectJobFields(jobInfo *pb.JobInfo, fields []string) *pb.JobInfo {
+	if len(fields) == 0 {
+		return jobInfo
+	}
+	subJobInfo := &pb.JobInfo{}
+	for _, field := range fields {
+		switch field {
+		case "job_id":
+			subJobInfo.JobId = jobInfo.JobId
+		case "name":
+			subJobInfo.Name = jobInfo.Name
+		case "account":
+			subJobInfo.Account = jobInfo.Account
+		case "user":
+			subJobInfo.User = jobInfo.User
+		case "partition":
+			subJobInfo.Partition = jobInfo.Partition
+		case "qos":
+			subJobInfo.Qos = jobInfo.Qos
+		case "state":
+			subJobInfo.State = jobInfo.State
+		case "cpus_req":
+			subJobInfo.CpusReq = jobInfo.CpusReq
+		case "mem_req_mb":
+			subJobInfo.MemReqMb = jobInfo.MemReqMb
+		case "nodes_req":
+			subJobInfo.NodesReq = jobInfo.NodesReq
+		case "time_limit_minutes":
+			subJobInfo.TimeLimitMinutes = jobInfo.TimeLimitMinutes
+		case "submit_time":
+			subJobInfo.SubmitTime = jobInfo.SubmitTime
+		case "working_directory":
+			subJobInfo.WorkingDirectory = jobInfo.WorkingDirectory
+		case "stdout_path":
+			subJobInfo.StdoutPath = jobInfo.StdoutPath
+		case "stderr_path":
+			subJobInfo.StderrPath = jobInfo.StderrPath
+		case "start_time":
+			subJobInfo.StartTime = jobInfo.StartTime
+		case "elapsed_seconds":
+			subJobInfo.ElapsedSeconds = jobInfo.ElapsedSeconds
+		case "reason":
+			subJobInfo.Reason = jobInfo.Reason
+		case "node_list":
+			subJobInfo.NodeList = jobInfo.NodeList
+		case "gpus_alloc":
+			subJobInfo.GpusAlloc = jobInfo.GpusAlloc
+		case "cpus_alloc":
+			subJobInfo.CpusAlloc = jobInfo.CpusAlloc
+		case "mem_alloc_mb":
+			subJobInfo.MemAllocMb = jobInfo.MemAllocMb
+		case "nodes_alloc":
+			subJobInfo.NodesAlloc = jobInfo.NodesAlloc
+		case "end_time":
+			subJobInfo.EndTime = jobInfo.EndTime
+		case "array_job_id":
+			subJobInfo.ArrayJobId = jobInfo.ArrayJobId
+		case "array_task_id":
+			subJobInfo.ArrayTaskId = jobInfo.ArrayTaskId
+		case "exit_code":
+			subJobInfo.ExitCode = jobInfo.ExitCode
+		case "exit_signal":
+			subJobInfo.ExitSignal = jobInfo.ExitSignal
+		case "derived_exit_code":
+			subJobInfo.DerivedExitCode = jobInfo.DerivedExitCode
+		case "derived_exit_signal":
+			subJobInfo.DerivedExitSignal = jobInfo.DerivedExitSignal
+		case "dependency":
+			subJobInfo.Dependency = jobInfo.Dependency
+		case "scheduled_start_time":
+			subJobInfo.ScheduledStartTime = jobInfo.ScheduledStartTime
+		case "pending_reason":
+			subJobInfo.PendingReason = jobInfo.PendingReason
+		case "het_job_id":
+			subJobInfo.HetJobId = jobInfo.HetJobId
+		case "het_job_offset":
+			subJobInfo.HetJobOffset = jobInfo.HetJobOffset
+		case "het_components":
+			subJobInfo.HetComponents = jobInfo.HetComponents
+		}
+	}
+	return subJobInfo
+}
 
-			if state == 0 {
-				cpusAlloc = 0
-				memAllocMb = 0
-				nodeReq = int32(utils.GetResInfoNumFromTresInfo(tresReq, nodeTresId))
-				elapsedSeconds = 0
-				gpusAlloc = 0
-			} else {
-				cpusAlloc = int32(utils.GetResInfoNumFromTresInfo(tresAlloc, cpuTresId))
-				memAllocMb = int64(utils.GetResInfoNumFromTresInfo(tresAlloc, memTresId))
-				nodeReq = nodesAlloc
-				elapsedSeconds = time.Now().Unix() - startTime
-				if output == "cons_tres" || output == "cons_res" {
-					if len(gpuIdList) == 0 {
-						gpusAlloc = 0
-					} else {
-						gpusAlloc = utils.GetGpuAllocsFromGpuIdList(tresAlloc, gpuIdList)
-					}
-				} else {
-					gpusAlloc = 0
-				}
-			}
-		} else if state == 1 {
-			// 新加逻辑
-			getReasonCmdTmp := fmt.Sprintf("squeue -j %d --noheader ", jobId)
-			getReasonCmd := getReasonCmdTmp + " --format='%R'"
-			reason, err := utils.RunCommand(getReasonCmd)
-			if utils.CheckSlurmStatus(reason) {
-				errInfo := &errdetails.ErrorInfo{
-					Reason: "SLURMCTLD_FAILED",
-				}
-				st := status.New(codes.Internal, "slurmctld down.")
-				st, _ = st.WithDetails(errInfo)
-				caller.Logger.Errorf("GetJobs failed: %v", st.Err())
-				return nil, st.Err()
-			}
+// 输出文件相对于工作目录的路径, 和JobInfo中的定义保持一致
+func relativeOutputPath(workDir string, path string) string {
+	if path == "" || !filepath.IsAbs(workDir) {
+		return path
+	}
+	if rel, err := filepath.Rel(workDir, path); err == nil {
+		return rel
+	}
+	return path
+}
 
-			if err != nil {
-				continue // 一般是数据库中有数据但是squeue中没有数据导致执行命令行失败
-			}
+// 是否需要返回输出文件, 获取运行中作业的输出文件需要额外执行命令
+func needJobOutput(fields []string) bool {
+	return len(fields) == 0 || slices.Contains(fields, "stdout_path") || slices.Contains(fields, "stderr_path")
+}
 
-			reason = "Running"
-			cpusAlloc = int32(utils.GetResInfoNumFromTresInfo(tresAlloc, cpuTresId))
-			memAllocMb = int64(utils.GetResInfoNumFromTresInfo(tresAlloc, memTresId))
-			nodeReq = nodesAlloc
-			elapsedSeconds = time.Now().Unix() - startTime
-			if output == "cons_tres" || output == "cons_res" {
-				if len(gpuIdList) == 0 {
-					gpusAlloc = 0
-				} else {
-					gpusAlloc = utils.GetGpuAllocsFromGpuIdList(tresAlloc, gpuIdList)
-				}
-			} else {
-				gpusAlloc = 0
-			}
-		} else {
-			reason = "end of job"
-			cpusAlloc = int32(utils.GetResInfoNumFromTresInfo(tresAlloc, cpuTresId))
-			memAllocMb = int64(utils.GetResInfoNumFromTresInfo(tresAlloc, memTresId))
-			nodeReq = nodesAlloc
-			if startTime != 0 && endTime != 0 {
-				elapsedSeconds = endTime - startTime
+// 获取slurmctld中全部作业的输出文件, 失败时只记录日志, 不影响作业查询
+func listJobOutputs(ctx context.Context, fields []string) map[uint32]*backend.JobOutput {
+	if !needJobOutput(fields) {
+		return nil
+	}
+	jobOutputs, err := caller.GetBackend(ctx).ListJobOutputs()
+	if err != nil {
+		caller.Logger.Warnf("List job outputs failed: %v", err)
+		return nil
+	}
+	return jobOutputs
+}
+
+// 记账数据库中的作业转换为JobInfo, 未结束的作业需要传入从slurmctld获取的输出文件
+func jobInfoFromJob(job *backend.Job, reason string, jobOutput *backend.JobOutput) *pb.JobInfo {
+	var (
+		startTimeTimestamp *timestamppb.Timestamp
+		endTimeTimestamp   *timestamppb.Timestamp
+		exitCode           *int32
+		exitSignal         *int32
+		derivedExitCode    *int32
+		derivedExitSignal  *int32
+	)
+	stdoutPath := relativeOutputPath(job.WorkingDirectory, job.StdoutPath)
+	stderrPath := relativeOutputPath(job.WorkingDirectory, job.StderrPath)
+	if jobOutput != nil {
+		stdoutPath = relativeOutputPath(job.WorkingDirectory, jobOutput.StdoutPath)
+		stderrPath = relativeOutputPath(job.WorkingDirectory, jobOutput.StderrPath)
+	}
+	// 只有结束的作业才有退出状态
+	switch job.State {
+	case "PENDING", "RUNNING", "SUSPENDED":
+	default:
+		exitCode, exitSignal = &job.ExitCode, &job.ExitSignal
+		derivedExitCode, derivedExitSignal = &job.DerivedExitCode, &job.DerivedExitSignal
+	}
+	cpusAlloc, memAllocMb, gpusAlloc, elapsedSeconds := jobAllocInfo(job)
+	nodeList := job.NodeList
+	nodesAlloc := job.NodesAlloc
+	if job.StartTime != 0 {
+		startTimeTimestamp = &timestamppb.Timestamp{Seconds: job.StartTime}
+	}
+	if job.EndTime != 0 {
+		endTimeTimestamp = &timestamppb.Timestamp{Seconds: job.EndTime}
+	}
+	arrayJobId, arrayTaskId := arrayFields(job.ArrayJobId, job.ArrayTaskId)
+	hetJobId, hetJobOffset := hetFields(job.HetJobId, job.HetJobOffset)
+	// 异构作业的组件和组件0的状态相同, 输出文件也由组件0决定
+	var hetComponents []*pb.JobInfo
+	for _, component := range job.HetComponents {
+		hetComponents = append(hetComponents, jobInfoFromJob(component, reason, nil))
+	}
+	return &pb.JobInfo{
+		JobId:             job.JobId,
+		Name:              job.Name,
+		Account:           job.Account,
+		User:              job.User,
+		Partition:         job.Partition,
+		Qos:               job.Qos,
+		State:             job.State,
+		CpusReq:           job.CpusReq,
+		MemReqMb:          job.MemReqMb,
+		NodesReq:          job.NodesReq,
+		TimeLimitMinutes:  job.TimeLimitMinutes,
+		SubmitTime:        &timestamppb.Timestamp{Seconds: job.SubmitTime},
+		WorkingDirectory:  job.WorkingDirectory,
+		StdoutPath:        &stdoutPath,
+		StderrPath:        &stderrPath,
+		StartTime:         startTimeTimestamp,
+		EndTime:           endTimeTimestamp,
+		ElapsedSeconds:    &elapsedSeconds,
+		Reason:            &reason,
+		NodeList:          &nodeList,
+		GpusAlloc:         &gpusAlloc,
+		CpusAlloc:         &cpusAlloc,
+		MemAllocMb:        &memAllocMb,
+		NodesAlloc:        &nodesAlloc,
+		ArrayJobId:        arrayJobId,
+		ArrayTaskId:       arrayTaskId,
+		ExitCode:          exitCode,
+		ExitSignal:        exitSignal,
+		DerivedExitCode:   derivedExitCode,
+		DerivedExitSignal: derivedExitSignal,
+		HetJobId:          hetJobId,
+		HetJobOffset:      hetJobOffset,
+		HetComponents:     hetComponents,
+	}
+}
+
+func (s *ServerJob) GetJobById(ctx context.Context, in *pb.GetJobByIdRequest) (*pb.GetJobByIdResponse, error) {
+	var jobOutput *backend.JobOutput
+	caller.Logger.Infof("Received request GetJobById: %v", in)
+	// 根据jobid查询作业详细信息
+	job, err := caller.GetBackend(ctx).GetJob(in.JobId)
+	if err != nil {
+		if errors.Is(err, backend.ErrNotFound) {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "JOB_NOT_FOUND",
 			}
-			if output == "cons_tres" || output == "cons_res" {
-				if len(gpuIdList) == 0 {
-					gpusAlloc = 0
-				} else {
-					gpusAlloc = utils.GetGpuAllocsFromGpuIdList(tresAlloc, gpuIdList)
-				}
-			} else {
-				gpusAlloc = 0
+			st := status.New(codes.NotFound, "The job does not exist.")
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("Failed get job by id, error is: %v", st.Err())
+			return nil, st.Err()
+		}
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "COMMAND_EXEC_FAILED",
+		}
+		st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("Failed get job by id, error is: %v", st.Err())
+		return nil, st.Err()
+	}
+
+	// 未结束的作业从slurmctld中获取原因
+	var queueJob *backend.QueueJob
+	switch job.State {
+	case "PENDING", "SUSPENDED", "RUNNING":
+		queueJobs, err := caller.GetBackend(ctx).ListQueueJobs(&backend.QueueFilter{JobIds: []uint32{in.JobId}})
+		if err != nil {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "COMMAND_EXEC_FAILED",
 			}
+			st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("Failed get job by id, error is: %v", st.Err())
+			return nil, st.Err()
 		}
-		// 低版本slurm mem_req 默认值转换为0
-		if memReq > 4000000000 {
-			memReq = 0
-		}
-		if len(fields) == 0 {
-			jobInfo = append(jobInfo, &pb.JobInfo{
-				JobId:            uint32(jobId),
-				Name:             jobName,
-				Account:          account,
-				User:             userName,
-				Partition:        partition,
-				Qos:              qosName,
-				State:            stateString,
-				CpusReq:          cpusReq,
-				MemReqMb:         int64(memReq),
-				TimeLimitMinutes: timeLimitMinutes,
-				SubmitTime:       submitTimeTimestamp,
-				WorkingDirectory: workingDirectory,
-				NodeList:         &nodeListTemp,
-				StartTime:        startTimeTimestamp,
-				EndTime:          endTimeTimestamp,
-				StdoutPath:       &stdoutPath,
-				StderrPath:       &stderrPath,
-				NodesReq:         nodeReq,
-				ElapsedSeconds:   &elapsedSeconds,
-				Reason:           &reason,
-				CpusAlloc:        &cpusAlloc,
-				MemAllocMb:       &memAllocMb,
-				GpusAlloc:        &gpusAlloc,
-				NodesAlloc:       &nodesAllocTemp,
-			})
-		} else {
-			subJobInfo := &pb.JobInfo{}
-			for _, field := range fields {
-				switch field {
-				case "job_id":
-					subJobInfo.JobId = uint32(jobId)
-				case "name":
-					subJobInfo.Name = jobName
-				case "account":
-					subJobInfo.Account = account
-				case "user":
-					subJobInfo.User = userName
-				case "partition":
-					subJobInfo.Partition = partition
-				case "qos":
-					subJobInfo.Qos = qosName
-				case "state":
-					subJobInfo.State = stateString
-				case "cpus_req":
-					subJobInfo.CpusReq = cpusReq
-				case "mem_req_mb":
-					subJobInfo.MemReqMb = int64(memReq)
-				case "nodes_req":
-					subJobInfo.NodesReq = nodeReq
-				case "time_limit_minutes":
-					subJobInfo.TimeLimitMinutes = timeLimitMinutes
-				case "submit_time":
-					subJobInfo.SubmitTime = submitTimeTimestamp
-				case "working_directory":
-					subJobInfo.WorkingDirectory = workingDirectory
-				case "stdout_path":
-					subJobInfo.StdoutPath = &stdoutPath
-				case "stderr_path":
-					subJobInfo.StderrPath = &stderrPath
-				case "start_time":
-					subJobInfo.StartTime = startTimeTimestamp
-				case "elapsed_seconds":
-					subJobInfo.ElapsedSeconds = &elapsedSeconds
-				case "reason":
-					subJobInfo.Reason = &reason
-				case "node_list":
-					subJobInfo.NodeList = &nodeListTemp
-				case "gpus_alloc":
-					subJobInfo.GpusAlloc = &gpusAlloc
-				case "cpus_alloc":
-					subJobInfo.CpusAlloc = &cpusAlloc
-				case "mem_alloc_mb":
-					subJobInfo.MemAllocMb = &memAllocMb
-				case "nodes_alloc":
-					subJobInfo.NodesAlloc = &nodesAllocTemp
-				case "end_time":
-					subJobInfo.EndTime = endTimeTimestamp
-				}
+		// 指定作业数组id时squeue会返回数组的全部任务
+		for _, j := range backend.MergeHetQueueJobs(queueJobs) {
+			if j.JobId == in.JobId {
+				queueJob = j
+				break
 			}
-			jobInfo = append(jobInfo, subJobInfo)
 		}
 	}
-	err = rows.Err()
-	if err != nil {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "SQL_QUERY_FAILED",
+	if queueJob != nil {
+		job = backend.OverlayQueueJob(job, queueJob)
+	}
+	reason, dependency := jobReason(job.State, queueJob)
+	// 未结束作业的输出文件从slurmctld中获取
+	if queueJob != nil && needJobOutput(in.Fields) {
+		if jobOutput, err = caller.GetBackend(ctx).GetJobOutput(in.JobId); err != nil {
+			caller.Logger.Warnf("Get job output failed: %v", err)
 		}
-		st := status.New(codes.Internal, err.Error())
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("GetJobs failed: %v", st.Err())
-		return nil, st.Err()
 	}
-	// 获取总的页数逻辑
-	if jobSqlTotalConfig != "" {
-		caller.DB.QueryRow(jobSqlTotalConfig, totalParams...).Scan(&count)
-		totalCount = uint32(count)
-		caller.Logger.Tracef("GetJobs GetJobsResponse is: %v", &pb.GetJobsResponse{Jobs: jobInfo, TotalCount: &totalCount})
-		return &pb.GetJobsResponse{Jobs: jobInfo, TotalCount: &totalCount}, nil
+
+	jobInfo := jobInfoFromJob(job, reason, jobOutput)
+	jobInfo.Dependency = dependency
+	jobInfo.ScheduledStartTime = scheduledStartTime(queueJob)
+	jobInfo.PendingReason = pendingReasonInfo(ctx, queueJob)
+	jobInfo = selectJobFields(jobInfo, in.Fields)
+	caller.Logger.Infof("GetJobByIdResponse: %v", jobInfo)
+	return &pb.GetJobByIdResponse{Job: jobInfo}, nil
+}
+
+// 作业的原因和依赖, 排队和挂起的作业从slurmctld中获取, 不在slurmctld中时原因为空
+func jobReason(state string, queueJob *backend.QueueJob) (string, *string) {
+	switch state {
+	case "PENDING", "SUSPENDED":
+		if queueJob == nil {
+			return "", nil
+		}
+		return pendingReason(queueJob.Reason), queueJobDependency(queueJob)
+	case "RUNNING":
+		return "Running", nil // 正在运行的作业的信息
 	}
-	caller.Logger.Tracef("GetJobs GetJobsResponse is: %v", &pb.GetJobsResponse{Jobs: jobInfo})
-	return &pb.GetJobsResponse{Jobs: jobInfo}, nil
+	return "end of job", nil // 结束状态的作业信息
 }
 
-func (s *ServerJob) SubmitJob(ctx context.Context, in *pb.SubmitJobRequest) (*pb.SubmitJobResponse, error) {
+// 排队作业预计开始的时间, 调度器还没有估计或者作业不在排队时返回nil
+func scheduledStartTime(job *backend.QueueJob) *timestamppb.Timestamp {
+	if job == nil || job.State != "PENDING" || job.ScheduledStartTime == 0 {
+		return nil
+	}
+	return &timestamppb.Timestamp{Seconds: job.ScheduledStartTime}
+}
+
+// 排队作业的依赖, 没有依赖时返回nil
+func queueJobDependency(job *backend.QueueJob) *string {
+	if job.Dependency == "" {
+		return nil
+	}
+	return &job.Dependency
+}
+
+// slurmctld中的作业转换为JobInfo
+func jobInfoFromQueueJob(ctx context.Context, job *backend.QueueJob, jobOutput *backend.JobOutput) *pb.JobInfo {
 	var (
-		scriptString = "#!/bin/bash\n"
-		name         string
-		homedir      string
+		stdoutPath     string
+		stderrPath     string
+		nodesAlloc     int32
+		reason         string
+		cpusAlloc      int32
+		elapsedSeconds int64
+		nodeList       string
+		timeLimit      int64
+		submitTime     int64
+		dependency     *string
 	)
-	caller.Logger.Infof("Received request SubmitJob: %v", in)
+	switch job.TimeLimitMinutes {
+	case -1: // INVALID 要另起逻辑
+		if dbJob, err := caller.GetBackend(ctx).GetJob(job.JobId); err == nil {
+			timeLimit = dbJob.TimeLimitMinutes
+		}
+	default:
+		timeLimit = job.TimeLimitMinutes
+	}
+	submitTime = job.SubmitTime
+	if submitTime == 0 {
+		submitTime = time.Now().Unix()
+	}
+	if job.State == "PENDING" {
+		reason = pendingReason(job.Reason) // 这个reason的值需要更新配置
+		nodeList = "None assigned"
+		dependency = queueJobDependency(job)
+	} else {
+		nodesAlloc = job.Nodes
+		reason = job.State
+		cpusAlloc = job.Cpus
+		elapsedSeconds = job.ElapsedSeconds
+		nodeList = job.NodeList
+	}
+	if jobOutput != nil {
+		stdoutPath = relativeOutputPath(job.WorkingDirectory, jobOutput.StdoutPath)
+		stderrPath = relativeOutputPath(job.WorkingDirectory, jobOutput.StderrPath)
+	}
+	// 在正在运行中的作业添加gpu分配逻辑
+	var gpusAlloc int32
+	if nodesAlloc != 0 {
+		gpusAlloc = job.GpusAlloc()
+	}
+	arrayJobId, arrayTaskId := arrayFields(job.ArrayJobId, job.ArrayTaskId)
+	hetJobId, hetJobOffset := hetFields(job.HetJobId, job.HetJobOffset)
+	var hetComponents []*pb.JobInfo
+	for _, component := range job.HetComponents {
+		hetComponents = append(hetComponents, jobInfoFromQueueJob(ctx, component, nil))
+	}
+	return &pb.JobInfo{
+		JobId:              job.JobId,
+		Name:               job.Name,
+		Account:            job.Account,
+		User:               job.User,
+		Partition:          job.Partition,
+		Qos:                job.Qos,
+		State:              job.State,
+		TimeLimitMinutes:   timeLimit,
+		WorkingDirectory:   job.WorkingDirectory,
+		StdoutPath:         &stdoutPath,
+		StderrPath:         &stderrPath,
+		Reason:             &reason,
+		CpusAlloc:          &cpusAlloc,
+		NodesAlloc:         &nodesAlloc,
+		ElapsedSeconds:     &elapsedSeconds,
+		NodeList:           &nodeList,
+		SubmitTime:         &timestamppb.Timestamp{Seconds: submitTime},
+		GpusAlloc:          &gpusAlloc,
+		ArrayJobId:         arrayJobId,
+		ArrayTaskId:        arrayTaskId,
+		Dependency:         dependency,
+		HetJobId:           hetJobId,
+		HetJobOffset:       hetJobOffset,
+		HetComponents:      hetComponents,
+		ScheduledStartTime: scheduledStartTime(job),
+		PendingReason:      pendingReasonInfo(ctx, job),
+	}
+}
+
+// 键集分页时默认的每页作业数
+const defaultJobPageSize = 100
+
+// 根据筛选条件生成作业查询
+func jobQuery(filter *pb.GetJobsRequest_Filter, sort *pb.SortInfo) *backend.JobQuery {
+	query := &backend.JobQuery{Order: "ASC"} // 默认就是升序排序
+	if sort != nil {
+		// 排序和分页都在后端完成
+		query.Order = sort.GetOrder().String()
+		query.SortField = sort.GetField()
+	}
+	if filter != nil {
+		query.Users = filter.Users
+		query.Accounts = filter.Accounts
+		query.States = filter.States
+		if filter.EndTime != nil {
+			query.EndTimeStart = filter.EndTime.StartTime.GetSeconds()
+			query.EndTimeEnd = filter.EndTime.EndTime.GetSeconds()
+		}
+		if filter.SubmitTime != nil {
+			query.SubmitTimeStart = filter.SubmitTime.StartTime.GetSeconds()
+			query.SubmitTimeEnd = filter.SubmitTime.EndTime.GetSeconds()
+		}
+		// 按作业名和作业id来搜索作业
+		query.JobId = filter.JobId
+		query.JobName = filter.JobName
+		query.ArrayJobId = filter.ArrayJobId
+	}
+	return query
+}
+
+// 查询计划得到的作业转换为JobInfo
+func plannedJobInfos(ctx context.Context, jobs []*backend.PlannedJob, fields []string) []*pb.JobInfo {
+	var jobInfo []*pb.JobInfo
+	// 只有结果中有未结束的作业时才需要获取输出文件
+	var jobOutputs map[uint32]*backend.JobOutput
+	for _, job := range jobs {
+		if job.Queue != nil {
+			jobOutputs = listJobOutputs(ctx, fields)
+			break
+		}
+	}
+	for _, job := range jobs {
+		reason, dependency := jobReason(job.State, job.Queue)
+		info := jobInfoFromJob(job.Job, reason, jobOutputs[job.JobId])
+		info.Dependency = dependency
+		info.ScheduledStartTime = scheduledStartTime(job.Queue)
+		info.PendingReason = pendingReasonInfo(ctx, job.Queue)
+		jobInfo = append(jobInfo, selectJobFields(info, fields))
+	}
+	return jobInfo
+}
+
+func invalidSortFieldError(rpc string, field string) error {
+	errInfo := &errdetails.ErrorInfo{
+		Reason: "INVALID_SORT_FIELD",
+	}
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("Jobs can not be sorted by %s.", field))
+	st, _ = st.WithDetails(errInfo)
+	caller.Logger.Errorf("%s Failed: %v", rpc, st.Err())
+	return st.Err()
+}
+
+func invalidPageTokenError(rpc string) error {
+	errInfo := &errdetails.ErrorInfo{
+		Reason: "INVALID_PAGE_TOKEN",
+	}
+	st := status.New(codes.InvalidArgument, "The page token is invalid.")
+	st, _ = st.WithDetails(errInfo)
+	caller.Logger.Errorf("%s Failed: %v", rpc, st.Err())
+	return st.Err()
+}
+
+func queryJobsFailedError(rpc string, err error) error {
+	errInfo := &errdetails.ErrorInfo{
+		Reason: "SQL_QUERY_FAILED",
+	}
+	st := status.New(codes.Internal, err.Error())
+	st, _ = st.WithDetails(errInfo)
+	caller.Logger.Errorf("%s Failed: %v", rpc, st.Err())
+	return st.Err()
+}
+
+func (s *ServerJob) GetJobs(ctx context.Context, in *pb.GetJobsRequest) (*pb.GetJobsResponse, error) {
+	var (
+		jobs       []*backend.PlannedJob
+		totalCount uint32
+		next       *backend.JobCursor
+		err        error
+	)
+	caller.Logger.Infof("Received request GetJobs: %v", in)
+	if !backend.IsJobSortField(in.Sort.GetField()) {
+		return nil, invalidSortFieldError("GetJobs", in.Sort.GetField())
+	}
+	query := jobQuery(in.Filter, in.Sort)
+	query.SkipCount = in.SkipTotalCount
+	pageSize := in.PageInfo.GetPageSize()
+	caller.Logger.Tracef("GetJobs query: %+v", query)
+	// 数据库和slurmctld中的作业统一由查询计划合并, 第一页和带令牌的请求使用键集分页
+	if in.PageToken != nil || (pageSize != 0 && in.PageInfo.Page <= 1) {
+		var cursor *backend.JobCursor
+		if in.GetPageToken() != "" {
+			if cursor, err = backend.DecodeJobCursor(in.GetPageToken()); err != nil {
+				return nil, invalidPageTokenError("GetJobs")
+			}
+		}
+		if pageSize == 0 {
+			pageSize = defaultJobPageSize
+		}
+		query.Limit = pageSize
+		jobs, next, totalCount, err = backend.PlanJobsAfter(caller.GetBackend(ctx), query, cursor)
+	} else {
+		if pageSize != 0 {
+			query.Limit = pageSize
+			query.Offset = uint64(in.PageInfo.Page-1) * pageSize
+		}
+		jobs, totalCount, err = backend.PlanJobs(caller.GetBackend(ctx), query)
+	}
+	if errors.Is(err, backend.ErrInvalidCursor) {
+		return nil, invalidPageTokenError("GetJobs")
+	}
+	if err != nil {
+		return nil, queryJobsFailedError("GetJobs", err)
+	}
+
+	resp := &pb.GetJobsResponse{Jobs: plannedJobInfos(ctx, jobs, in.Fields)}
+	if !in.SkipTotalCount {
+		resp.TotalCount = &totalCount
+	}
+	if next != nil {
+		token := next.Encode()
+		resp.NextPageToken = &token
+	}
+	caller.Logger.Tracef("GetJobs GetJobsResponse is: %v", resp)
+	return resp, nil
+}
+
+// 默认每次发送的作业数
+const defaultStreamBatchSize = 1000
+
+func (s *ServerJob) StreamJobs(in *pb.StreamJobsRequest, stream pb.JobService_StreamJobsServer) error {
+	ctx := stream.Context()
+	caller.Logger.Infof("Received request StreamJobs: %v", in)
+	if !backend.IsJobSortField(in.Sort.GetField()) {
+		return invalidSortFieldError("StreamJobs", in.Sort.GetField())
+	}
+	query := jobQuery(in.Filter, in.Sort)
+	query.SkipCount = true
+	query.Limit = defaultStreamBatchSize
+	if in.BatchSize != 0 {
+		query.Limit = uint64(in.BatchSize)
+	}
+	// 逐页查询并发送, 不需要一次性加载全部作业
+	var cursor *backend.JobCursor
+	for {
+		jobs, next, _, err := backend.PlanJobsAfter(caller.GetBackend(ctx), query, cursor)
+		if err != nil {
+			return queryJobsFailedError("StreamJobs", err)
+		}
+		if len(jobs) != 0 {
+			if err := stream.Send(&pb.StreamJobsResponse{Jobs: plannedJobInfos(ctx, jobs, in.Fields)}); err != nil {
+				return err
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// 检查提交作业的参数, metadata会附加到错误信息中
+func checkSubmitJobRequest(in *pb.SubmitJobRequest, metadata map[string]string) *status.Status {
 	resultAcct := utils.CheckAccountOrUserStrings(in.Account)
 	resultUser := utils.CheckAccountOrUserStrings(in.UserId)
 	if !resultAcct || !resultUser {
 		errInfo := &errdetails.ErrorInfo{
-			Reason: "ACCOUNT_USER_CONTAIN_ILLEGAL_CHARACTERS",
+			Reason:   "ACCOUNT_USER_CONTAIN_ILLEGAL_CHARACTERS",
+			Metadata: metadata,
 		}
 		st := status.New(codes.Internal, "The account or username contains illegal characters.")
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("SubmitJob failed: %v", st.Err())
-		return nil, st.Err()
+		return st
 	}
-	// 检查账户是否在slurm中
-	userSqlConfig := "SELECT name FROM user_table WHERE deleted = 0 AND name = ?"
-	err := caller.DB.QueryRow(userSqlConfig, in.UserId).Scan(&name)
-	if err != nil {
+	if in.Array != nil && !utils.CheckArraySpec(*in.Array) {
 		errInfo := &errdetails.ErrorInfo{
-			Reason: "USER_NOT_FOUND",
+			Reason:   "INVALID_ARRAY_SPEC",
+			Metadata: metadata,
 		}
-		message := fmt.Sprintf("%s does not exists.", in.UserId)
-		st := status.New(codes.NotFound, message)
+		st := status.New(codes.InvalidArgument, "The job array index specification is invalid.")
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("SubmitJob failed: %v", st.Err())
-		return nil, st.Err()
+		return st
+	}
+	if len(in.HetComponents) != 0 {
+		var message string
+		if in.Array != nil {
+			message = "A heterogeneous job cannot be a job array."
+		}
+		for _, component := range in.HetComponents {
+			if component.NodeCount == 0 || component.CoreCount == 0 {
+				message = "The node count and core count of a heterogeneous job component must be positive."
+			}
+		}
+		if message != "" {
+			errInfo := &errdetails.ErrorInfo{
+				Reason:   "INVALID_HET_JOB",
+				Metadata: metadata,
+			}
+			st := status.New(codes.InvalidArgument, message)
+			st, _ = st.WithDetails(errInfo)
+			return st
+		}
+	}
+	if _, err := utils.DependencyOption(in.Dependencies, in.DependencyAny); err != nil {
+		return invalidDependencyStatus(err, metadata)
 	}
+	return nil
+}
+
+// 依赖不合法时的错误
+func invalidDependencyStatus(err error, metadata map[string]string) *status.Status {
+	errInfo := &errdetails.ErrorInfo{
+		Reason:   "INVALID_DEPENDENCY",
+		Metadata: metadata,
+	}
+	st := status.New(codes.InvalidArgument, err.Error())
+	st, _ = st.WithDetails(errInfo)
+	return st
+}
 
+// 异构作业的组件, 没有指定的分区和qos使用作业的
+func hetJobComponent(in *pb.SubmitJobRequest, component *pb.HetJobComponent) *pb.HetJobComponent {
+	merged := proto.Clone(component).(*pb.HetJobComponent)
+	if merged.Partition == "" {
+		merged.Partition = in.Partition
+	}
+	if merged.Qos == nil {
+		merged.Qos = in.Qos
+	}
+	return merged
+}
+
+// 拼接提交作业的batch脚本
+func jobScript(in *pb.SubmitJobRequest) (string, error) {
+	var (
+		scriptString = "#!/bin/bash\n"
+		homedir      string
+	)
 	modulepath := caller.ConfigValue.Modulepath.Path
-	// 拼接提交作业的batch脚本
+	// 异构作业的组件0使用作业的选项, 其他组件的选项放在#SBATCH hetjob之后
+	component := &pb.HetJobComponent{Partition: in.Partition, Qos: in.Qos, NodeCount: in.NodeCount, CoreCount: in.CoreCount, GpuCount: in.GpuCount}
+	if len(in.HetComponents) != 0 {
+		component = hetJobComponent(in, in.HetComponents[0])
+	}
 	scriptString += "#SBATCH " + "-A " + in.Account + "\n"
-	scriptString += "#SBATCH " + "--partition=" + in.Partition + "\n"
-	if in.Qos != nil {
-		scriptString += "#SBATCH " + "--qos=" + *in.Qos + "\n"
+	scriptString += "#SBATCH " + "--partition=" + component.Partition + "\n"
+	if component.Qos != nil {
+		scriptString += "#SBATCH " + "--qos=" + *component.Qos + "\n"
 	}
 	scriptString += "#SBATCH " + "-J " + in.JobName + "\n"
-	scriptString += "#SBATCH " + "--nodes=" + strconv.Itoa(int(in.NodeCount)) + "\n"
-	scriptString += "#SBATCH " + "-c " + strconv.Itoa(int(in.CoreCount)) + "\n"
+	scriptString += "#SBATCH " + "--nodes=" + strconv.Itoa(int(component.NodeCount)) + "\n"
+	scriptString += "#SBATCH " + "-c " + strconv.Itoa(int(component.CoreCount)) + "\n"
 	if in.TimeLimitMinutes != nil {
 		scriptString += "#SBATCH " + "--time=" + strconv.Itoa(int(*in.TimeLimitMinutes)) + "\n"
 	}
@@ -1205,8 +1099,18 @@ func (s *ServerJob) SubmitJob(ctx context.Context, in *pb.SubmitJobRequest) (*pb
 	// if in.MemoryMb != nil {
 	// 	scriptString += "#SBATCH " + "--mem=" + strconv.Itoa(int(*in.MemoryMb)) + "MB" + "\n"
 	// }
-	if in.GpuCount != 0 {
-		scriptString += "#SBATCH " + "--gres=gpu:" + strconv.Itoa(int(in.GpuCount)) + "\n"
+	if component.GpuCount != 0 {
+		scriptString += "#SBATCH " + "--gres=gpu:" + strconv.Itoa(int(component.GpuCount)) + "\n"
+	}
+	if in.Array != nil {
+		scriptString += "#SBATCH " + "--array=" + *in.Array + "\n"
+	}
+	dependency, err := utils.DependencyOption(in.Dependencies, in.DependencyAny)
+	if err != nil {
+		return "", err
+	}
+	if dependency != "" {
+		scriptString += "#SBATCH " + "--dependency=" + dependency + "\n"
 	}
 
 	if len(in.ExtraOptions) != 0 {
@@ -1214,34 +1118,244 @@ func (s *ServerJob) SubmitJob(ctx context.Context, in *pb.SubmitJobRequest) (*pb
 			scriptString += "#SBATCH " + extraVale + "\n"
 		}
 	}
+	for _, extraValue := range component.ExtraOptions {
+		scriptString += "#SBATCH " + extraValue + "\n"
+	}
+	for i := 1; i < len(in.HetComponents); i++ {
+		component = hetJobComponent(in, in.HetComponents[i])
+		scriptString += "#SBATCH hetjob\n"
+		scriptString += "#SBATCH " + "-A " + in.Account + "\n"
+		scriptString += "#SBATCH " + "--partition=" + component.Partition + "\n"
+		if component.Qos != nil {
+			scriptString += "#SBATCH " + "--qos=" + *component.Qos + "\n"
+		}
+		scriptString += "#SBATCH " + "--nodes=" + strconv.Itoa(int(component.NodeCount)) + "\n"
+		scriptString += "#SBATCH " + "-c " + strconv.Itoa(int(component.CoreCount)) + "\n"
+		if in.TimeLimitMinutes != nil {
+			scriptString += "#SBATCH " + "--time=" + strconv.Itoa(int(*in.TimeLimitMinutes)) + "\n"
+		}
+		if component.GpuCount != 0 {
+			scriptString += "#SBATCH " + "--gres=gpu:" + strconv.Itoa(int(component.GpuCount)) + "\n"
+		}
+		for _, extraValue := range component.ExtraOptions {
+			scriptString += "#SBATCH " + extraValue + "\n"
+		}
+	}
 
 	modulepathString := fmt.Sprintf("source %s", modulepath) // 改成从配置文件中获取profile文件路径信息
 	scriptString += "\n" + modulepathString + "\n"
 
 	scriptString += in.Script
-	// 提交作业
+	return scriptString, nil
+}
+
+// 用sbatch --test-only检查作业, slurm拒绝作业时在结果中返回原因而不是错误
+func dryRunJob(ctx context.Context, rpc string, user string, script string) (*pb.DryRunResult, error) {
+	estimate, err := caller.GetBackend(ctx).TestSubmitJob(user, script)
+	if err != nil {
+		if errors.Is(err, backend.ErrNotSupported) {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "NOT_SUPPORTED",
+			}
+			st := status.New(codes.Unimplemented, err.Error())
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
+			return nil, st.Err()
+		}
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "SBATCH_FAILED",
+		}
+		st := status.New(codes.Unknown, err.Error())
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
+		return nil, st.Err()
+	}
+	if estimate.RejectReason != "" {
+		return &pb.DryRunResult{Accepted: false, RejectionReason: estimate.RejectReason}, nil
+	}
+	result := &pb.DryRunResult{
+		Accepted:  true,
+		NodeList:  estimate.NodeList,
+		Partition: estimate.Partition,
+		Cpus:      uint32(estimate.Cpus),
+	}
+	if estimate.StartTime != 0 {
+		result.EstimatedStartTime = &timestamppb.Timestamp{Seconds: estimate.StartTime}
+	}
+	return result, nil
+}
+
+func (s *ServerJob) SubmitJob(ctx context.Context, in *pb.SubmitJobRequest) (*pb.SubmitJobResponse, error) {
+	caller.Logger.Infof("Received request SubmitJob: %v", in)
+	if st := checkSubmitJobRequest(in, nil); st != nil {
+		caller.Logger.Errorf("SubmitJob failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	// 检查用户是否在slurm中
+	exists, err := caller.GetBackend(ctx).UserExists(in.UserId)
+	if err != nil || !exists {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "USER_NOT_FOUND",
+		}
+		message := fmt.Sprintf("%s does not exists.", in.UserId)
+		st := status.New(codes.NotFound, message)
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("SubmitJob failed: %v", st.Err())
+		return nil, st.Err()
+	}
 
-	submitResponse, err := utils.LocalSubmitJob(scriptString, in.UserId)
+	scriptString, err := jobScript(in)
+	if err != nil {
+		st := invalidDependencyStatus(err, nil)
+		caller.Logger.Errorf("SubmitJob failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	if in.DryRun {
+		result, err := dryRunJob(ctx, "SubmitJob", in.UserId, scriptString)
+		if err != nil {
+			return nil, err
+		}
+		caller.Logger.Infof("SubmitJob dry run result: %v", result)
+		return &pb.SubmitJobResponse{GeneratedScript: scriptString, DryRunResult: result}, nil
+	}
+	// 提交作业
+	jobId, err := caller.GetBackend(ctx).SubmitJob(in.UserId, scriptString)
 	if err != nil {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "SBATCH_FAILED",
 		}
-		st := status.New(codes.Unknown, submitResponse)
+		st := status.New(codes.Unknown, err.Error())
 		st, _ = st.WithDetails(errInfo)
 		caller.Logger.Errorf("SubmitJob failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	responseList := strings.Split(strings.TrimSpace(string(submitResponse)), " ")
-	jobIdString := responseList[len(responseList)-1]
-	jobId, _ := strconv.Atoi(jobIdString)
-	caller.Logger.Infof("SubmitJobResponse: %v", &pb.SubmitJobResponse{JobId: uint32(jobId), GeneratedScript: scriptString})
-	return &pb.SubmitJobResponse{JobId: uint32(jobId), GeneratedScript: scriptString}, nil
+	caller.Logger.Infof("SubmitJobResponse: %v", &pb.SubmitJobResponse{JobId: jobId, GeneratedScript: scriptString})
+	return &pb.SubmitJobResponse{JobId: jobId, GeneratedScript: scriptString}, nil
+}
+
+// 工作流不合法时的错误
+func invalidWorkflowError(message string) error {
+	errInfo := &errdetails.ErrorInfo{
+		Reason: "INVALID_WORKFLOW",
+	}
+	st := status.New(codes.InvalidArgument, message)
+	st, _ = st.WithDetails(errInfo)
+	caller.Logger.Errorf("SubmitWorkflow failed: %v", st.Err())
+	return st.Err()
+}
+
+func (s *ServerJob) SubmitWorkflow(ctx context.Context, in *pb.SubmitWorkflowRequest) (*pb.SubmitWorkflowResponse, error) {
+	caller.Logger.Infof("Received request SubmitWorkflow: %v", in)
+	if !utils.CheckAccountOrUserStrings(in.UserId) {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "ACCOUNT_USER_CONTAIN_ILLEGAL_CHARACTERS",
+		}
+		st := status.New(codes.Internal, "The account or username contains illegal characters.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("SubmitWorkflow failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	exists, err := caller.GetBackend(ctx).UserExists(in.UserId)
+	if err != nil || !exists {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "USER_NOT_FOUND",
+		}
+		message := fmt.Sprintf("%s does not exists.", in.UserId)
+		st := status.New(codes.NotFound, message)
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("SubmitWorkflow failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	// 检查作业名和依赖, 作业统一以工作流的用户提交
+	indexes := make(map[string]int, len(in.Jobs))
+	jobs := make([]*pb.SubmitJobRequest, len(in.Jobs))
+	for i, workflowJob := range in.Jobs {
+		if workflowJob.Name == "" || workflowJob.Job == nil {
+			return nil, invalidWorkflowError(fmt.Sprintf("The job #%d has no name or job spec.", i))
+		}
+		if _, ok := indexes[workflowJob.Name]; ok {
+			return nil, invalidWorkflowError(fmt.Sprintf("The job name %s is not unique.", workflowJob.Name))
+		}
+		indexes[workflowJob.Name] = i
+		jobs[i] = proto.Clone(workflowJob.Job).(*pb.SubmitJobRequest)
+		jobs[i].UserId = in.UserId
+		if st := checkSubmitJobRequest(jobs[i], map[string]string{"job_name": workflowJob.Name}); st != nil {
+			caller.Logger.Errorf("SubmitWorkflow failed: %v", st.Err())
+			return nil, st.Err()
+		}
+	}
+	// 提交前检查所有依赖, 避免提交了一部分作业后才发现依赖不合法
+	for _, workflowJob := range in.Jobs {
+		for _, dependency := range workflowJob.DependsOn {
+			if _, ok := indexes[dependency.Name]; !ok {
+				return nil, invalidWorkflowError(fmt.Sprintf("The job %s depends on unknown job %s.", workflowJob.Name, dependency.Name))
+			}
+			if _, err := utils.DependencyTypeName(dependency.Type); err != nil {
+				st := invalidDependencyStatus(err, map[string]string{"job_name": workflowJob.Name})
+				caller.Logger.Errorf("SubmitWorkflow failed: %v", st.Err())
+				return nil, st.Err()
+			}
+		}
+	}
+	order, err := utils.TopologicalOrder(len(in.Jobs), func(i int) []int {
+		var dependsOn []int
+		for _, dependency := range in.Jobs[i].DependsOn {
+			dependsOn = append(dependsOn, indexes[dependency.Name])
+		}
+		return dependsOn
+	})
+	if err != nil {
+		return nil, invalidWorkflowError("The workflow has a dependency cycle.")
+	}
+
+	// 按拓扑顺序提交, 依赖的作业名替换为已提交的作业id
+	jobIds := make([]uint32, len(in.Jobs))
+	var results []*pb.WorkflowJobResult
+	for _, i := range order {
+		workflowJob := in.Jobs[i]
+		job := jobs[i]
+		for _, dependency := range workflowJob.DependsOn {
+			job.Dependencies = append(job.Dependencies, &pb.JobDependency{
+				Type:   dependency.Type,
+				JobIds: []uint32{jobIds[indexes[dependency.Name]]},
+			})
+		}
+		scriptString, err := jobScript(job)
+		if err != nil {
+			cancelWorkflowJobs(ctx, in.UserId, results)
+			st := invalidDependencyStatus(err, map[string]string{"job_name": workflowJob.Name})
+			caller.Logger.Errorf("SubmitWorkflow failed: %v", st.Err())
+			return nil, st.Err()
+		}
+		jobId, err := caller.GetBackend(ctx).SubmitJob(in.UserId, scriptString)
+		if err != nil {
+			cancelWorkflowJobs(ctx, in.UserId, results)
+			errInfo := &errdetails.ErrorInfo{
+				Reason:   "SBATCH_FAILED",
+				Metadata: map[string]string{"job_name": workflowJob.Name},
+			}
+			st := status.New(codes.Unknown, err.Error())
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("SubmitWorkflow failed: %v", st.Err())
+			return nil, st.Err()
+		}
+		jobIds[i] = jobId
+		results = append(results, &pb.WorkflowJobResult{Name: workflowJob.Name, JobId: jobId, GeneratedScript: scriptString})
+	}
+	caller.Logger.Infof("SubmitWorkflowResponse: %v", results)
+	return &pb.SubmitWorkflowResponse{Jobs: results}, nil
+}
+
+// 工作流提交失败时取消已经提交的作业, 先取消依赖其他作业的
+func cancelWorkflowJobs(ctx context.Context, userId string, results []*pb.WorkflowJobResult) {
+	for j := len(results) - 1; j >= 0; j-- {
+		if err := caller.GetBackend(ctx).CancelJob(userId, results[j].JobId, nil); err != nil {
+			caller.Logger.Errorf("SubmitWorkflow cancel job %d failed: %v", results[j].JobId, err)
+		}
+	}
 }
 
 func (s *ServerJob) SubmitScriptAsJob(ctx context.Context, in *pb.SubmitScriptAsJobRequest) (*pb.SubmitScriptAsJobResponse, error) {
-	var (
-		name string
-	)
 	// 记录日志
 	caller.Logger.Infof("Received request SubmitFileAsJob: %v", in)
 	resultUser := utils.CheckAccountOrUserStrings(in.UserId)
@@ -1254,10 +1368,9 @@ func (s *ServerJob) SubmitScriptAsJob(ctx context.Context, in *pb.SubmitScriptAs
 		caller.Logger.Errorf("SubmitScriptAsJob failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	// 检查账户是否在slurm中
-	userSqlConfig := "SELECT name FROM user_table WHERE deleted = 0 AND name = ?"
-	err := caller.DB.QueryRow(userSqlConfig, in.UserId).Scan(&name)
-	if err != nil {
+	// 检查用户是否在slurm中
+	exists, err := caller.GetBackend(ctx).UserExists(in.UserId)
+	if err != nil || !exists {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "USER_NOT_FOUND",
 		}
@@ -1292,21 +1405,354 @@ func (s *ServerJob) SubmitScriptAsJob(ctx context.Context, in *pb.SubmitScriptAs
 		in.Script = updateScript
 	}
 
-	submitResponse, err := utils.LocalSubmitJob(in.Script, in.UserId)
+	if in.DryRun {
+		result, err := dryRunJob(ctx, "SubmitScriptAsJob", in.UserId, in.Script)
+		if err != nil {
+			return nil, err
+		}
+		caller.Logger.Infof("SubmitScriptAsJob dry run result: %v", result)
+		return &pb.SubmitScriptAsJobResponse{DryRunResult: result}, nil
+	}
+	jobId, err := caller.GetBackend(ctx).SubmitJob(in.UserId, in.Script)
 	if err != nil {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "SBATCH_FAILED",
 		}
-		st := status.New(codes.Unknown, submitResponse)
+		st := status.New(codes.Unknown, err.Error())
 		st, _ = st.WithDetails(errInfo)
 		caller.Logger.Errorf("SubmitScriptAsJob failed: %v", st.Err())
 		return nil, st.Err()
-	} else {
-		// 这里还要获取jobid
-		responseList := strings.Split(strings.TrimSpace(string(submitResponse)), " ")
-		jobIdString := responseList[len(responseList)-1]
-		jobId, _ := strconv.Atoi(jobIdString)
-		caller.Logger.Infof("SubmitJobResponse: %v", &pb.SubmitScriptAsJobResponse{JobId: uint32(jobId)})
-		return &pb.SubmitScriptAsJobResponse{JobId: uint32(jobId)}, nil
 	}
+	caller.Logger.Infof("SubmitJobResponse: %v", &pb.SubmitScriptAsJobResponse{JobId: jobId})
+	return &pb.SubmitScriptAsJobResponse{JobId: jobId}, nil
+}
+
+// 作业是否已经结束, 已经不在slurmctld中的作业也视为结束
+func jobFinished(ctx context.Context, jobId uint32) (bool, error) {
+	jobs, err := caller.GetBackend(ctx).ListQueueJobs(&backend.QueueFilter{JobIds: []uint32{jobId}})
+	if err != nil {
+		return false, err
+	}
+	for _, job := range jobs {
+		if job.JobId == jobId {
+			return slices.Contains(terminalStates, job.State), nil
+		}
+	}
+	return true, nil
+}
+
+func (s *ServerJob) TailJobOutput(in *pb.TailJobOutputRequest, stream pb.JobService_TailJobOutputServer) error {
+	caller.Logger.Infof("Received request TailJobOutput: %v", in)
+	ctx := stream.Context()
+	// 检查用户名中是否包含大写字母
+	resultUser := utils.CheckAccountOrUserStrings(in.UserId)
+	if !resultUser {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "USER_CONTAIN_ILLEGAL_CHARACTERS",
+		}
+		st := status.New(codes.Internal, "The username contains illegal characters.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("TailJobOutput failed: %v", st.Err())
+		return st.Err()
+	}
+	// 判断用户是否存在
+	exists, err := caller.GetBackend(ctx).UserExists(in.UserId)
+	if err != nil || !exists {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "USER_NOT_FOUND",
+		}
+		message := fmt.Sprintf("%s does not exists.", in.UserId)
+		st := status.New(codes.NotFound, message)
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("TailJobOutput failed: %v", st.Err())
+		return st.Err()
+	}
+	jobOutput, err := caller.GetBackend(ctx).GetJobOutput(in.JobId)
+	if err != nil {
+		if errors.Is(err, backend.ErrNotFound) {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "JOB_NOT_FOUND",
+			}
+			st := status.New(codes.NotFound, "The job does not exist.")
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("TailJobOutput failed: %v", st.Err())
+			return st.Err()
+		}
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "COMMAND_EXEC_FAILED",
+		}
+		st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("TailJobOutput failed: %v", st.Err())
+		return st.Err()
+	}
+	// 文件以作业所有者的身份读取, 只允许所有者读取
+	if jobOutput.User != in.UserId {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "JOB_NOT_OWNED",
+		}
+		message := fmt.Sprintf("The job is not owned by %s.", in.UserId)
+		st := status.New(codes.PermissionDenied, message)
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("TailJobOutput failed: %v", st.Err())
+		return st.Err()
+	}
+	path := jobOutput.StdoutPath
+	if in.OutputType == pb.TailJobOutputRequest_STDERR {
+		path = jobOutput.StderrPath
+	}
+	maxBytes := uint64(tailDefaultMaxBytes)
+	if in.MaxBytes != nil && *in.MaxBytes != 0 {
+		maxBytes = min(*in.MaxBytes, tailMaxBytes)
+	}
+	offset := in.Offset
+	var sent uint64
+	for sent < maxBytes {
+		// 先检查作业状态再读取, 作业结束后读完剩余的输出就结束
+		finished, err := jobFinished(ctx, in.JobId)
+		if err != nil {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "COMMAND_EXEC_FAILED",
+			}
+			st := status.New(codes.Internal, "Exec command failed or slurmctld down.")
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("TailJobOutput failed: %v", st.Err())
+			return st.Err()
+		}
+		// 每次最多读取utils.ReadFileMaxSize字节并发送, 直到读完新的输出或者达到max_bytes
+		for sent < maxBytes {
+			size := min(maxBytes-sent, utils.ReadFileMaxSize)
+			var data []byte
+			data, err = utils.ReadFileAsUser(jobOutput.User, path, offset, size)
+			if err != nil {
+				break
+			}
+			// 读到的内容少于请求的字节数时已经读完了当前的输出
+			drained := uint64(len(data)) < size
+			for len(data) > 0 {
+				chunk := data[:min(tailChunkSize, len(data))]
+				data = data[len(chunk):]
+				offset += uint64(len(chunk))
+				sent += uint64(len(chunk))
+				if err := stream.Send(&pb.TailJobOutputResponse{Data: chunk, Offset: offset, Path: path}); err != nil {
+					return err
+				}
+			}
+			if drained {
+				break
+			}
+		}
+		if errors.Is(err, os.ErrNotExist) {
+			if finished {
+				errInfo := &errdetails.ErrorInfo{
+					Reason: "OUTPUT_FILE_NOT_FOUND",
+				}
+				message := fmt.Sprintf("%s does not exists.", path)
+				st := status.New(codes.NotFound, message)
+				st, _ = st.WithDetails(errInfo)
+				caller.Logger.Errorf("TailJobOutput failed: %v", st.Err())
+				return st.Err()
+			}
+			err = nil // 排队中的作业还没有创建输出文件
+		}
+		if err != nil {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "READ_OUTPUT_FAILED",
+			}
+			st := status.New(codes.Internal, err.Error())
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("TailJobOutput failed: %v", st.Err())
+			return st.Err()
+		}
+		if finished {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(tailPollInterval):
+		}
+	}
+	return nil
+}
+
+// 作业步转换为JobStepInfo
+func jobStepInfo(step *backend.JobStep) *pb.JobStepInfo {
+	stepInfo := &pb.JobStepInfo{
+		StepId:         step.StepId,
+		Name:           step.Name,
+		State:          step.State,
+		NodeList:       step.NodeList,
+		NodesAlloc:     step.Nodes,
+		TaskCount:      step.Tasks,
+		ElapsedSeconds: step.ElapsedSeconds,
+		TresAlloc:      step.TresAlloc,
+		TresUsageInMax: step.TresUsageInMax,
+		TresUsageInAve: step.TresUsageInAve,
+		TresUsageInTot: step.TresUsageInTot,
+		CpuTimeSeconds: step.CpuTimeSeconds,
+	}
+	if step.StartTime != 0 {
+		stepInfo.StartTime = &timestamppb.Timestamp{Seconds: step.StartTime}
+	}
+	if step.EndTime != 0 {
+		stepInfo.EndTime = &timestamppb.Timestamp{Seconds: step.EndTime}
+	}
+	// 只有结束的作业步才有退出状态
+	if step.State != "RUNNING" && step.State != "PENDING" && step.State != "SUSPENDED" {
+		stepInfo.ExitCode = &step.ExitCode
+		stepInfo.ExitSignal = &step.ExitSignal
+	}
+	return stepInfo
+}
+
+func (s *ServerJob) GetJobSteps(ctx context.Context, in *pb.GetJobStepsRequest) (*pb.GetJobStepsResponse, error) {
+	caller.Logger.Infof("Received request GetJobSteps: %v", in)
+	steps, err := caller.GetBackend(ctx).ListJobSteps(in.JobId)
+	if err != nil {
+		if errors.Is(err, backend.ErrNotFound) {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "JOB_NOT_FOUND",
+			}
+			st := status.New(codes.NotFound, "The job does not exist.")
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("GetJobSteps failed: %v", st.Err())
+			return nil, st.Err()
+		}
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "SQL_QUERY_FAILED",
+		}
+		st := status.New(codes.Internal, err.Error())
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("GetJobSteps failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	var stepInfos []*pb.JobStepInfo
+	for _, step := range steps {
+		stepInfos = append(stepInfos, jobStepInfo(step))
+	}
+	caller.Logger.Tracef("GetJobSteps GetJobStepsResponse is: %v", stepInfos)
+	return &pb.GetJobStepsResponse{Steps: stepInfos}, nil
+}
+
+// 作业效率转换为JobEfficiency
+func jobEfficiencyInfo(e *backend.JobEfficiency) *pb.JobEfficiency {
+	return &pb.JobEfficiency{
+		JobId:                    e.Job.JobId,
+		User:                     e.Job.User,
+		Account:                  e.Job.Account,
+		State:                    e.Job.State,
+		CpusAlloc:                e.Job.CpusAlloc,
+		CpuUsedSeconds:           e.CpuUsedSeconds,
+		CpuAllocSeconds:          e.CpuAllocSeconds,
+		CpuEfficiencyPercent:     e.CpuEfficiency(),
+		MemReqMb:                 e.MemReqMb,
+		MemPeakMb:                e.MemPeakMb,
+		MemAveMb:                 e.MemAveMb,
+		MemPeakEfficiencyPercent: e.MemPeakEfficiency(),
+		MemAveEfficiencyPercent:  e.MemAveEfficiency(),
+		ElapsedSeconds:           e.ElapsedSeconds,
+		TimeLimitMinutes:         e.TimeLimitSeconds / 60,
+		TimeEfficiencyPercent:    e.TimeEfficiency(),
+	}
+}
+
+func (s *ServerJob) GetJobEfficiency(ctx context.Context, in *pb.GetJobEfficiencyRequest) (*pb.GetJobEfficiencyResponse, error) {
+	caller.Logger.Infof("Received request GetJobEfficiency: %v", in)
+	job, err := caller.GetBackend(ctx).GetJob(in.JobId)
+	var steps []*backend.JobStep
+	if err == nil {
+		steps, err = caller.GetBackend(ctx).ListJobSteps(in.JobId)
+	}
+	if err != nil {
+		if errors.Is(err, backend.ErrNotFound) {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "JOB_NOT_FOUND",
+			}
+			st := status.New(codes.NotFound, "The job does not exist.")
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("GetJobEfficiency failed: %v", st.Err())
+			return nil, st.Err()
+		}
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "SQL_QUERY_FAILED",
+		}
+		st := status.New(codes.Internal, err.Error())
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("GetJobEfficiency failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	efficiency := jobEfficiencyInfo(backend.NewJobEfficiency(job, steps))
+	caller.Logger.Tracef("GetJobEfficiency GetJobEfficiencyResponse is: %v", efficiency)
+	return &pb.GetJobEfficiencyResponse{Efficiency: efficiency}, nil
+}
+
+func (s *ServerJob) GetEfficiencySummary(ctx context.Context, in *pb.GetEfficiencySummaryRequest) (*pb.GetEfficiencySummaryResponse, error) {
+	caller.Logger.Infof("Received request GetEfficiencySummary: %v", in)
+	if in.EndTime == nil {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "END_TIME_NOT_SET",
+		}
+		st := status.New(codes.InvalidArgument, "The end time range is not set.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("GetEfficiencySummary failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	// 只统计已经结束的作业, 不分页
+	query := &backend.JobQuery{
+		Users:        in.Users,
+		Accounts:     in.Accounts,
+		EndTimeStart: in.EndTime.StartTime.GetSeconds(),
+		EndTimeEnd:   in.EndTime.EndTime.GetSeconds(),
+		Order:        "ASC",
+	}
+	jobs, _, err := caller.GetBackend(ctx).QueryJobs(query)
+	var jobSteps map[uint32][]*backend.JobStep
+	if err == nil && len(jobs) != 0 {
+		jobSteps, err = caller.GetBackend(ctx).QueryJobSteps(query)
+	}
+	if err != nil {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "SQL_QUERY_FAILED",
+		}
+		st := status.New(codes.Internal, err.Error())
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("GetEfficiencySummary failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	var names []string
+	summaryMap := make(map[string]*backend.EfficiencySummary)
+	for _, job := range jobs {
+		name := job.User
+		if in.GroupBy == pb.GetEfficiencySummaryRequest_ACCOUNT {
+			name = job.Account
+		}
+		summary, ok := summaryMap[name]
+		if !ok {
+			summary = &backend.EfficiencySummary{Name: name}
+			summaryMap[name] = summary
+			names = append(names, name)
+		}
+		summary.Add(backend.NewJobEfficiency(job, jobSteps[job.JobId]))
+	}
+	var summaries []*pb.EfficiencySummary
+	slices.Sort(names)
+	for _, name := range names {
+		summary := summaryMap[name]
+		summaries = append(summaries, &pb.EfficiencySummary{
+			Name:                  summary.Name,
+			JobCount:              summary.JobCount,
+			CpuUsedSeconds:        summary.CpuUsedSeconds,
+			CpuAllocSeconds:       summary.CpuAllocSeconds,
+			CpuEfficiencyPercent:  summary.CpuEfficiency(),
+			MemPeakMb:             summary.MemPeakMb,
+			MemReqMb:              summary.MemReqMb,
+			MemEfficiencyPercent:  summary.MemEfficiency(),
+			ElapsedSeconds:        summary.ElapsedSeconds,
+			TimeLimitSeconds:      summary.TimeLimitSeconds,
+			TimeEfficiencyPercent: summary.TimeEfficiency(),
+		})
+	}
+	caller.Logger.Tracef("GetEfficiencySummary GetEfficiencySummaryResponse is: %v", summaries)
+	return &pb.GetEfficiencySummaryResponse{Summaries: summaries}, nil
 }
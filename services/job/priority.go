@@ -0,0 +1,60 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"scow-slurm-adapter/backend"
+	"scow-slurm-adapter/caller"
+	pb "scow-slurm-adapter/gen/go"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ServerJob) GetJobPriority(ctx context.Context, in *pb.GetJobPriorityRequest) (*pb.GetJobPriorityResponse, error) {
+	caller.Logger.Infof("Received request GetJobPriority: %v", in)
+	queueJob, err := findPendingJob(ctx, "GetJobPriority", in.JobId)
+	if err != nil {
+		return nil, err
+	}
+	// 提交到多个分区的作业在每个分区中分别排队
+	partitions := strings.Split(queueJob.Partition, ",")
+	priorities, err := caller.GetBackend(ctx).ListJobPriorities(partitions)
+	if err != nil {
+		if errors.Is(err, backend.ErrNotSupported) {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "NOT_SUPPORTED",
+			}
+			st := status.New(codes.Unimplemented, err.Error())
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("GetJobPriority failed: %v", st.Err())
+			return nil, st.Err()
+		}
+		return nil, commandFailedError("GetJobPriority", err)
+	}
+	resp := &pb.GetJobPriorityResponse{}
+	for _, priority := range priorities {
+		if priority.JobId != queueJob.JobId {
+			continue
+		}
+		rank, pendingCount := backend.JobPriorityRank(priorities, priority.JobId, priority.Partition)
+		resp.Priorities = append(resp.Priorities, &pb.JobPriority{
+			Partition:       priority.Partition,
+			Priority:        priority.Priority,
+			Age:             priority.Age,
+			Fairshare:       priority.Fairshare,
+			JobSize:         priority.JobSize,
+			PartitionFactor: priority.PartitionFactor,
+			Qos:             priority.Qos,
+			Nice:            priority.Nice,
+			Tres:            priority.Tres,
+			Rank:            rank,
+			PendingCount:    pendingCount,
+		})
+	}
+	caller.Logger.Tracef("GetJobPriority GetJobPriorityResponse is: %v", resp)
+	return resp, nil
+}
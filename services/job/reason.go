@@ -0,0 +1,46 @@
+package job
+
+import (
+	"context"
+
+	"scow-slurm-adapter/backend"
+	"scow-slurm-adapter/caller"
+	pb "scow-slurm-adapter/gen/go"
+	"scow-slurm-adapter/reason"
+)
+
+var pendingReasonCategories = map[reason.Category]pb.PendingReason_Category{
+	reason.CategoryOther:          pb.PendingReason_OTHER,
+	reason.CategoryResources:      pb.PendingReason_RESOURCES,
+	reason.CategoryPriority:       pb.PendingReason_PRIORITY,
+	reason.CategoryLimits:         pb.PendingReason_LIMITS,
+	reason.CategoryDependency:     pb.PendingReason_DEPENDENCY,
+	reason.CategoryHeld:           pb.PendingReason_HELD,
+	reason.CategoryPartitionDown:  pb.PendingReason_PARTITION_DOWN,
+	reason.CategoryAccountBlocked: pb.PendingReason_ACCOUNT_BLOCKED,
+}
+
+// JobInfo中的排队原因, 账户没有分区权限时去掉后面的分区名, 只保留前面固定的部分
+func pendingReason(raw string) string {
+	if r := reason.Classify(raw); r != nil && r.Code == reason.AccountNotPermitted {
+		return r.Code
+	}
+	return raw
+}
+
+// 排队作业分类后的原因, 说明文字使用请求的语言, 作业不在排队或者没有原因时返回nil
+func pendingReasonInfo(ctx context.Context, job *backend.QueueJob) *pb.PendingReason {
+	if job == nil || job.State != "PENDING" {
+		return nil
+	}
+	r := reason.Classify(job.Reason)
+	if r == nil {
+		return nil
+	}
+	return &pb.PendingReason{
+		Category:    pendingReasonCategories[r.Category],
+		Code:        r.Code,
+		Params:      r.Params,
+		Explanation: r.Explain(caller.GetLocale(ctx)),
+	}
+}
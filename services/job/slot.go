@@ -0,0 +1,60 @@
+package job
+
+import (
+	"context"
+
+	"scow-slurm-adapter/caller"
+	pb "scow-slurm-adapter/gen/go"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 假设的作业只用于sbatch --test-only, 不会真正运行
+func slotJobRequest(in *pb.GetNextAvailableSlotRequest) *pb.SubmitJobRequest {
+	return &pb.SubmitJobRequest{
+		UserId:           in.UserId,
+		JobName:          "next-available-slot",
+		Account:          in.Account,
+		Partition:        in.Partition,
+		Qos:              in.Qos,
+		NodeCount:        max(in.NodeCount, 1),
+		CoreCount:        max(in.CoreCount, 1),
+		GpuCount:         in.GpuCount,
+		TimeLimitMinutes: in.TimeLimitMinutes,
+		WorkingDirectory: "/tmp",
+		Script:           "hostname\n",
+	}
+}
+
+func (s *ServerJob) GetNextAvailableSlot(ctx context.Context, in *pb.GetNextAvailableSlotRequest) (*pb.GetNextAvailableSlotResponse, error) {
+	caller.Logger.Infof("Received request GetNextAvailableSlot: %v", in)
+	job := slotJobRequest(in)
+	if st := checkSubmitJobRequest(job, nil); st != nil {
+		caller.Logger.Errorf("GetNextAvailableSlot failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	exists, err := caller.GetBackend(ctx).UserExists(in.UserId)
+	if err != nil || !exists {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "USER_NOT_FOUND",
+		}
+		st := status.New(codes.NotFound, "The user does not exist.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("GetNextAvailableSlot failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	scriptString, err := jobScript(job)
+	if err != nil {
+		st := invalidDependencyStatus(err, nil)
+		caller.Logger.Errorf("GetNextAvailableSlot failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	slot, err := dryRunJob(ctx, "GetNextAvailableSlot", in.UserId, scriptString)
+	if err != nil {
+		return nil, err
+	}
+	caller.Logger.Tracef("GetNextAvailableSlot GetNextAvailableSlotResponse is: %v", slot)
+	return &pb.GetNextAvailableSlotResponse{Slot: slot}, nil
+}
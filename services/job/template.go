@@ -0,0 +1,113 @@
+package job
+
+import (
+	"context"
+	"scow-slurm-adapter/caller"
+	pb "scow-slurm-adapter/gen/go"
+	"scow-slurm-adapter/utils"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 配置文件中的作业模板转换为JobTemplate
+func jobTemplateInfo(template *utils.JobTemplate) *pb.JobTemplate {
+	info := &pb.JobTemplate{
+		Name:              template.Name,
+		Description:       template.Desc,
+		Partition:         template.Partition,
+		NodeCount:         max(template.NodeCount, 1),
+		CoreCount:         max(template.CoreCount, 1),
+		GpuCount:          template.GpuCount,
+		ExtraOptions:      template.ExtraOptions,
+		Script:            template.Script,
+		Variables:         template.Variables,
+		RequiredVariables: template.RequiredVariables(),
+	}
+	if template.Qos != "" {
+		info.Qos = &template.Qos
+	}
+	if template.TimeLimitMinutes != 0 {
+		info.TimeLimitMinutes = &template.TimeLimitMinutes
+	}
+	return info
+}
+
+func (s *ServerJob) ListJobTemplates(ctx context.Context, in *pb.ListJobTemplatesRequest) (*pb.ListJobTemplatesResponse, error) {
+	caller.Logger.Infof("Received request ListJobTemplates: %v", in)
+	var templates []*pb.JobTemplate
+	for i := range caller.ConfigValue.Templates {
+		templates = append(templates, jobTemplateInfo(&caller.ConfigValue.Templates[i]))
+	}
+	return &pb.ListJobTemplatesResponse{Templates: templates}, nil
+}
+
+func (s *ServerJob) SubmitJobFromTemplate(ctx context.Context, in *pb.SubmitJobFromTemplateRequest) (*pb.SubmitJobFromTemplateResponse, error) {
+	caller.Logger.Infof("Received request SubmitJobFromTemplate: %v", in)
+	template, ok := utils.FindJobTemplate(caller.ConfigValue.Templates, in.TemplateName)
+	if !ok {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "TEMPLATE_NOT_FOUND",
+		}
+		st := status.New(codes.NotFound, "The job template does not exist.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("SubmitJobFromTemplate failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	script, err := template.RenderScript(in.Variables)
+	if err != nil {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "TEMPLATE_VARIABLE_NOT_SET",
+		}
+		st := status.New(codes.InvalidArgument, err.Error())
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("SubmitJobFromTemplate failed: %v", st.Err())
+		return nil, st.Err()
+	}
+
+	// 用户指定的值覆盖模板的默认值, 再按SubmitJob生成脚本并提交
+	info := jobTemplateInfo(template)
+	req := &pb.SubmitJobRequest{
+		UserId:           in.UserId,
+		JobName:          in.JobName,
+		Account:          in.Account,
+		Partition:        info.Partition,
+		Qos:              info.Qos,
+		NodeCount:        info.NodeCount,
+		CoreCount:        info.CoreCount,
+		GpuCount:         info.GpuCount,
+		TimeLimitMinutes: info.TimeLimitMinutes,
+		Script:           script,
+		WorkingDirectory: in.WorkingDirectory,
+		Stdout:           in.Stdout,
+		Stderr:           in.Stderr,
+		ExtraOptions:     append(append([]string{}, template.ExtraOptions...), in.ExtraOptions...),
+		Array:            in.Array,
+		Dependencies:     in.Dependencies,
+		DryRun:           in.DryRun,
+	}
+	if in.Partition != nil {
+		req.Partition = *in.Partition
+	}
+	if in.Qos != nil {
+		req.Qos = in.Qos
+	}
+	if in.NodeCount != nil {
+		req.NodeCount = *in.NodeCount
+	}
+	if in.CoreCount != nil {
+		req.CoreCount = *in.CoreCount
+	}
+	if in.GpuCount != nil {
+		req.GpuCount = *in.GpuCount
+	}
+	if in.TimeLimitMinutes != nil {
+		req.TimeLimitMinutes = in.TimeLimitMinutes
+	}
+	res, err := s.SubmitJob(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SubmitJobFromTemplateResponse{JobId: res.JobId, GeneratedScript: res.GeneratedScript, DryRunResult: res.DryRunResult}, nil
+}
@@ -0,0 +1,149 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"scow-slurm-adapter/backend"
+	"scow-slurm-adapter/caller"
+	pb "scow-slurm-adapter/gen/go"
+	"scow-slurm-adapter/utils"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func jobUpdateFromRequest(in *pb.UpdateJobRequest) *backend.JobUpdate {
+	update := &backend.JobUpdate{
+		Partition: in.Partition,
+		Qos:       in.Qos,
+		NodeCount: in.NodeCount,
+		Cpus:      in.Cpus,
+		Gpus:      in.Gpus,
+		Name:      in.Name,
+		Comment:   in.Comment,
+	}
+	if in.MemoryMb != nil {
+		memMb := int64(*in.MemoryMb)
+		update.MemMb = &memMb
+	}
+	return update
+}
+
+func commandFailedError(rpc string, err error) error {
+	errInfo := &errdetails.ErrorInfo{
+		Reason: "COMMAND_EXEC_FAILED",
+	}
+	st := status.New(codes.Internal, err.Error())
+	st, _ = st.WithDetails(errInfo)
+	caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
+	return st.Err()
+}
+
+// 查找排队中的作业, 作业不存在或者不在排队时返回对应的错误
+func findPendingJob(ctx context.Context, rpc string, jobId uint32) (*backend.QueueJob, error) {
+	queueJob := findQueueJob(ctx, jobId, nil)
+	if queueJob == nil {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "JOB_NOT_FOUND",
+		}
+		st := status.New(codes.NotFound, "The job does not exist.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
+		return nil, st.Err()
+	}
+	if queueJob.State != "PENDING" {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "JOB_NOT_PENDING",
+		}
+		st := status.New(codes.FailedPrecondition, "The job is not pending.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
+		return nil, st.Err()
+	}
+	return queueJob, nil
+}
+
+func (s *ServerJob) UpdateJob(ctx context.Context, in *pb.UpdateJobRequest) (*pb.UpdateJobResponse, error) {
+	caller.Logger.Infof("Received request UpdateJob: %v", in)
+	queueJob, err := findPendingJob(ctx, "UpdateJob", in.JobId)
+	if err != nil {
+		return nil, err
+	}
+	update := jobUpdateFromRequest(in)
+	if len(update.Fields()) == 0 {
+		return &pb.UpdateJobResponse{}, nil
+	}
+
+	// 按修改后所在的分区检查
+	partitionName := queueJob.Partition
+	if update.Partition != nil {
+		partitionName = *update.Partition
+	}
+	partition, err := caller.GetBackend(ctx).GetPartition(partitionName)
+	if err != nil {
+		if errors.Is(err, backend.ErrNotFound) {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "PARTITION_NOT_FOUND",
+			}
+			st := status.New(codes.NotFound, "The partition does not exist.")
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("UpdateJob failed: %v", st.Err())
+			return nil, st.Err()
+		}
+		return nil, commandFailedError("UpdateJob", err)
+	}
+	// 每个节点的gpu和内存以分区中第一个节点的配置为准
+	var node *backend.Node
+	if update.Gpus != nil || update.MemMb != nil {
+		if nodeName := utils.GetFirstNodeName(partition.Nodes); nodeName != "" && nodeName != "(null)" {
+			node, err = caller.GetBackend(ctx).GetNode(nodeName)
+			if err != nil {
+				return nil, commandFailedError("UpdateJob", err)
+			}
+		}
+	}
+	if invalid := backend.ValidateJobUpdate(update, queueJob, partition, node); len(invalid) != 0 {
+		metadata := make(map[string]string)
+		var messages []string
+		for field, reason := range invalid {
+			metadata[field] = reason.Error()
+			messages = append(messages, field+": "+reason.Error())
+		}
+		sort.Strings(messages)
+		errInfo := &errdetails.ErrorInfo{
+			Reason:   "INVALID_JOB_UPDATE",
+			Metadata: metadata,
+		}
+		st := status.New(codes.InvalidArgument, strings.Join(messages, "; "))
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("UpdateJob failed: %v", st.Err())
+		return nil, st.Err()
+	}
+
+	results, err := caller.GetBackend(ctx).UpdateJob(in.JobId, update)
+	if err != nil {
+		if errors.Is(err, backend.ErrNotFound) {
+			// 检查之后作业离开了队列
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "JOB_NOT_FOUND",
+			}
+			st := status.New(codes.NotFound, "The job does not exist.")
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("UpdateJob failed: %v", st.Err())
+			return nil, st.Err()
+		}
+		return nil, commandFailedError("UpdateJob", err)
+	}
+	resp := &pb.UpdateJobResponse{}
+	for _, field := range update.Fields() {
+		if err, ok := results[field]; ok {
+			resp.RejectedFields = append(resp.RejectedFields, &pb.UpdateJobResponse_RejectedField{Field: field, Reason: err.Error()})
+		}
+	}
+	caller.Logger.Tracef("UpdateJob UpdateJobResponse is: %v", resp)
+	return resp, nil
+}
@@ -0,0 +1,90 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"scow-slurm-adapter/backend"
+	"scow-slurm-adapter/caller"
+	pb "scow-slurm-adapter/gen/go"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var usageBuckets = map[pb.GetUsageSummaryRequest_Bucket]backend.UsageBucket{
+	pb.GetUsageSummaryRequest_NONE:  backend.UsageBucketNone,
+	pb.GetUsageSummaryRequest_DAY:   backend.UsageBucketDay,
+	pb.GetUsageSummaryRequest_WEEK:  backend.UsageBucketWeek,
+	pb.GetUsageSummaryRequest_MONTH: backend.UsageBucketMonth,
+}
+
+func (s *ServerJob) GetUsageSummary(ctx context.Context, in *pb.GetUsageSummaryRequest) (*pb.GetUsageSummaryResponse, error) {
+	caller.Logger.Infof("Received request GetUsageSummary: %v", in)
+	if in.TimeRange.GetStartTime() == nil {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "TIME_RANGE_NOT_SET",
+		}
+		st := status.New(codes.InvalidArgument, "The time range is not set.")
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("GetUsageSummary failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	// 不指定结束时间时统计到现在
+	now := time.Now().Unix()
+	opts := &backend.UsageOptions{
+		Start:  in.TimeRange.StartTime.GetSeconds(),
+		End:    now,
+		Bucket: usageBuckets[in.Bucket],
+	}
+	if in.TimeRange.EndTime != nil {
+		opts.End = in.TimeRange.EndTime.GetSeconds()
+	}
+	for _, groupBy := range in.GroupBy {
+		switch groupBy {
+		case pb.GetUsageSummaryRequest_USER:
+			opts.GroupByUser = true
+		case pb.GetUsageSummaryRequest_ACCOUNT:
+			opts.GroupByAccount = true
+		case pb.GetUsageSummaryRequest_PARTITION:
+			opts.GroupByPartition = true
+		case pb.GetUsageSummaryRequest_QOS:
+			opts.GroupByQos = true
+		}
+	}
+	// 统计范围内运行过的作业
+	query := &backend.JobQuery{
+		Users:        in.Users,
+		Accounts:     in.Accounts,
+		RunTimeStart: opts.Start,
+		RunTimeEnd:   opts.End,
+	}
+	usage, err := caller.GetBackend(ctx).QueryUsage(query, opts, now)
+	if err != nil {
+		errInfo := &errdetails.ErrorInfo{
+			Reason: "SQL_QUERY_FAILED",
+		}
+		st := status.New(codes.Internal, err.Error())
+		st, _ = st.WithDetails(errInfo)
+		caller.Logger.Errorf("GetUsageSummary failed: %v", st.Err())
+		return nil, st.Err()
+	}
+	var summaries []*pb.UsageSummary
+	for _, summary := range usage {
+		summaries = append(summaries, &pb.UsageSummary{
+			User:        summary.User,
+			Account:     summary.Account,
+			Partition:   summary.Partition,
+			Qos:         summary.Qos,
+			BucketStart: &timestamppb.Timestamp{Seconds: summary.BucketStart},
+			JobCount:    summary.JobCount,
+			CpuHours:    summary.CpuHours(),
+			GpuHours:    summary.GpuHours(),
+			MemGbHours:  summary.MemGbHours(),
+		})
+	}
+	caller.Logger.Tracef("GetUsageSummary GetUsageSummaryResponse is: %v", summaries)
+	return &pb.GetUsageSummaryResponse{Summaries: summaries}, nil
+}
@@ -0,0 +1,248 @@
+package job
+
+import (
+	"context"
+	"scow-slurm-adapter/backend"
+	"scow-slurm-adapter/caller"
+	pb "scow-slurm-adapter/gen/go"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WatchJobs的参数
+const (
+	watchPollInterval = 5 * time.Second // 所有订阅者共用的轮询间隔
+	watchBufferSize   = 16              // 每个订阅者最多缓存的轮询结果数, 超过时断开该订阅者
+)
+
+// 轮询时查询的作业状态, 包括刚结束还留在slurmctld中的作业
+var watchStates = append([]string{"PENDING", "RUNNING", "SUSPENDED", "COMPLETING"}, backend.TerminalStates...)
+
+// 事件类型对应的JobEvent_Type
+var jobEventTypes = map[backend.JobEventType]pb.JobEvent_Type{
+	backend.JobSubmitted:     pb.JobEvent_SUBMITTED,
+	backend.JobStarted:       pb.JobEvent_STARTED,
+	backend.JobReasonChanged: pb.JobEvent_REASON_CHANGED,
+	backend.JobCompleted:     pb.JobEvent_COMPLETED,
+	backend.JobFailed:        pb.JobEvent_FAILED,
+	backend.JobCancelled:     pb.JobEvent_CANCELLED,
+	backend.JobStateChanged:  pb.JobEvent_STATE_CHANGED,
+}
+
+// 同一个集群的WatchJobs共用一个轮询, 比较前后两次squeue的结果后把事件分发给全部订阅者
+type jobWatcher struct {
+	backend     backend.Backend
+	mu          sync.Mutex
+	subscribers map[chan []*backend.JobEvent]bool
+	jobs        []*backend.QueueJob // 最近一次轮询的结果
+	polled      chan struct{}       // 第一次轮询完成后关闭
+	stop        chan struct{}       // 没有订阅者时关闭以停止轮询
+}
+
+var (
+	watchersMu sync.Mutex
+	watchers   = make(map[string]*jobWatcher) // 集群名到该集群轮询的映射
+)
+
+// 获取集群的轮询, 没有时创建
+func clusterWatcher(cluster *caller.Cluster) *jobWatcher {
+	watchersMu.Lock()
+	defer watchersMu.Unlock()
+	w, ok := watchers[cluster.Name]
+	if !ok {
+		w = &jobWatcher{backend: cluster.Backend, subscribers: make(map[chan []*backend.JobEvent]bool)}
+		watchers[cluster.Name] = w
+	}
+	return w
+}
+
+// 第一个订阅者启动轮询
+func (w *jobWatcher) subscribe() chan []*backend.JobEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	ch := make(chan []*backend.JobEvent, watchBufferSize)
+	w.subscribers[ch] = true
+	if w.stop == nil {
+		w.stop = make(chan struct{})
+		w.polled = make(chan struct{})
+		go w.run(w.stop, w.polled)
+	}
+	return ch
+}
+
+// 最后一个订阅者退出时停止轮询
+func (w *jobWatcher) unsubscribe(ch chan []*backend.JobEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.subscribers[ch] {
+		delete(w.subscribers, ch)
+		close(ch)
+	}
+	if len(w.subscribers) == 0 && w.stop != nil {
+		close(w.stop)
+		w.stop = nil
+		w.jobs = nil
+	}
+}
+
+// 等待第一次轮询完成后返回当前的作业
+func (w *jobWatcher) snapshot(ctx context.Context) []*backend.QueueJob {
+	w.mu.Lock()
+	polled := w.polled
+	w.mu.Unlock()
+	select {
+	case <-polled:
+	case <-ctx.Done():
+		return nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.jobs
+}
+
+func (w *jobWatcher) run(stop chan struct{}, polled chan struct{}) {
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+	first := true
+	for {
+		jobs, err := w.backend.ListQueueJobs(&backend.QueueFilter{States: watchStates})
+		if err != nil {
+			caller.Logger.Errorf("WatchJobs poll failed: %v", err)
+		} else {
+			w.update(stop, jobs, first)
+			if first {
+				close(polled)
+				first = false
+			}
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// 第一次轮询只记录作业, 之后每次把变化分发给订阅者
+func (w *jobWatcher) update(stop chan struct{}, jobs []*backend.QueueJob, first bool) {
+	w.mu.Lock()
+	previous := w.jobs
+	w.mu.Unlock()
+	var events []*backend.JobEvent
+	if !first {
+		var vanished []*backend.QueueJob
+		events, vanished = backend.DiffQueueJobs(previous, jobs)
+		events = append(events, vanishedJobEvents(w.backend, vanished)...)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	// 轮询期间订阅者全部退出并重新开始了新的轮询
+	if w.stop != stop {
+		return
+	}
+	w.jobs = jobs
+	if len(events) == 0 {
+		return
+	}
+	for ch := range w.subscribers {
+		select {
+		case ch <- events:
+		default:
+			// 订阅者处理不过来时断开, 避免阻塞其他订阅者
+			delete(w.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// 作业在两次轮询之间结束并离开了slurmctld, 从记账数据库中获取结束状态
+func vanishedJobEvents(b backend.Backend, vanished []*backend.QueueJob) []*backend.JobEvent {
+	var events []*backend.JobEvent
+	for _, job := range vanished {
+		if _, ok := backend.TerminalEventType(job.State); ok {
+			continue
+		}
+		dbJob, err := b.GetJob(job.JobId)
+		if err != nil {
+			caller.Logger.Warnf("WatchJobs get ended job %d failed: %v", job.JobId, err)
+			continue
+		}
+		eventType, ok := backend.TerminalEventType(dbJob.State)
+		if !ok {
+			continue
+		}
+		ended := *job
+		ended.State = dbJob.State
+		events = append(events, &backend.JobEvent{Type: eventType, Job: &ended, PreviousState: job.State, PreviousReason: job.Reason})
+	}
+	return events
+}
+
+func (s *ServerJob) WatchJobs(in *pb.WatchJobsRequest, stream pb.JobService_WatchJobsServer) error {
+	caller.Logger.Infof("Received request WatchJobs: %v", in)
+	filter := &backend.QueueFilter{
+		Users:      in.Filter.GetUsers(),
+		Accounts:   in.Filter.GetAccounts(),
+		Partitions: in.Filter.GetPartitions(),
+		JobIds:     in.Filter.GetJobIds(),
+	}
+	ctx := stream.Context()
+	watcher := clusterWatcher(caller.GetCluster(ctx))
+	events := watcher.subscribe()
+	defer watcher.unsubscribe(events)
+
+	if in.InitialSnapshot {
+		var initial []*pb.JobEvent
+		for _, job := range watcher.snapshot(ctx) {
+			if filter.Match(job) {
+				initial = append(initial, &pb.JobEvent{Type: pb.JobEvent_INITIAL, Job: jobInfoFromQueueJob(ctx, job, nil), Time: timestamppb.Now()})
+			}
+		}
+		if len(initial) != 0 {
+			if err := stream.Send(&pb.WatchJobsResponse{Events: initial}); err != nil {
+				return err
+			}
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case batch, ok := <-events:
+			if !ok {
+				errInfo := &errdetails.ErrorInfo{
+					Reason: "WATCHER_TOO_SLOW",
+				}
+				st := status.New(codes.ResourceExhausted, "The client does not receive events fast enough.")
+				st, _ = st.WithDetails(errInfo)
+				caller.Logger.Errorf("WatchJobs failed: %v", st.Err())
+				return st.Err()
+			}
+			var jobEvents []*pb.JobEvent
+			for _, event := range batch {
+				if !filter.Match(event.Job) {
+					continue
+				}
+				jobEvents = append(jobEvents, &pb.JobEvent{
+					Type:           jobEventTypes[event.Type],
+					Job:            jobInfoFromQueueJob(ctx, event.Job, nil),
+					PreviousState:  event.PreviousState,
+					PreviousReason: event.PreviousReason,
+					Time:           timestamppb.Now(),
+				})
+			}
+			if len(jobEvents) == 0 {
+				continue
+			}
+			if err := stream.Send(&pb.WatchJobsResponse{Events: jobEvents}); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -3,11 +3,12 @@ package user
 import (
 	"context"
 	"fmt"
+	"scow-slurm-adapter/backend"
 	"scow-slurm-adapter/caller"
 	pb "scow-slurm-adapter/gen/go"
 	"scow-slurm-adapter/utils"
-	"strings"
 
+	"github.com/wxnacy/wgo/arrays"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -18,15 +19,7 @@ type ServerUser struct {
 }
 
 func (s *ServerUser) AddUserToAccount(ctx context.Context, in *pb.AddUserToAccountRequest) (*pb.AddUserToAccountResponse, error) {
-	var (
-		acctName string
-		userName string
-		qosName  string
-		user     string
-		qosList  []string
-	)
 	caller.Logger.Infof("Received request AddUserToAccount: %v", in)
-	clusterName := caller.ConfigValue.MySQLConfig.ClusterName
 	defaultQos := caller.ConfigValue.Slurm.DefaultQOS
 
 	resultAcct := utils.CheckAccountOrUserStrings(in.AccountName) // 重新判断一下
@@ -42,9 +35,8 @@ func (s *ServerUser) AddUserToAccount(ctx context.Context, in *pb.AddUserToAccou
 	}
 
 	// 检查账号是否存在slurm中
-	acctSqlConfig := "SELECT name FROM acct_table WHERE name = ? AND deleted = 0"
-	err := caller.DB.QueryRow(acctSqlConfig, in.AccountName).Scan(&acctName)
-	if err != nil {
+	acctExists, err := caller.GetBackend(ctx).AccountExists(in.AccountName)
+	if err != nil || !acctExists {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "ACCOUNT_NOT_FOUND",
 		}
@@ -56,32 +48,7 @@ func (s *ServerUser) AddUserToAccount(ctx context.Context, in *pb.AddUserToAccou
 	}
 
 	// 查询系统中的base Qos
-	qosSqlConfig := "SELECT name FROM qos_table WHERE deleted = 0"
-	rows, err := caller.DB.Query(qosSqlConfig)
-	if err != nil {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "SQL_QUERY_FAILED",
-		}
-		st := status.New(codes.Internal, err.Error())
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("AddUserToAccount failed: %v", st.Err())
-		return nil, st.Err()
-	}
-	defer rows.Close()
-	for rows.Next() {
-		err := rows.Scan(&qosName)
-		if err != nil {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "SQL_QUERY_FAILED",
-			}
-			st := status.New(codes.Internal, err.Error())
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("AddUserToAccount failed: %v", st.Err())
-			return nil, st.Err()
-		}
-		qosList = append(qosList, qosName)
-	}
-	err = rows.Err()
+	qosList, err := caller.GetBackend(ctx).ListQos()
 	if err != nil {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "SQL_QUERY_FAILED",
@@ -92,9 +59,7 @@ func (s *ServerUser) AddUserToAccount(ctx context.Context, in *pb.AddUserToAccou
 		return nil, st.Err()
 	}
 
-	baseQos := strings.Join(qosList, ",")
-	// 查询用户是否在系统中
-	partitions, err := utils.GetPartitionInfo()
+	partitions, err := caller.GetBackend(ctx).ListPartitions()
 	if err != nil || len(partitions) == 0 {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "COMMAND_EXEC_FAILED",
@@ -105,171 +70,107 @@ func (s *ServerUser) AddUserToAccount(ctx context.Context, in *pb.AddUserToAccou
 		return nil, st.Err()
 	}
 
-	// 检查用户是否在slurm中
-	userSqlConfig := "SELECT name FROM user_table WHERE name = ? AND deleted = 0"
-	err = caller.DB.QueryRow(userSqlConfig, in.UserId).Scan(&userName)
-
-	if err != nil {
-		for _, v := range partitions {
-			createUserCmd := fmt.Sprintf("sacctmgr -i create user name='%s' partition='%s' account='%s'", in.UserId, v, in.AccountName)
-			modifyUserCmd := fmt.Sprintf("sacctmgr -i modify user %s set qos='%s' DefaultQOS='%s'", in.UserId, baseQos, defaultQos)
-			retcode01 := utils.ExecuteShellCommand(createUserCmd)
-			if retcode01 != 0 {
-				errInfo := &errdetails.ErrorInfo{
-					Reason: "EXEC_COMMAND_FAILED",
-				}
-				st := status.New(codes.AlreadyExists, "Command exec fail.")
-				st, _ = st.WithDetails(errInfo)
-				caller.Logger.Errorf("AddUserToAccount failed: %v", st.Err())
-				return nil, st.Err()
-			}
-			retcode02 := utils.ExecuteShellCommand(modifyUserCmd)
-			if retcode02 != 0 {
-				errInfo := &errdetails.ErrorInfo{
-					Reason: "EXEC_COMMAND_FAILED",
-				}
-				st := status.New(codes.AlreadyExists, "Command exec fail.")
-				st, _ = st.WithDetails(errInfo)
-				caller.Logger.Errorf("AddUserToAccount failed: %v", st.Err())
-				return nil, st.Err()
+	// 检查用户是否在slurm中, 用户存在时再检查账户和用户之间是否存在关联关系
+	userExists, err := caller.GetBackend(ctx).UserExists(in.UserId)
+	if err == nil && userExists {
+		assocExists, err := backend.AssociationExists(caller.GetBackend(ctx), in.UserId, in.AccountName)
+		if err == nil && assocExists {
+			// 关联已经存在的情况
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "USER_ALREADY_EXISTS",
 			}
+			st := status.New(codes.AlreadyExists, "The user already exists in account.")
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("AddUserToAccount failed: %v", st.Err())
+			return nil, st.Err()
 		}
-		return &pb.AddUserToAccountResponse{}, nil
 	}
-	// 检查账户和用户之间是否存在关联关系
-	assocSqlConfig := fmt.Sprintf("SELECT DISTINCT user FROM %s_assoc_table WHERE user = ? AND acct = ? AND deleted = 0", clusterName)
-	err = caller.DB.QueryRow(assocSqlConfig, in.UserId, in.AccountName).Scan(&user)
 
-	if err != nil {
-		for _, v := range partitions {
-			createUserCmd := fmt.Sprintf("sacctmgr -i create user name='%s' partition='%s' account='%s'", in.UserId, v, in.AccountName)
-			modifyUserCmd := fmt.Sprintf("sacctmgr -i modify user %s set qos='%s' DefaultQOS='%s'", in.UserId, baseQos, defaultQos)
-			retCode1 := utils.ExecuteShellCommand(createUserCmd)
-			if retCode1 != 0 {
-				errInfo := &errdetails.ErrorInfo{
-					Reason: "EXEC_COMMAND_FAILED",
-				}
-				st := status.New(codes.AlreadyExists, "Command exec fail. ")
-				st, _ = st.WithDetails(errInfo)
-				caller.Logger.Errorf("AddUserToAccount failed: %v", st.Err())
-				return nil, st.Err()
+	for _, p := range partitions {
+		if err := caller.GetBackend(ctx).AddUserToAccount(in.UserId, in.AccountName, p.Name); err != nil {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "EXEC_COMMAND_FAILED",
 			}
-			retCode2 := utils.ExecuteShellCommand(modifyUserCmd)
-			if retCode2 != 0 {
-				errInfo := &errdetails.ErrorInfo{
-					Reason: "EXEC_COMMAND_FAILED",
-				}
-				st := status.New(codes.AlreadyExists, "Command exec fail.")
-				st, _ = st.WithDetails(errInfo)
-				caller.Logger.Errorf("AddUserToAccount failed: %v", st.Err())
-				return nil, st.Err()
+			st := status.New(codes.AlreadyExists, "Command exec fail.")
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("AddUserToAccount failed: %v", st.Err())
+			return nil, st.Err()
+		}
+		if err := caller.GetBackend(ctx).SetUserQos(in.UserId, qosList, defaultQos); err != nil {
+			errInfo := &errdetails.ErrorInfo{
+				Reason: "EXEC_COMMAND_FAILED",
 			}
+			st := status.New(codes.AlreadyExists, "Command exec fail.")
+			st, _ = st.WithDetails(errInfo)
+			caller.Logger.Errorf("AddUserToAccount failed: %v", st.Err())
+			return nil, st.Err()
 		}
-		caller.Logger.Infof("AddUserToAccount sucess! User is: %v, Account is: %v", in.UserId, in.AccountName)
-		return &pb.AddUserToAccountResponse{}, nil
 	}
-	// 关联已经存在的情况
-	errInfo := &errdetails.ErrorInfo{
-		Reason: "USER_ALREADY_EXISTS",
-	}
-	st := status.New(codes.AlreadyExists, "The user already exists in account.")
-	st, _ = st.WithDetails(errInfo)
-	caller.Logger.Errorf("AddUserToAccount failed: %v", st.Err())
-	return nil, st.Err()
+	caller.Logger.Infof("AddUserToAccount sucess! User is: %v, Account is: %v", in.UserId, in.AccountName)
+	return &pb.AddUserToAccountResponse{}, nil
 }
 
-func (s *ServerUser) RemoveUserFromAccount(ctx context.Context, in *pb.RemoveUserFromAccountRequest) (*pb.RemoveUserFromAccountResponse, error) {
-	var (
-		acctName string
-		userName string
-		user     string
-		acct     string
-		jobName  string
-		jobList  []string
-		acctList []string
-	)
-	caller.Logger.Infof("Received request RemoveUserFromAccount: %v", in)
-	clusterName := caller.ConfigValue.MySQLConfig.ClusterName
-	resultAcct := utils.CheckAccountOrUserStrings(in.AccountName)
-	resultUser := utils.CheckAccountOrUserStrings(in.UserId)
-	if !resultAcct || !resultUser {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "ACCOUNT_USER_CONTAIN_ILLEGAL_CHARACTERS",
-		}
-		st := status.New(codes.Internal, "The account or username contains illegal characters.")
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("RemoveUserFromAccount failed: %v", st.Err())
-		return nil, st.Err()
-	}
-
-	// 检查账号名是否在slurm中
-	acctSqlConfig := "SELECT name FROM acct_table WHERE name = ? AND deleted = 0"
-	err := caller.DB.QueryRow(acctSqlConfig, in.AccountName).Scan(&acctName)
-	if err != nil {
+// 检查账户、用户以及两者之间的关联关系是否存在, 不存在时返回对应的NotFound错误
+func checkUserInAccount(ctx context.Context, rpc string, userId string, accountName string) error {
+	acctExists, err := caller.GetBackend(ctx).AccountExists(accountName)
+	if err != nil || !acctExists {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "ACCOUNT_NOT_FOUND",
 		}
-		message := fmt.Sprintf("%s does not exists.", in.AccountName)
+		message := fmt.Sprintf("%s does not exists.", accountName)
 		st := status.New(codes.NotFound, message)
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("RemoveUserFromAccount failed: %v", st.Err())
-		return nil, st.Err()
+		caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
+		return st.Err()
 	}
-	// 检查用户名是否在slurm中
-	userSqlConfig := "SELECT name FROM user_table WHERE name = ? AND deleted = 0"
-	err = caller.DB.QueryRow(userSqlConfig, in.UserId).Scan(&userName)
-	if err != nil {
+	userExists, err := caller.GetBackend(ctx).UserExists(userId)
+	if err != nil || !userExists {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "USER_NOT_FOUND",
 		}
-		message := fmt.Sprintf("%s does not exists.", in.UserId)
+		message := fmt.Sprintf("%s does not exists.", userId)
 		st := status.New(codes.NotFound, message)
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("RemoveUserFromAccount failed: %v", st.Err())
-		return nil, st.Err()
+		caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
+		return st.Err()
 	}
-
-	// 检查账户和用户之间是否存在关联关系
-	assocSqlConfig := fmt.Sprintf("SELECT DISTINCT user FROM %s_assoc_table WHERE user = ? AND acct = ? AND deleted = 0", clusterName)
-	err = caller.DB.QueryRow(assocSqlConfig, in.UserId, in.AccountName).Scan(&user)
-	if err != nil {
+	assocExists, err := backend.AssociationExists(caller.GetBackend(ctx), userId, accountName)
+	if err != nil || !assocExists {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "USER_ACCOUNT_NOT_FOUND",
 		}
-		message := fmt.Sprintf("%s and %s assocation is not exists!", in.UserId, in.AccountName)
+		message := fmt.Sprintf("%s and %s assocation is not exists!", userId, accountName)
 		st := status.New(codes.NotFound, message)
 		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("RemoveUserFromAccount failed: %v", st.Err())
-		return nil, st.Err()
+		caller.Logger.Errorf("%s failed: %v", rpc, st.Err())
+		return st.Err()
 	}
+	return nil
+}
 
-	// 查询除当前账户外的关联账户信息
-	assocAcctSqlConfig := fmt.Sprintf("SELECT DISTINCT acct FROM %s_assoc_table WHERE user = ? AND deleted = 0 AND acct != ?", clusterName)
-	rows, err := caller.DB.Query(assocAcctSqlConfig, in.UserId, in.AccountName)
-	if err != nil {
+func (s *ServerUser) RemoveUserFromAccount(ctx context.Context, in *pb.RemoveUserFromAccountRequest) (*pb.RemoveUserFromAccountResponse, error) {
+	var (
+		acctList []string
+	)
+	caller.Logger.Infof("Received request RemoveUserFromAccount: %v", in)
+	resultAcct := utils.CheckAccountOrUserStrings(in.AccountName)
+	resultUser := utils.CheckAccountOrUserStrings(in.UserId)
+	if !resultAcct || !resultUser {
 		errInfo := &errdetails.ErrorInfo{
-			Reason: "SQL_QUERY_FAILED",
+			Reason: "ACCOUNT_USER_CONTAIN_ILLEGAL_CHARACTERS",
 		}
-		st := status.New(codes.Internal, err.Error())
+		st := status.New(codes.Internal, "The account or username contains illegal characters.")
 		st, _ = st.WithDetails(errInfo)
 		caller.Logger.Errorf("RemoveUserFromAccount failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	defer rows.Close()
-	for rows.Next() {
-		err := rows.Scan(&acct)
-		if err != nil {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "SQL_QUERY_FAILED",
-			}
-			st := status.New(codes.Internal, err.Error())
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("RemoveUserFromAccount failed: %v", st.Err())
-			return nil, st.Err()
-		}
-		acctList = append(acctList, acct)
+
+	if err := checkUserInAccount(ctx, "RemoveUserFromAccount", in.UserId, in.AccountName); err != nil {
+		return nil, err
 	}
-	err = rows.Err()
+
+	// 查询除当前账户外的关联账户信息
+	assocs, err := caller.GetBackend(ctx).ListAssociations(&backend.AssociationFilter{User: in.UserId})
 	if err != nil {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "SQL_QUERY_FAILED",
@@ -279,22 +180,18 @@ func (s *ServerUser) RemoveUserFromAccount(ctx context.Context, in *pb.RemoveUse
 		caller.Logger.Errorf("RemoveUserFromAccount failed: %v", st.Err())
 		return nil, st.Err()
 	}
-
-	// 查询用户uid
-	uid, _, err := utils.GetUserUidGid(in.UserId)
-	if err != nil {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "USER_NOT_FOUND",
+	for _, assoc := range assocs {
+		if assoc.Account != in.AccountName && arrays.ContainsString(acctList, assoc.Account) == -1 {
+			acctList = append(acctList, assoc.Account)
 		}
-		message := fmt.Sprintf("%s does not exists.", in.UserId)
-		st := status.New(codes.NotFound, message)
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("RemoveUserFromAccount failed: %v", st.Err())
-		return nil, st.Err()
 	}
+
 	// 检查用户是否有未结束的作业
-	jobSqlConfig := fmt.Sprintf("SELECT job_name FROM %s_job_table WHERE id_user = ? AND account = ? AND state IN (0, 1, 2)", clusterName)
-	jobRows, err := caller.DB.Query(jobSqlConfig, uid, in.AccountName)
+	jobList, _, err := caller.GetBackend(ctx).QueryJobs(&backend.JobQuery{
+		Users:    []string{in.UserId},
+		Accounts: []string{in.AccountName},
+		States:   []string{"PENDING", "RUNNING", "SUSPENDED"},
+	})
 	if err != nil {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "SQL_QUERY_FAILED",
@@ -304,48 +201,22 @@ func (s *ServerUser) RemoveUserFromAccount(ctx context.Context, in *pb.RemoveUse
 		caller.Logger.Errorf("RemoveUserFromAccount failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	defer jobRows.Close()
-	for jobRows.Next() {
-		err := jobRows.Scan(&jobName)
-		if err != nil {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "SQL_QUERY_FAILED",
-			}
-			st := status.New(codes.Internal, err.Error())
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("RemoveUserFromAccount failed: %v", st.Err())
-			return nil, st.Err()
-		}
-		jobList = append(jobList, jobName)
-	}
-	err = jobRows.Err()
-	if err != nil {
+
+	// 有作业直接出错返回
+	if len(jobList) != 0 {
 		errInfo := &errdetails.ErrorInfo{
-			Reason: "SQL_QUERY_FAILED",
+			Reason: "RUNNING_JOB_EXISTS",
 		}
-		st := status.New(codes.Internal, err.Error())
+		message := fmt.Sprintf("The %s have running jobs!", in.UserId)
+		st := status.New(codes.Internal, message)
 		st, _ = st.WithDetails(errInfo)
 		caller.Logger.Errorf("RemoveUserFromAccount failed: %v", st.Err())
 		return nil, st.Err()
 	}
 
 	if len(acctList) == 0 {
-		// 有作业直接出错返回
-		if len(jobList) != 0 {
-			errInfo := &errdetails.ErrorInfo{
-				Reason: "RUNNING_JOB_EXISTS",
-			}
-			message := fmt.Sprintf("The %s have running jobs!", in.UserId)
-			st := status.New(codes.Internal, message)
-			st, _ = st.WithDetails(errInfo)
-			caller.Logger.Errorf("RemoveUserFromAccount failed: %v", st.Err())
-			return nil, st.Err()
-		}
-
 		// 没作业下直接删除用户
-		deletedUserCmd := fmt.Sprintf("sacctmgr -i delete user name=%s", in.UserId)
-		res := utils.ExecuteShellCommand(deletedUserCmd)
-		if res == 0 {
+		if err := caller.GetBackend(ctx).DeleteUser(in.UserId); err == nil {
 			caller.Logger.Infof("RemoveUserFromAccount sucess! User is: %v, Account is: %v", in.UserId, in.AccountName)
 			return &pb.RemoveUserFromAccountResponse{}, nil
 		}
@@ -358,19 +229,7 @@ func (s *ServerUser) RemoveUserFromAccount(ctx context.Context, in *pb.RemoveUse
 		return nil, st.Err()
 	}
 	// 更改默认账号
-	if len(jobList) != 0 {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "RUNNING_JOB_EXISTS",
-		}
-		message := fmt.Sprintf("The %s have running jobs!", in.UserId)
-		st := status.New(codes.Internal, message)
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("RemoveUserFromAccount failed: %v", st.Err())
-		return nil, st.Err()
-	}
-	updateDefaultAcctCmd := fmt.Sprintf("sacctmgr -i update user set DefaultAccount=%s where user=%s", acctList[0], in.UserId)
-	retcode1 := utils.ExecuteShellCommand(updateDefaultAcctCmd)
-	if retcode1 != 0 {
+	if err := caller.GetBackend(ctx).SetUserDefaultAccount(in.UserId, acctList[0]); err != nil {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "COMMAND_EXECUTE_FAILED",
 		}
@@ -379,9 +238,7 @@ func (s *ServerUser) RemoveUserFromAccount(ctx context.Context, in *pb.RemoveUse
 		caller.Logger.Errorf("RemoveUserFromAccount failed: %v", st.Err())
 		return nil, st.Err()
 	}
-	deleteUerFromAcctCmd := fmt.Sprintf("sacctmgr -i delete user name=%s account=%s", in.UserId, in.AccountName)
-	retcode2 := utils.ExecuteShellCommand(deleteUerFromAcctCmd)
-	if retcode2 != 0 {
+	if err := caller.GetBackend(ctx).RemoveUserFromAccount(in.UserId, in.AccountName); err != nil {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "COMMAND_EXECUTE_FAILED",
 		}
@@ -394,11 +251,6 @@ func (s *ServerUser) RemoveUserFromAccount(ctx context.Context, in *pb.RemoveUse
 }
 
 func (s *ServerUser) BlockUserInAccount(ctx context.Context, in *pb.BlockUserInAccountRequest) (*pb.BlockUserInAccountResponse, error) {
-	var (
-		acctName string
-		userName string
-		user     string
-	)
 	caller.Logger.Infof("Received request BlockUserInAccount: %v", in)
 	resultAcct := utils.CheckAccountOrUserStrings(in.AccountName)
 	resultUser := utils.CheckAccountOrUserStrings(in.UserId)
@@ -412,49 +264,11 @@ func (s *ServerUser) BlockUserInAccount(ctx context.Context, in *pb.BlockUserInA
 		return nil, st.Err()
 	}
 
-	clusterName := caller.ConfigValue.MySQLConfig.ClusterName
-	// 检查账户是否在slurm中
-	acctSqlConfig := "SELECT name FROM acct_table WHERE name = ? AND deleted = 0"
-	err := caller.DB.QueryRow(acctSqlConfig, in.AccountName).Scan(&acctName)
-	if err != nil {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "SQL_QUERY_FAILED",
-		}
-		st := status.New(codes.Internal, err.Error())
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("BlockUserInAccount failed: %v", st.Err())
-		return nil, st.Err()
-	}
-	// 检查用户是否在slurm中
-	userSqlConfig := "SELECT name FROM user_table WHERE name = ? AND deleted = 0"
-	err = caller.DB.QueryRow(userSqlConfig, in.UserId).Scan(&userName)
-	if err != nil {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "SQL_QUERY_FAILED",
-		}
-		st := status.New(codes.Internal, err.Error())
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("BlockUserInAccount failed: %v", st.Err())
-		return nil, st.Err()
-	}
-	// 检查账户与用户是否存在关联关系
-	assocSqlConfig := fmt.Sprintf("SELECT DISTINCT user FROM %s_assoc_table WHERE user = ? AND acct = ? AND deleted = 0", clusterName)
-	err = caller.DB.QueryRow(assocSqlConfig, in.UserId, in.AccountName).Scan(&user)
-
-	if err != nil {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "USER_ACCOUNT_NOT_FOUND",
-		}
-		message := fmt.Sprintf("%s and %s assocation is not exists!", in.UserId, in.AccountName)
-		st := status.New(codes.NotFound, message)
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("BlockUserInAccount failed: %v", st.Err())
-		return nil, st.Err()
+	if err := checkUserInAccount(ctx, "BlockUserInAccount", in.UserId, in.AccountName); err != nil {
+		return nil, err
 	}
 	// 关联存在的情况下直接封锁账户
-	blockUserCmd := fmt.Sprintf("sacctmgr -i -Q modify user where name=%s account=%s set MaxSubmitJobs=0 MaxJobs=0 GrpJobs=0 GrpSubmit=0 GrpSubmitJobs=0 MaxSubmitJobs=0", in.UserId, in.AccountName)
-	res := utils.ExecuteShellCommand(blockUserCmd)
-	if res == 0 {
+	if err := caller.GetBackend(ctx).BlockUserInAccount(in.UserId, in.AccountName); err == nil {
 		caller.Logger.Infof("BlockUserInAccount sucess! User is: %v, Account is: %v", in.UserId, in.AccountName)
 		return &pb.BlockUserInAccountResponse{}, nil
 	}
@@ -467,13 +281,21 @@ func (s *ServerUser) BlockUserInAccount(ctx context.Context, in *pb.BlockUserInA
 	return nil, st.Err()
 }
 
+// 查询用户在账户中是否被封锁, 最大提交作业数未设置表示没被封锁
+func isUserBlocked(ctx context.Context, userId string, accountName string) (bool, error) {
+	assocs, err := caller.GetBackend(ctx).ListAssociations(&backend.AssociationFilter{User: userId, Account: accountName})
+	if err != nil {
+		return false, err
+	}
+	for _, assoc := range assocs {
+		if assoc.MaxSubmitJobs != nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (s *ServerUser) UnblockUserInAccount(ctx context.Context, in *pb.UnblockUserInAccountRequest) (*pb.UnblockUserInAccountResponse, error) {
-	var (
-		acctName      string
-		userName      string
-		user          string
-		maxSubmitJobs int
-	)
 	caller.Logger.Infof("Received request UnblockUserInAccount: %v", in)
 	resultAcct := utils.CheckAccountOrUserStrings(in.AccountName)
 	resultUser := utils.CheckAccountOrUserStrings(in.UserId)
@@ -487,58 +309,17 @@ func (s *ServerUser) UnblockUserInAccount(ctx context.Context, in *pb.UnblockUse
 		return nil, st.Err()
 	}
 
-	clusterName := caller.ConfigValue.MySQLConfig.ClusterName
-	// 检查账户是否在slurm中
-	acctSqlConfig := "SELECT name FROM acct_table WHERE name = ? AND deleted = 0"
-	err := caller.DB.QueryRow(acctSqlConfig, in.AccountName).Scan(&acctName)
-	if err != nil {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "ACCOUNT_NOT_FOUND",
-		}
-		mesaage := fmt.Sprintf("%s does not exists.", in.AccountName)
-		st := status.New(codes.NotFound, mesaage)
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("UnblockUserInAccount failed: %v", st.Err())
-		return nil, st.Err()
-	}
-	//  检查用户是否在slurm中
-	userSqlConfig := "SELECT name FROM user_table WHERE name = ? AND deleted = 0"
-	err = caller.DB.QueryRow(userSqlConfig, in.UserId).Scan(&userName)
-	if err != nil {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "USER_NOT_FOUND",
-		}
-		message := fmt.Sprintf("%s does not exists.", in.UserId)
-		st := status.New(codes.NotFound, message)
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("UnblockUserInAccount failed: %v", st.Err())
-		return nil, st.Err()
-	}
-
-	// 检查账户与用户是否存在关联关系
-	assocSqlConfig := fmt.Sprintf("SELECT DISTINCT user FROM %s_assoc_table WHERE user = ? AND acct = ? AND deleted = 0", clusterName)
-	err = caller.DB.QueryRow(assocSqlConfig, in.UserId, in.AccountName).Scan(&user)
-	if err != nil {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "USER_ACCOUNT_NOT_FOUND",
-		}
-		message := fmt.Sprintf("%s and %s assocation is not exists!", in.UserId, in.AccountName)
-		st := status.New(codes.NotFound, message)
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("UnblockUserInAccount failed: %v", st.Err())
-		return nil, st.Err()
+	if err := checkUserInAccount(ctx, "UnblockUserInAccount", in.UserId, in.AccountName); err != nil {
+		return nil, err
 	}
 	// 最大提交作业数为NULL表示没被封锁
-	maxSubmitJobsSqlConfig := fmt.Sprintf("SELECT DISTINCT max_submit_jobs FROM %s_assoc_table WHERE user = ? AND acct = ? AND deleted = 0", clusterName)
-	err = caller.DB.QueryRow(maxSubmitJobsSqlConfig, in.UserId, in.AccountName).Scan(&maxSubmitJobs)
-	if err != nil {
+	blocked, err := isUserBlocked(ctx, in.UserId, in.AccountName)
+	if err != nil || !blocked {
 		caller.Logger.Infof("UnblockUserInAccount sucess! User id: %v, Account is: %v", in.UserId, in.AccountName)
 		return &pb.UnblockUserInAccountResponse{}, nil
 	}
 	// 用户从账户中解封的操作
-	unblockUserCmd := fmt.Sprintf("sacctmgr -i -Q modify user where name='%s' account='%s' set MaxSubmitJobs=-1 MaxJobs=-1 GrpJobs=-1 GrpSubmit=-1 GrpSubmitJobs=-1 MaxSubmitJobs=-1", in.UserId, in.AccountName)
-	res := utils.ExecuteShellCommand(unblockUserCmd)
-	if res == 0 {
+	if err := caller.GetBackend(ctx).UnblockUserInAccount(in.UserId, in.AccountName); err == nil {
 		return &pb.UnblockUserInAccountResponse{}, nil
 	}
 	errInfo := &errdetails.ErrorInfo{
@@ -551,12 +332,6 @@ func (s *ServerUser) UnblockUserInAccount(ctx context.Context, in *pb.UnblockUse
 }
 
 func (s *ServerUser) QueryUserInAccountBlockStatus(ctx context.Context, in *pb.QueryUserInAccountBlockStatusRequest) (*pb.QueryUserInAccountBlockStatusResponse, error) {
-	var (
-		acctName      string
-		userName      string
-		user          string
-		maxSubmitJobs int
-	)
 	// 记录日志
 	caller.Logger.Infof("Received request QueryUserInAccountBlockStatus: %v", in)
 	// 检查账户名、用户名是否包含大写字母
@@ -572,51 +347,12 @@ func (s *ServerUser) QueryUserInAccountBlockStatus(ctx context.Context, in *pb.Q
 		return nil, st.Err()
 	}
 
-	clusterName := caller.ConfigValue.MySQLConfig.ClusterName
-	// 判断账户是否在slurm中
-	acctSqlConfig := "SELECT name FROM acct_table WHERE name = ? AND deleted = 0"
-	err := caller.DB.QueryRow(acctSqlConfig, in.AccountName).Scan(&acctName)
-	if err != nil {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "ACCOUNT_NOT_FOUND",
-		}
-		message := fmt.Sprintf("%s does not exists.", in.AccountName)
-		st := status.New(codes.NotFound, message)
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("QueryUserInAccountBlockStatus failed: %v", st.Err())
-		return nil, st.Err()
+	if err := checkUserInAccount(ctx, "QueryUserInAccountBlockStatus", in.UserId, in.AccountName); err != nil {
+		return nil, err
 	}
-	// 判断用户是否在slurm中
-	userSqlConfig := "SELECT name FROM user_table WHERE name = ? AND deleted = 0"
-	err = caller.DB.QueryRow(userSqlConfig, in.UserId).Scan(&userName)
-	if err != nil {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "USER_NOT_FOUND",
-		}
-		message := fmt.Sprintf("%s does not exists.", in.UserId)
-		st := status.New(codes.NotFound, message)
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("QueryUserInAccountBlockStatus failed: %v", st.Err())
-		return nil, st.Err()
-	}
-
-	// 检查账户与用户在slurm中是否存在关联关系
-	assocSqlConfig := fmt.Sprintf("SELECT DISTINCT user FROM %s_assoc_table WHERE user = ? AND acct = ? AND deleted = 0", clusterName)
-	err = caller.DB.QueryRow(assocSqlConfig, in.UserId, in.AccountName).Scan(&user)
-	if err != nil {
-		errInfo := &errdetails.ErrorInfo{
-			Reason: "USER_ACCOUNT_NOT_FOUND",
-		}
-		message := fmt.Sprintf("%s and %s assocation is not exists!", in.UserId, in.AccountName)
-		st := status.New(codes.NotFound, message)
-		st, _ = st.WithDetails(errInfo)
-		caller.Logger.Errorf("QueryUserInAccountBlockStatus failed: %v", st.Err())
-		return nil, st.Err()
-	}
-	// 查询max_submit_jobs的值,通过max_submit_jobs来判断用户是否被封锁
-	maxSubmitJobSqlConfig := fmt.Sprintf("SELECT DISTINCT max_submit_jobs FROM %s_assoc_table WHERE user = ? AND acct = ? AND deleted = 0", clusterName)
-	err = caller.DB.QueryRow(maxSubmitJobSqlConfig, in.UserId, in.AccountName).Scan(&maxSubmitJobs)
-	if err != nil {
+	// 通过max_submit_jobs来判断用户是否被封锁
+	blocked, err := isUserBlocked(ctx, in.UserId, in.AccountName)
+	if err != nil || !blocked {
 		caller.Logger.Infof("User %v In Account %v is Unblocked Status", in.UserId, in.AccountName)
 		return &pb.QueryUserInAccountBlockStatusResponse{Blocked: false}, nil
 	}
@@ -626,12 +362,8 @@ func (s *ServerUser) QueryUserInAccountBlockStatus(ctx context.Context, in *pb.Q
 
 func (s *ServerUser) DeleteUser(ctx context.Context, in *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
 	// 检查用户是不是存在
-	var (
-		userName string
-	)
-	userSqlConfig := "SELECT name FROM user_table WHERE name = ? AND deleted = 0"
-	err := caller.DB.QueryRow(userSqlConfig, in.UserId).Scan(&userName)
-	if err != nil {
+	exists, err := caller.GetBackend(ctx).UserExists(in.UserId)
+	if err != nil || !exists {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "USER_NOT_FOUND",
 		}
@@ -643,9 +375,7 @@ func (s *ServerUser) DeleteUser(ctx context.Context, in *pb.DeleteUserRequest) (
 	}
 
 	// 作业的判断
-	userRunningJobInfoCmd := fmt.Sprintf("squeue --noheader -A %s", in.UserId)
-	runningJobInfo, err := utils.RunCommand(userRunningJobInfoCmd)
-
+	runningJobs, err := caller.GetBackend(ctx).ListQueueJobs(&backend.QueueFilter{Users: []string{in.UserId}})
 	if err != nil {
 		errInfo := &errdetails.ErrorInfo{
 			Reason: "CMD_EXECUTE_FAILED",
@@ -656,9 +386,8 @@ func (s *ServerUser) DeleteUser(ctx context.Context, in *pb.DeleteUserRequest) (
 		return nil, st.Err()
 	}
 
-	if len(runningJobInfo) == 0 {
-		deleteUserCmd := fmt.Sprintf("sacctmgr -i delete user name=%s", in.UserId)
-		_, err = utils.RunCommand(deleteUserCmd)
+	if len(runningJobs) == 0 {
+		err = caller.GetBackend(ctx).DeleteUser(in.UserId)
 		if err != nil {
 			errInfo := &errdetails.ErrorInfo{
 				Reason: "CMD_EXECUTE_FAILED",
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	pb "scow-slurm-adapter/gen/go"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestGetAppConnectionInfo(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewAppServiceClient(conn)
+
+	req := &pb.GetAppConnectionInfoRequest{
+		JobId: 1274,
+	}
+	res, err := client.GetAppConnectionInfo(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetAppConnectionInfo failed: %v", err)
+	}
+
+	// Check the result, 运行中的交互式应用返回连接信息
+	assert.NotEmpty(t, res.GetAppConnectionInfo().GetHost())
+	assert.NotZero(t, res.GetAppConnectionInfo().GetPort())
+}
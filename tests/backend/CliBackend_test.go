@@ -0,0 +1,285 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"scow-slurm-adapter/backend"
+	"scow-slurm-adapter/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 假的执行器, 记录调用参数并返回预设的结果
+type fakeExecutor struct {
+	calls  [][]string
+	result map[string]*utils.CommandResult
+}
+
+func (f *fakeExecutor) Execute(name string, args ...string) (*utils.CommandResult, error) {
+	return f.ExecuteWithStdin("", name, args...)
+}
+
+func (f *fakeExecutor) ExecuteWithStdin(stdin string, name string, args ...string) (*utils.CommandResult, error) {
+	f.calls = append(f.calls, append([]string{name}, args...))
+	if result, ok := f.result[name]; ok {
+		return result, nil
+	}
+	return &utils.CommandResult{}, nil
+}
+
+func newCliBackend() backend.Backend {
+	b, _ := backend.New(&utils.Config{}, nil)
+	return b
+}
+
+func TestCliListQueueJobs(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"squeue": {Stdout: "12|acct|alice|compute|normal|RUNNING|None|8|2|1:00:00|10:00|2024-01-02T03:04:05|/home/alice|cn[01-02]|gres/gpu:2|12|N/A|(null)|12|N/A|my|job\n" +
+			"13|acct|alice|compute|normal|PENDING|(Priority)|4|1|UNLIMITED|0:00|2024-01-02T03:04:05|/home/alice|(Priority)|N/A|13|N/A|afterok:12(unfulfilled)|13|2024-01-03T00:00:00|job2\n"},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+
+	name := "job2"
+	jobs, err := newCliBackend().ListQueueJobs(&backend.QueueFilter{Users: []string{"alice"}, States: []string{"PENDING", "RUNNING"}, JobName: &name})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"squeue", "--noheader", "--format=%A|%a|%u|%P|%q|%T|%r|%C|%D|%l|%M|%V|%Z|%N|%b|%F|%K|%E|%i|%S|%j", "-u", "alice", "-t", "pending,running", "-n", "job2"}, fake.calls[0])
+	assert.Len(t, jobs, 2)
+	// 作业名中的分隔符不影响解析
+	assert.Equal(t, "my|job", jobs[0].Name)
+	assert.Equal(t, "", jobs[0].Reason)
+	assert.Equal(t, "", jobs[0].Dependency)
+	assert.Equal(t, int64(60), jobs[0].TimeLimitMinutes)
+	assert.Equal(t, int64(600), jobs[0].ElapsedSeconds)
+	assert.Equal(t, int32(2), jobs[0].GpusPerNode)
+	assert.Equal(t, "Priority", jobs[1].Reason)
+	assert.Equal(t, "afterok:12(unfulfilled)", jobs[1].Dependency)
+	assert.Equal(t, int64(0), jobs[1].TimeLimitMinutes)
+	assert.Equal(t, int32(0), jobs[1].GpusPerNode)
+	assert.Equal(t, uint32(0), jobs[1].ArrayJobId)
+	// 只有排队作业有预计开始时间
+	assert.Equal(t, int64(0), jobs[0].ScheduledStartTime)
+	startTime, _ := time.ParseInLocation("2006-01-02T15:04:05", "2024-01-03T00:00:00", time.Local)
+	assert.Equal(t, startTime.Unix(), jobs[1].ScheduledStartTime)
+}
+
+func TestCliListQueueJobsArray(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"squeue": {Stdout: "21|acct|alice|compute|normal|RUNNING|None|1|1|10:00|1:00|2024-01-02T03:04:05|/home/alice|cn01|N/A|20|1|(null)|20_1|N/A|array\n" +
+			"20|acct|alice|compute|normal|PENDING|(JobArrayTaskLimit)|1|1|10:00|0:00|2024-01-02T03:04:05|/home/alice||N/A|20|[2-10%2]|(null)|20_[2-10%2]|N/A|array\n" +
+			"30|acct|alice|compute|normal|RUNNING|None|1|1|10:00|1:00|2024-01-02T03:04:05|/home/alice|cn02|N/A|30|N/A|(null)|30|N/A|other\n"},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+
+	arrayJobId := uint32(20)
+	jobs, err := newCliBackend().ListQueueJobs(&backend.QueueFilter{ArrayJobId: &arrayJobId})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"squeue", "--noheader", "--format=%A|%a|%u|%P|%q|%T|%r|%C|%D|%l|%M|%V|%Z|%N|%b|%F|%K|%E|%i|%S|%j", "-j", "20"}, fake.calls[0])
+	assert.Len(t, jobs, 2)
+	assert.Equal(t, uint32(21), jobs[0].JobId)
+	assert.Equal(t, uint32(20), jobs[0].ArrayJobId)
+	assert.Equal(t, "1", jobs[0].ArrayTaskId)
+	assert.Equal(t, "2-10%2", jobs[1].ArrayTaskId)
+}
+
+func TestCliSubmitAndCancelArrayJob(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"su": {Stdout: "Submitted batch job 20 on cluster hpc\n"},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+	// slurm命令的路径从配置文件中读取
+	utils.DefaultConfigPath = "../../config/config.yaml"
+
+	b := newCliBackend()
+	jobId, err := b.SubmitJob("alice", "#!/bin/bash\n#SBATCH --array=1-10\nhostname\n")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(20), jobId)
+
+	arrayTaskId := uint32(3)
+	assert.Nil(t, b.CancelJob("alice", 20, &arrayTaskId))
+	assert.Contains(t, fake.calls[1][len(fake.calls[1])-1], "scancel '20_3'")
+	assert.Nil(t, b.CancelJob("alice", 20, nil))
+	assert.Contains(t, fake.calls[2][len(fake.calls[2])-1], "scancel '20'")
+}
+
+func TestCliControlJob(t *testing.T) {
+	utils.DefaultConfigPath = "../../config/config.yaml"
+	fake := &fakeExecutor{}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+
+	b := newCliBackend()
+	arrayTaskId := uint32(3)
+	// 用户的操作以用户身份执行
+	assert.Nil(t, b.ControlJob("alice", 20, &arrayTaskId, backend.JobActionHold))
+	assert.Equal(t, "su", fake.calls[0][0])
+	assert.Contains(t, fake.calls[0][len(fake.calls[0])-1], "scontrol 'hold' '20_3'")
+	// 管理员的操作直接执行
+	assert.Nil(t, b.ControlJob("", 20, nil, backend.JobActionSuspend))
+	assert.Equal(t, []string{"scontrol", "suspend", "20"}, fake.calls[1])
+	assert.Nil(t, b.SetJobAdminComment(20, nil, "maintenance"))
+	assert.Equal(t, []string{"scontrol", "update", "job=20", "AdminComment=maintenance"}, fake.calls[2])
+}
+
+func TestCliTestSubmitJob(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"su": {Stderr: "sbatch: Job 3456 to start at 2024-01-02T03:04:05 using 4 processors on nodes cn[01-02] in partition compute\n"},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+	utils.DefaultConfigPath = "../../config/config.yaml"
+
+	b := newCliBackend()
+	estimate, err := b.TestSubmitJob("alice", "#!/bin/bash\nhostname\n")
+	assert.Nil(t, err)
+	assert.Contains(t, fake.calls[0][len(fake.calls[0])-1], "sbatch --test-only")
+	assert.Equal(t, "", estimate.RejectReason)
+	assert.Equal(t, "cn[01-02]", estimate.NodeList)
+	assert.Equal(t, "compute", estimate.Partition)
+	assert.Equal(t, int32(4), estimate.Cpus)
+	startTime, _ := time.ParseInLocation("2006-01-02T15:04:05", "2024-01-02T03:04:05", time.Local)
+	assert.Equal(t, startTime.Unix(), estimate.StartTime)
+
+	// slurm拒绝作业时返回原因
+	fake.result["su"] = &utils.CommandResult{Stderr: "sbatch: error: Batch job submission failed: Invalid qos specification\n", ExitCode: 1}
+	estimate, err = b.TestSubmitJob("alice", "#!/bin/bash\nhostname\n")
+	assert.Nil(t, err)
+	assert.Equal(t, "Invalid qos specification", estimate.RejectReason)
+}
+
+func TestCliCancelJobs(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"scancel": {Stderr: "scancel: error: Kill job error on job id 12: Job/step already completing or completed\n", ExitCode: 1},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+
+	results, err := newCliBackend().CancelJobs("", []string{"12", "13", "20_[2-10]"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"scancel", "12", "13", "20_[2-10]"}, fake.calls[0])
+	assert.Len(t, results, 1)
+	assert.Equal(t, "Job/step already completing or completed", results["12"].Error())
+
+	// 无法区分是哪个作业出错时整批都失败
+	fake.result["scancel"] = &utils.CommandResult{Stderr: "scancel: error: slurm_kill_job2 failed\n", ExitCode: 1}
+	results, err = newCliBackend().CancelJobs("", []string{"12", "13"})
+	assert.Nil(t, err)
+	assert.Len(t, results, 2)
+}
+
+func TestQueueJobSpec(t *testing.T) {
+	assert.Equal(t, "12", (&backend.QueueJob{JobId: 12}).JobSpec())
+	assert.Equal(t, "20_1", (&backend.QueueJob{JobId: 21, ArrayJobId: 20, ArrayTaskId: "1"}).JobSpec())
+	assert.Equal(t, "20_[2-10]", (&backend.QueueJob{JobId: 20, ArrayJobId: 20, ArrayTaskId: "2-10%2"}).JobSpec())
+}
+
+func TestCliListQueueJobsInvalidJobId(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"squeue": {Stderr: "slurm_load_jobs error: Invalid job id specified", ExitCode: 1},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+
+	jobs, err := newCliBackend().ListQueueJobs(&backend.QueueFilter{JobIds: []uint32{99}})
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 0)
+	assert.Equal(t, []string{"squeue", "--noheader", "--format=%A|%a|%u|%P|%q|%T|%r|%C|%D|%l|%M|%V|%Z|%N|%b|%F|%K|%E|%i|%S|%j", "-j", "99"}, fake.calls[0])
+}
+
+func TestCliPartitionStatus(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"sinfo": {Stdout: "gpu 32 16/16/0/32 gpu:4 up 1 1/0/0/1\ngpu 32 0/32/32/64 gpu:8 up 2 0/1/1/2\n"},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+
+	partitionStatus, err := newCliBackend().GetPartitionStatus("gpu")
+	assert.Nil(t, err)
+	assert.True(t, partitionStatus.Available)
+	assert.Equal(t, 3, partitionStatus.NodesTotal)
+	assert.Equal(t, 1, partitionStatus.NodesAlloc)
+	assert.Equal(t, 1, partitionStatus.NodesOther)
+	assert.Equal(t, 96, partitionStatus.CpusTotal)
+	assert.Equal(t, 4+16, partitionStatus.GpusTotal)
+	assert.Equal(t, 8, partitionStatus.GpusNotAvailable)
+}
+
+func TestCliGetJobOutput(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"scontrol": {Stdout: "JobId=21 ArrayJobId=20 ArrayTaskId=1 JobName=array UserId=alice(1000) GroupId=alice(1000) StdErr=/home/alice/err-20_1.log StdOut=/home/alice/out-20_1.log\n" +
+			"JobId=20 ArrayJobId=20 ArrayTaskId=2-10 JobName=array UserId=alice(1000) GroupId=alice(1000) StdErr=/home/alice/err-20_4294967294.log StdOut=/home/alice/out-20_4294967294.log\n"},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+
+	jobOutput, err := newCliBackend().GetJobOutput(21)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"scontrol", "show", "job", "--oneliner", "21"}, fake.calls[0])
+	assert.Equal(t, &backend.JobOutput{User: "alice", StdoutPath: "/home/alice/out-20_1.log", StderrPath: "/home/alice/err-20_1.log"}, jobOutput)
+}
+
+func TestCliListJobOutputs(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"scontrol": {Stdout: "JobId=5 JobName=a UserId=alice(1000) StdErr=/home/alice/a.err StdOut=/home/alice/a.out\n" +
+			"JobId=6 JobName=b UserId=bob(1001) StdErr=/home/bob/slurm-6.out StdOut=/home/bob/slurm-6.out\n"},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+
+	jobOutputs, err := newCliBackend().ListJobOutputs()
+	assert.Nil(t, err)
+	assert.Equal(t, map[uint32]*backend.JobOutput{
+		5: {User: "alice", StdoutPath: "/home/alice/a.out", StderrPath: "/home/alice/a.err"},
+		6: {User: "bob", StdoutPath: "/home/bob/slurm-6.out", StderrPath: "/home/bob/slurm-6.out"},
+	}, jobOutputs)
+
+	fake.result["scontrol"] = &utils.CommandResult{Stdout: "No jobs in the system\n"}
+	jobOutputs, err = newCliBackend().ListJobOutputs()
+	assert.Nil(t, err)
+	assert.Len(t, jobOutputs, 0)
+}
+
+func TestCliClusterBackend(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"squeue": {Stdout: "CLUSTER: c2\n12|acct|alice|compute|normal|RUNNING|None|8|2|1:00:00|10:00|2024-01-02T03:04:05|/home/alice|cn[01-02]|N/A|12|N/A|(null)|12|N/A|job\n"},
+		"su":     {Stdout: "Submitted batch job 13 on cluster c2\n"},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+	// slurm命令的路径从配置文件中读取
+	utils.DefaultConfigPath = "../../config/config.yaml"
+
+	b, err := backend.NewClusterBackend(&utils.Config{}, nil, &utils.ClusterConfig{Name: "c2"})
+	assert.Nil(t, err)
+
+	// 其他集群的命令加上-M, 并去掉输出中的集群名
+	jobs, err := b.ListQueueJobs(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"squeue", "-M", "c2", "--noheader"}, fake.calls[0][:4])
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, uint32(12), jobs[0].JobId)
+
+	jobId, err := b.SubmitJob("alice", "#!/bin/bash\n")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(13), jobId)
+	assert.Contains(t, fake.calls[1][4], "/bin/sbatch -M 'c2'")
+
+	// sacctmgr只修改该集群的关联关系
+	assert.Nil(t, b.BlockUserInAccount("alice", "acct"))
+	assert.Equal(t, []string{"sacctmgr", "-i", "-Q", "modify", "user", "where", "name=alice", "account=acct", "cluster=c2", "set"}, fake.calls[2][:10])
+
+	// 默认集群的sacctmgr命令也指定集群, 不影响同一个slurmdbd下的其他集群
+	fake.calls = nil
+	defaultBackend, _ := backend.New(&utils.Config{MySQLConfig: utils.MySQLConfig{ClusterName: "hpc"}}, nil)
+	assert.Nil(t, defaultBackend.BlockUserInAccount("alice", "acct"))
+	assert.Equal(t, []string{"sacctmgr", "-i", "-Q", "modify", "user", "where", "name=alice", "account=acct", "cluster=hpc", "set"}, fake.calls[0][:10])
+	assert.Nil(t, defaultBackend.CreateAccount("acct2"))
+	assert.Equal(t, []string{"sacctmgr", "-i", "create", "account", "name=acct2", "cluster=hpc"}, fake.calls[1])
+
+	// rest后端的其他集群需要配置自己的slurmrestd
+	_, err = backend.NewClusterBackend(&utils.Config{Slurm: utils.Slurm{Backend: backend.TypeRest}}, nil, &utils.ClusterConfig{Name: "c2"})
+	assert.NotNil(t, err)
+}
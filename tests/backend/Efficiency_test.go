@@ -0,0 +1,74 @@
+package main
+
+import (
+	"testing"
+
+	"scow-slurm-adapter/backend"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJobEfficiency(t *testing.T) {
+	job := &backend.Job{
+		JobId:            7,
+		CpusAlloc:        4,
+		MemAllocMb:       4096,
+		TimeLimitMinutes: 10,
+		StartTime:        1000,
+		EndTime:          1300,
+	}
+	steps := []*backend.JobStep{
+		{
+			StepId:         "batch",
+			Tasks:          1,
+			CpuTimeSeconds: 240,
+			TresUsageInMax: map[string]uint64{"mem": 1024 << 20},
+			TresUsageInAve: map[string]uint64{"mem": 512 << 20},
+		},
+		{
+			StepId:         "0",
+			Tasks:          4,
+			CpuTimeSeconds: 600,
+			TresUsageInTot: map[string]uint64{"mem": 2048 << 20},
+			TresUsageInAve: map[string]uint64{"mem": 256 << 20},
+		},
+	}
+
+	e := backend.NewJobEfficiency(job, steps)
+	assert.Equal(t, int64(300), e.ElapsedSeconds)
+	assert.Equal(t, int64(1200), e.CpuAllocSeconds)
+	assert.Equal(t, int64(840), e.CpuUsedSeconds)
+	assert.InDelta(t, 70.0, e.CpuEfficiency(), 0.001)
+	assert.Equal(t, int64(2048), e.MemPeakMb)
+	assert.Equal(t, int64(1024), e.MemAveMb)
+	assert.InDelta(t, 50.0, e.MemPeakEfficiency(), 0.001)
+	assert.InDelta(t, 25.0, e.MemAveEfficiency(), 0.001)
+	assert.InDelta(t, 50.0, e.TimeEfficiency(), 0.001)
+}
+
+func TestNewJobEfficiencyRunningStep(t *testing.T) {
+	// 运行中的作业步使用sstat的累计cpu时间(毫秒)
+	job := &backend.Job{JobId: 8, CpusAlloc: 2, MemReqMb: 1024}
+	steps := []*backend.JobStep{
+		{StepId: "0", Tasks: 2, TresUsageInTot: map[string]uint64{"cpu": 90000}},
+	}
+
+	e := backend.NewJobEfficiency(job, steps)
+	assert.Equal(t, int64(0), e.ElapsedSeconds)
+	assert.Equal(t, int64(90), e.CpuUsedSeconds)
+	assert.Equal(t, int64(1024), e.MemReqMb)
+	assert.Equal(t, float64(0), e.CpuEfficiency())
+	assert.Equal(t, float64(0), e.TimeEfficiency())
+}
+
+func TestEfficiencySummary(t *testing.T) {
+	summary := &backend.EfficiencySummary{Name: "alice"}
+	summary.Add(&backend.JobEfficiency{CpuUsedSeconds: 30, CpuAllocSeconds: 100, MemPeakMb: 512, MemReqMb: 1024, ElapsedSeconds: 60, TimeLimitSeconds: 600})
+	// 没有时间限制的作业不计入时间效率
+	summary.Add(&backend.JobEfficiency{CpuUsedSeconds: 70, CpuAllocSeconds: 100, MemPeakMb: 512, MemReqMb: 1024, ElapsedSeconds: 3600})
+
+	assert.Equal(t, uint32(2), summary.JobCount)
+	assert.InDelta(t, 50.0, summary.CpuEfficiency(), 0.001)
+	assert.InDelta(t, 50.0, summary.MemEfficiency(), 0.001)
+	assert.InDelta(t, 10.0, summary.TimeEfficiency(), 0.001)
+}
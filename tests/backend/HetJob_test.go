@@ -0,0 +1,73 @@
+package main
+
+import (
+	"testing"
+
+	"scow-slurm-adapter/backend"
+	"scow-slurm-adapter/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeHetJobs(t *testing.T) {
+	jobs := []*backend.Job{
+		{JobId: 10, Partition: "compute", NodeList: "cn01", CpusAlloc: 4},
+		{JobId: 12, HetJobId: 11, HetJobOffset: 1, Partition: "gpu", NodeList: "gpu[01-02]", NodesAlloc: 2, CpusAlloc: 16, GpusAlloc: 8, MemAllocMb: 2048},
+		{JobId: 11, HetJobId: 11, HetJobOffset: 0, Partition: "compute", NodeList: "cn02", NodesAlloc: 1, CpusAlloc: 2, MemAllocMb: 1024},
+		// 组件0不在结果中时保持不变
+		{JobId: 21, HetJobId: 20, HetJobOffset: 1, Partition: "gpu", CpusAlloc: 8},
+	}
+	merged := backend.MergeHetJobs(jobs)
+	assert.Len(t, merged, 3)
+	assert.Same(t, jobs[0], merged[0])
+
+	het := merged[1]
+	assert.Equal(t, uint32(11), het.JobId)
+	assert.Equal(t, int32(3), het.NodesAlloc)
+	assert.Equal(t, int32(18), het.CpusAlloc)
+	assert.Equal(t, int32(8), het.GpusAlloc)
+	assert.Equal(t, int64(3072), het.MemAllocMb)
+	assert.Equal(t, "compute,gpu", het.Partition)
+	assert.Equal(t, "cn02,gpu[01-02]", het.NodeList)
+	// 组件按offset排序, 各自保留原来的资源
+	assert.Len(t, het.HetComponents, 2)
+	assert.Equal(t, uint32(11), het.HetComponents[0].JobId)
+	assert.Equal(t, int32(2), het.HetComponents[0].CpusAlloc)
+	assert.Equal(t, uint32(12), het.HetComponents[1].JobId)
+
+	assert.Same(t, jobs[3], merged[2])
+}
+
+func TestMergeHetQueueJobs(t *testing.T) {
+	jobs := []*backend.QueueJob{
+		{JobId: 11, HetJobId: 11, HetJobOffset: 0, Partition: "compute", NodeList: "cn01", Nodes: 1, Cpus: 2},
+		{JobId: 12, HetJobId: 11, HetJobOffset: 1, Partition: "gpu", NodeList: "gpu[01-02]", Nodes: 2, Cpus: 16, GpusPerNode: 4},
+		{JobId: 13, Nodes: 2, GpusPerNode: 2},
+	}
+	merged := backend.MergeHetQueueJobs(jobs)
+	assert.Len(t, merged, 2)
+	assert.Equal(t, int32(3), merged[0].Nodes)
+	assert.Equal(t, int32(18), merged[0].Cpus)
+	assert.Equal(t, "cn01,gpu[01-02]", merged[0].NodeList)
+	assert.Equal(t, int32(8), merged[0].GpusAlloc())
+	assert.Len(t, merged[0].HetComponents, 2)
+	assert.Equal(t, int32(4), merged[1].GpusAlloc())
+}
+
+func TestCliListQueueJobsHet(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"squeue": {Stdout: "11|acct|alice|compute|normal|RUNNING|None|2|1|10:00|1:00|2024-01-02T03:04:05|/home/alice|cn01|N/A|11|N/A|(null)|11+0|N/A|het\n" +
+			"12|acct|alice|gpu|normal|RUNNING|None|16|2|10:00|1:00|2024-01-02T03:04:05|/home/alice|gpu[01-02]|gres/gpu:4|12|N/A|(null)|11+1|N/A|het\n"},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+
+	jobs, err := newCliBackend().ListQueueJobs(nil)
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 2)
+	assert.Equal(t, uint32(11), jobs[0].HetJobId)
+	assert.Equal(t, uint32(0), jobs[0].HetJobOffset)
+	assert.Equal(t, uint32(11), jobs[1].HetJobId)
+	assert.Equal(t, uint32(1), jobs[1].HetJobOffset)
+	assert.Equal(t, uint32(0), jobs[1].ArrayJobId)
+}
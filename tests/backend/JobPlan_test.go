@@ -0,0 +1,326 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"scow-slurm-adapter/backend"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 内存中的记账数据库和slurmctld
+type fakePlanBackend struct {
+	backend.Backend
+	db    []*backend.Job
+	queue []*backend.QueueJob
+	nows  []int64 // 每次查询数据库时计算elapsed_seconds使用的时间
+}
+
+func (f *fakePlanBackend) QueryJobs(query *backend.JobQuery) ([]*backend.Job, uint32, error) {
+	var matched []*backend.Job
+	for _, job := range f.db {
+		if query.Match(job) {
+			matched = append(matched, job)
+		}
+	}
+	f.nows = append(f.nows, query.ElapsedNow())
+	jobs, total, err := backend.PageJobsInMemory(matched, query, query.ElapsedNow())
+	if query.SkipCount {
+		total = 0
+	}
+	return jobs, total, err
+}
+
+func (f *fakePlanBackend) ListQueueJobs(filter *backend.QueueFilter) ([]*backend.QueueJob, error) {
+	var jobs []*backend.QueueJob
+	for _, job := range f.queue {
+		if len(filter.Users) != 0 && !slices.Contains(filter.Users, job.User) {
+			continue
+		}
+		if len(filter.Accounts) != 0 && !slices.Contains(filter.Accounts, job.Account) {
+			continue
+		}
+		if len(filter.JobIds) != 0 && !slices.Contains(filter.JobIds, job.JobId) {
+			continue
+		}
+		if filter.JobName != nil && *filter.JobName != job.Name {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func planJobIds(jobs []*backend.PlannedJob) []uint32 {
+	ids := []uint32{}
+	for _, job := range jobs {
+		ids = append(ids, job.JobId)
+	}
+	return ids
+}
+
+func newFakePlanBackend() *fakePlanBackend {
+	return &fakePlanBackend{
+		db: []*backend.Job{
+			{JobId: 1, Name: "a", User: "alice", Account: "acct1", State: "COMPLETED", SubmitTime: 100, EndTime: 200},
+			{JobId: 2, Name: "b", User: "bob", Account: "acct2", State: "FAILED", SubmitTime: 110, EndTime: 300},
+			{JobId: 3, Name: "a", User: "alice", Account: "acct2", State: "RUNNING", SubmitTime: 120, NodeList: "None assigned"},
+			{JobId: 4, Name: "c", User: "bob", Account: "acct1", State: "PENDING", SubmitTime: 130},
+		},
+		queue: []*backend.QueueJob{
+			{JobId: 3, Name: "a", User: "alice", Account: "acct2", State: "RUNNING", SubmitTime: 120, NodeList: "cn01", Nodes: 1, Cpus: 4, ElapsedSeconds: 60},
+			{JobId: 4, Name: "c", User: "bob", Account: "acct1", State: "PENDING", SubmitTime: 130, Reason: "Priority"},
+			// 刚提交还没写入数据库的作业
+			{JobId: 5, Name: "a", User: "alice", Account: "acct1", State: "PENDING", SubmitTime: 140, Reason: "Resources", TimeLimitMinutes: -1},
+			{JobId: 6, Name: "d", User: "bob", Account: "acct2", State: "RUNNING", SubmitTime: 150, NodeList: "cn02", Nodes: 1, Cpus: 2},
+		},
+	}
+}
+
+func TestPlanJobsFilters(t *testing.T) {
+	jobId := uint32(5)
+	jobName := "a"
+	tests := []struct {
+		name  string
+		query backend.JobQuery
+		want  []uint32
+	}{
+		{"all", backend.JobQuery{}, []uint32{1, 2, 3, 4, 5, 6}},
+		{"users", backend.JobQuery{Users: []string{"alice"}}, []uint32{1, 3, 5}},
+		// 账户条件对未结束的作业同样生效
+		{"accounts", backend.JobQuery{Accounts: []string{"acct1"}}, []uint32{1, 4, 5}},
+		{"users and accounts", backend.JobQuery{Users: []string{"bob"}, Accounts: []string{"acct2"}}, []uint32{2, 6}},
+		{"running", backend.JobQuery{States: []string{"RUNNING"}}, []uint32{3, 6}},
+		{"pending", backend.JobQuery{States: []string{"PENDING"}, Users: []string{"alice", "bob"}}, []uint32{4, 5}},
+		{"finished", backend.JobQuery{States: []string{"COMPLETED", "FAILED"}}, []uint32{1, 2}},
+		{"submit time", backend.JobQuery{SubmitTimeStart: 115, SubmitTimeEnd: 145}, []uint32{3, 4, 5}},
+		{"end time", backend.JobQuery{EndTimeStart: 250, EndTimeEnd: 400}, []uint32{2}},
+		{"job id", backend.JobQuery{JobId: &jobId}, []uint32{5}},
+		{"job name", backend.JobQuery{JobName: &jobName}, []uint32{1, 3, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobs, total, err := backend.PlanJobs(newFakePlanBackend(), &tt.query)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, planJobIds(jobs))
+			assert.Equal(t, uint32(len(tt.want)), total)
+		})
+	}
+}
+
+func TestPlanJobsPaging(t *testing.T) {
+	tests := []struct {
+		name  string
+		query backend.JobQuery
+		want  []uint32
+	}{
+		{"asc first page", backend.JobQuery{Order: "ASC", Limit: 4}, []uint32{1, 2, 3, 4}},
+		{"asc across db and queue", backend.JobQuery{Order: "ASC", Offset: 3, Limit: 2}, []uint32{4, 5}},
+		{"asc queue only", backend.JobQuery{Order: "ASC", Offset: 5, Limit: 2}, []uint32{6}},
+		{"asc past end", backend.JobQuery{Order: "ASC", Offset: 8, Limit: 2}, []uint32{}},
+		{"desc queue only", backend.JobQuery{Order: "DESC", Limit: 2}, []uint32{6, 5}},
+		{"desc across queue and db", backend.JobQuery{Order: "DESC", Offset: 1, Limit: 3}, []uint32{5, 4, 3}},
+		{"desc db only", backend.JobQuery{Order: "DESC", Offset: 4, Limit: 4}, []uint32{2, 1}},
+		{"desc unpaged", backend.JobQuery{Order: "DESC"}, []uint32{6, 5, 4, 3, 2, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobs, total, err := backend.PlanJobs(newFakePlanBackend(), &tt.query)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, planJobIds(jobs))
+			assert.Equal(t, uint32(6), total)
+		})
+	}
+}
+
+func TestPlanJobsOverlay(t *testing.T) {
+	f := newFakePlanBackend()
+	// 数据库中的状态还没更新
+	f.db[3].State = "PENDING"
+	f.queue[1].State = "RUNNING"
+	f.queue[1].NodeList = "cn03"
+	f.queue[1].Nodes = 1
+	f.queue[1].Cpus = 8
+	jobs, _, err := backend.PlanJobs(f, &backend.JobQuery{})
+	assert.NoError(t, err)
+
+	assert.Nil(t, jobs[0].Queue)
+	assert.Equal(t, "COMPLETED", jobs[0].State)
+
+	running := jobs[2]
+	assert.NotNil(t, running.Queue)
+	assert.Equal(t, "cn01", running.NodeList)
+	assert.Equal(t, int32(4), running.CpusAlloc)
+	assert.NotZero(t, running.StartTime)
+
+	updated := jobs[3]
+	assert.Equal(t, "RUNNING", updated.State)
+	assert.Equal(t, "cn03", updated.NodeList)
+	assert.Equal(t, int32(8), updated.CpusAlloc)
+
+	// 未写入数据库的排队作业没有分配资源, INVALID的时间限制视为0
+	pending := jobs[4]
+	assert.Equal(t, "Resources", pending.Queue.Reason)
+	assert.Equal(t, "", pending.NodeList)
+	assert.Equal(t, int64(0), pending.TimeLimitMinutes)
+	assert.Equal(t, int64(140), pending.SubmitTime)
+}
+
+func TestPlanJobsOverlayStates(t *testing.T) {
+	// 作业4在数据库中还是PENDING, slurmctld中已经是RUNNING
+	newBackend := func() *fakePlanBackend {
+		f := newFakePlanBackend()
+		f.queue[1].State = "RUNNING"
+		f.queue[1].NodeList = "cn03"
+		f.queue[1].Nodes = 1
+		f.queue[1].Cpus = 8
+		return f
+	}
+	tests := []struct {
+		name  string
+		query backend.JobQuery
+		want  []uint32
+		total uint32
+	}{
+		{"pending", backend.JobQuery{States: []string{"PENDING"}}, []uint32{5}, 1},
+		{"running", backend.JobQuery{States: []string{"RUNNING"}}, []uint32{3, 4, 6}, 3},
+		{"running desc", backend.JobQuery{States: []string{"RUNNING"}, Order: "DESC"}, []uint32{6, 4, 3}, 3},
+		{"running paged", backend.JobQuery{States: []string{"RUNNING"}, Order: "ASC", Offset: 1, Limit: 1}, []uint32{4}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobs, total, err := backend.PlanJobs(newBackend(), &tt.query)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, planJobIds(jobs))
+			assert.Equal(t, tt.total, total)
+			for _, job := range jobs {
+				assert.Contains(t, tt.query.States, job.State)
+			}
+		})
+	}
+
+	pages := planAllPages(t, newBackend(), backend.JobQuery{States: []string{"RUNNING"}, Order: "ASC", Limit: 2})
+	assert.Equal(t, [][]uint32{{3, 4}, {6}}, pages)
+	pages = planAllPages(t, newBackend(), backend.JobQuery{States: []string{"PENDING"}, Order: "ASC", Limit: 2})
+	assert.Equal(t, [][]uint32{{5}}, pages)
+}
+
+// 逐页查询直到没有下一页, 返回每页的作业id
+func planAllPages(t *testing.T, b backend.Backend, query backend.JobQuery) [][]uint32 {
+	var pages [][]uint32
+	var cursor *backend.JobCursor
+	for i := 0; i < 10; i++ {
+		jobs, next, _, err := backend.PlanJobsAfter(b, &query, cursor)
+		assert.NoError(t, err)
+		pages = append(pages, planJobIds(jobs))
+		if next == nil {
+			return pages
+		}
+		// 令牌编码后原样传回
+		cursor, err = backend.DecodeJobCursor(next.Encode())
+		assert.NoError(t, err)
+	}
+	t.Fatal("too many pages")
+	return nil
+}
+
+func TestPlanJobsAfter(t *testing.T) {
+	tests := []struct {
+		name  string
+		query backend.JobQuery
+		want  [][]uint32
+	}{
+		{"asc", backend.JobQuery{Order: "ASC", Limit: 4}, [][]uint32{{1, 2, 3, 4}, {5, 6}}},
+		{"asc exact pages", backend.JobQuery{Order: "ASC", Limit: 3}, [][]uint32{{1, 2, 3}, {4, 5, 6}}},
+		{"desc", backend.JobQuery{Order: "DESC", Limit: 4}, [][]uint32{{6, 5, 4, 3}, {2, 1}}},
+		{"desc queue fills page", backend.JobQuery{Order: "DESC", Limit: 2}, [][]uint32{{6, 5}, {4, 3}, {2, 1}, {}}},
+		{"filtered", backend.JobQuery{Order: "ASC", Limit: 1, Users: []string{"alice"}}, [][]uint32{{1}, {3}, {5}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, planAllPages(t, newFakePlanBackend(), tt.query))
+		})
+	}
+}
+
+func TestPlanJobsAfterTotal(t *testing.T) {
+	jobs, next, total, err := backend.PlanJobsAfter(newFakePlanBackend(), &backend.JobQuery{Order: "DESC", Limit: 1}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{6}, planJobIds(jobs))
+	assert.NotNil(t, next)
+	assert.Equal(t, uint32(6), total)
+
+	_, _, total, err = backend.PlanJobsAfter(newFakePlanBackend(), &backend.JobQuery{Order: "ASC", Limit: 1, SkipCount: true}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0), total)
+}
+
+func TestPlanJobsAfterJobWritten(t *testing.T) {
+	f := newFakePlanBackend()
+	query := backend.JobQuery{Order: "ASC", Limit: 5}
+	jobs, next, _, err := backend.PlanJobsAfter(f, &query, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1, 2, 3, 4, 5}, planJobIds(jobs))
+	// 翻页期间作业5写入了数据库, 不会重复返回
+	f.db = append(f.db, &backend.Job{JobId: 5, Name: "a", User: "alice", Account: "acct1", State: "PENDING", SubmitTime: 140})
+	jobs, next, _, err = backend.PlanJobsAfter(f, &query, next)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{6}, planJobIds(jobs))
+	assert.Nil(t, next)
+}
+
+func TestPlanJobsSortField(t *testing.T) {
+	// 未写入数据库的作业按排序字段插入到数据库的作业中
+	tests := []struct {
+		name  string
+		query backend.JobQuery
+		want  []uint32
+	}{
+		{"asc", backend.JobQuery{SortField: "user"}, []uint32{1, 3, 5, 2, 4, 6}},
+		{"desc", backend.JobQuery{SortField: "user", Order: "DESC"}, []uint32{6, 4, 2, 5, 3, 1}},
+		{"asc page", backend.JobQuery{SortField: "user", Offset: 2, Limit: 2}, []uint32{5, 2}},
+		{"asc last page", backend.JobQuery{SortField: "user", Offset: 4, Limit: 2}, []uint32{4, 6}},
+		{"desc page", backend.JobQuery{SortField: "user", Order: "DESC", Offset: 1, Limit: 3}, []uint32{4, 2, 5}},
+		{"past end", backend.JobQuery{SortField: "user", Offset: 6, Limit: 2}, []uint32{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobs, total, err := backend.PlanJobs(newFakePlanBackend(), &tt.query)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, planJobIds(jobs))
+			assert.Equal(t, uint32(6), total)
+		})
+	}
+
+	pages := planAllPages(t, newFakePlanBackend(), backend.JobQuery{SortField: "user", Limit: 2})
+	assert.Equal(t, [][]uint32{{1, 3}, {5, 2}, {4, 6}}, pages)
+	pages = planAllPages(t, newFakePlanBackend(), backend.JobQuery{SortField: "submit_time", Order: "DESC", Limit: 4})
+	assert.Equal(t, [][]uint32{{6, 5, 4, 3}, {2, 1}}, pages)
+}
+
+func TestPlanJobsAfterElapsedNow(t *testing.T) {
+	f := newFakePlanBackend()
+	f.db[2].StartTime = 900
+	query := backend.JobQuery{SortField: "elapsed_seconds", Order: "DESC", Limit: 1}
+	_, next, _, err := backend.PlanJobsAfter(f, &query, nil)
+	assert.NoError(t, err)
+	assert.NotZero(t, next.Now)
+	assert.Equal(t, next.Now, f.nows[len(f.nows)-1])
+	// 之后的页使用第一页的时间计算运行中的作业的elapsed_seconds, 排序值不随查询时间变化
+	next.Now = 1000
+	_, next, _, err = backend.PlanJobsAfter(f, &query, next)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), f.nows[len(f.nows)-1])
+	assert.Equal(t, int64(1000), next.Now)
+}
+
+func TestDecodeJobCursor(t *testing.T) {
+	cursor := &backend.JobCursor{After: "42|alice", Now: 1000}
+	decoded, err := backend.DecodeJobCursor(cursor.Encode())
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+
+	_, err = backend.DecodeJobCursor("not a token")
+	assert.ErrorIs(t, err, backend.ErrInvalidCursor)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"scow-slurm-adapter/backend"
+	"scow-slurm-adapter/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCliListJobPriorities(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"sprio": {Stdout: "     20|compute|alice|  10500|  1000|  8000|   500|  1000|     0|    0|cpu=10,gres/gpu=5\n" +
+			"     21|compute|bob  |   2000|   500|  1500|     0|     0|     0|    0|\n"},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+
+	priorities, err := newCliBackend().ListJobPriorities([]string{"compute", "gpu"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"sprio", "--noheader", "--format=%i|%r|%u|%Y|%A|%F|%J|%P|%Q|%N|%T", "-p", "compute,gpu"}, fake.calls[0])
+	assert.Len(t, priorities, 2)
+	assert.Equal(t, &backend.JobPriority{
+		JobId:           20,
+		Partition:       "compute",
+		User:            "alice",
+		Priority:        10500,
+		Age:             1000,
+		Fairshare:       8000,
+		JobSize:         500,
+		PartitionFactor: 1000,
+		Tres:            map[string]int64{"cpu": 10, "gres/gpu": 5},
+	}, priorities[0])
+	assert.Nil(t, priorities[1].Tres)
+
+	// 没有使用multifactor插件
+	fake.result["sprio"] = &utils.CommandResult{Stderr: "sprio: error: You are not running a supported priority plugin\n(priority/basic).\n", ExitCode: 1}
+	_, err = newCliBackend().ListJobPriorities(nil)
+	assert.True(t, errors.Is(err, backend.ErrNotSupported))
+}
+
+func TestJobPriorityRank(t *testing.T) {
+	priorities := []*backend.JobPriority{
+		{JobId: 20, Partition: "compute", Priority: 100},
+		{JobId: 21, Partition: "compute", Priority: 300},
+		{JobId: 22, Partition: "compute", Priority: 100},
+		{JobId: 22, Partition: "gpu", Priority: 50},
+	}
+	rank, pendingCount := backend.JobPriorityRank(priorities, 22, "compute")
+	// 优先级相同时作业id小的在前
+	assert.Equal(t, uint32(3), rank)
+	assert.Equal(t, uint32(3), pendingCount)
+	rank, pendingCount = backend.JobPriorityRank(priorities, 22, "gpu")
+	assert.Equal(t, uint32(1), rank)
+	assert.Equal(t, uint32(1), pendingCount)
+	rank, _ = backend.JobPriorityRank(priorities, 23, "compute")
+	assert.Equal(t, uint32(0), rank)
+}
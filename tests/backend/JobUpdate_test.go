@@ -0,0 +1,100 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"scow-slurm-adapter/backend"
+	"scow-slurm-adapter/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCliUpdateJob(t *testing.T) {
+	fake := &fakeExecutor{}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+
+	partition, qos, cpus, name := "gpu", "high", uint32(8), "renamed"
+	results, err := newCliBackend().UpdateJob(20, &backend.JobUpdate{Partition: &partition, Qos: &qos, Cpus: &cpus, Name: &name})
+	assert.Nil(t, err)
+	assert.Empty(t, results)
+	// 每个字段单独修改, 分区最先修改
+	assert.Equal(t, [][]string{
+		{"scontrol", "update", "job=20", "Partition=gpu"},
+		{"scontrol", "update", "job=20", "QOS=high"},
+		{"scontrol", "update", "job=20", "NumCPUs=8"},
+		{"scontrol", "update", "job=20", "JobName=renamed"},
+	}, fake.calls)
+
+	// slurm拒绝的字段在结果中
+	fake.result = map[string]*utils.CommandResult{
+		"scontrol": {Stderr: "slurm_update error: Invalid qos specification\n", ExitCode: 1},
+	}
+	results, err = newCliBackend().UpdateJob(20, &backend.JobUpdate{Qos: &qos})
+	assert.Nil(t, err)
+	assert.Equal(t, "Invalid qos specification", results[backend.JobUpdateQos].Error())
+
+	fake.result["scontrol"] = &utils.CommandResult{Stderr: "slurm_update error: Invalid job id specified\n", ExitCode: 1}
+	_, err = newCliBackend().UpdateJob(20, &backend.JobUpdate{Qos: &qos})
+	assert.ErrorIs(t, err, backend.ErrNotFound)
+}
+
+func TestRestUpdateJob(t *testing.T) {
+	var status int
+	var body string
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		io.WriteString(w, body)
+	}, utils.SlurmRestd{Token: "token"})
+	qos := "high"
+
+	// slurm拒绝的字段在结果中
+	status, body = http.StatusInternalServerError, `{"errors": [{"error_number": 2008, "description": "Invalid qos specification"}]}`
+	results, err := b.UpdateJob(20, &backend.JobUpdate{Qos: &qos})
+	assert.Nil(t, err)
+	assert.Equal(t, "Invalid qos specification", results[backend.JobUpdateQos].Error())
+
+	status, body = http.StatusInternalServerError, `{"errors": [{"error_number": 2017, "description": "Invalid job id specified"}]}`
+	_, err = b.UpdateJob(20, &backend.JobUpdate{Qos: &qos})
+	assert.ErrorIs(t, err, backend.ErrNotFound)
+
+	// 认证失败和slurmrestd本身的错误不是字段的错误
+	status, body = http.StatusUnauthorized, `{"errors": [{"error_number": 1007, "description": "Authentication failure"}]}`
+	_, err = b.UpdateJob(20, &backend.JobUpdate{Qos: &qos})
+	assert.NotNil(t, err)
+	status, body = http.StatusBadGateway, "bad gateway"
+	results, err = b.UpdateJob(20, &backend.JobUpdate{Qos: &qos})
+	assert.NotNil(t, err)
+	assert.Nil(t, results)
+}
+
+func TestValidateJobUpdate(t *testing.T) {
+	job := &backend.QueueJob{JobId: 20, Account: "a1", Partition: "compute", Qos: "normal"}
+	partition := &backend.Partition{Name: "gpu", TotalCpus: 64, TotalNodes: 2, AllowAccounts: "ALL", AllowQos: "high"}
+	node := &backend.Node{Name: "gpu01", Gpus: 4, RealMemoryMb: 1024}
+
+	// 目标分区不允许作业原来的qos
+	target := "gpu"
+	invalid := backend.ValidateJobUpdate(&backend.JobUpdate{Partition: &target}, job, partition, node)
+	assert.Len(t, invalid, 1)
+	assert.Contains(t, invalid, backend.JobUpdatePartition)
+
+	// 同时修改qos
+	qos := "high"
+	assert.Empty(t, backend.ValidateJobUpdate(&backend.JobUpdate{Partition: &target, Qos: &qos}, job, partition, node))
+
+	nodes, cpus, gpus, memMb := uint32(3), uint32(0), uint32(8), int64(2048)
+	invalid = backend.ValidateJobUpdate(&backend.JobUpdate{Qos: &qos, NodeCount: &nodes, Cpus: &cpus, Gpus: &gpus, MemMb: &memMb}, job, partition, node)
+	assert.Len(t, invalid, 4)
+	assert.Contains(t, invalid, backend.JobUpdateNodeCount)
+	assert.Contains(t, invalid, backend.JobUpdateCpus)
+	assert.Contains(t, invalid, backend.JobUpdateGpus)
+	assert.Contains(t, invalid, backend.JobUpdateMemMb)
+
+	// 账户不能使用分区
+	partition.AllowAccounts = "a2,a3"
+	invalid = backend.ValidateJobUpdate(&backend.JobUpdate{Qos: &qos}, job, partition, nil)
+	assert.Contains(t, invalid, backend.JobUpdatePartition)
+}
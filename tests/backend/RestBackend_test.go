@@ -0,0 +1,516 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"scow-slurm-adapter/backend"
+	"scow-slurm-adapter/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 创建指向测试服务器的rest后端
+func newRestBackend(t *testing.T, handler http.HandlerFunc, restConfig utils.SlurmRestd) backend.Backend {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	if restConfig.Url == "" {
+		restConfig.Url = server.URL
+	}
+	config := &utils.Config{
+		Slurm:       utils.Slurm{Backend: backend.TypeRest},
+		SlurmRestd:  restConfig,
+		MySQLConfig: utils.MySQLConfig{ClusterName: "cluster"},
+	}
+	b, err := backend.New(config, nil)
+	assert.Nil(t, err)
+	return b
+}
+
+func TestRestListPartitions(t *testing.T) {
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/slurm/v0.0.40/partitions", r.URL.Path)
+		io.WriteString(w, `{"partitions": [
+			{"name": "compute", "nodes": {"configured": "cn[01-02]", "total": 2}, "cpus": {"total": 64},
+			 "accounts": {"allowed": ""}, "qos": {"allowed": "normal,high"}, "partition": {"state": ["UP"]},
+			 "tres": {"configured": "cpu=64,mem=256G,node=2"}}]}`)
+	}, utils.SlurmRestd{Token: "token"})
+
+	partitions, err := b.ListPartitions()
+	assert.Nil(t, err)
+	assert.Len(t, partitions, 1)
+	assert.Equal(t, "compute", partitions[0].Name)
+	assert.Equal(t, "UP", partitions[0].State)
+	assert.Equal(t, "ALL", partitions[0].AllowAccounts)
+	assert.Equal(t, "normal,high", partitions[0].AllowQos)
+	assert.Equal(t, 64, partitions[0].TotalCpus)
+	assert.Equal(t, 2, partitions[0].TotalNodes)
+	assert.Equal(t, 256*1024, partitions[0].MemMb)
+
+	err = b.UpdatePartitionAllowAccounts("compute", "a,b")
+	assert.True(t, errors.Is(err, backend.ErrNotSupported))
+}
+
+func TestRestSubmitJobWithSignedToken(t *testing.T) {
+	key := []byte("secret-key")
+	keyFile := filepath.Join(t.TempDir(), "jwt_hs256.key")
+	assert.Nil(t, os.WriteFile(keyFile, key, 0600))
+
+	var body map[string]interface{}
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/slurm/v0.0.40/job/submit", r.URL.Path)
+		// 以提交作业的用户身份访问slurmrestd
+		assert.Equal(t, "alice", r.Header.Get("X-SLURM-USER-NAME"))
+		parts := strings.Split(r.Header.Get("X-SLURM-USER-TOKEN"), ".")
+		assert.Len(t, parts, 3)
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(parts[0] + "." + parts[1]))
+		assert.Equal(t, base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), parts[2])
+		payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+		assert.Contains(t, string(payload), `"sun":"alice"`)
+
+		json.NewDecoder(r.Body).Decode(&body)
+		io.WriteString(w, `{"job_id": 42}`)
+	}, utils.SlurmRestd{User: "root", JwtKeyFile: keyFile})
+
+	script := "#!/bin/bash\n#SBATCH -A a_admin\n#SBATCH --partition=compute\n#SBATCH --time=90\n#SBATCH -c 4\n#SBATCH --gres=gpu:2\n#SBATCH --array=0-15:4%2\n#SBATCH --dependency=afterok:12:13\n\nhostname\n"
+	jobId, err := b.SubmitJob("alice", script)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(42), jobId)
+	assert.Equal(t, script, body["script"])
+	job := body["job"].(map[string]interface{})
+	assert.Equal(t, "a_admin", job["account"])
+	assert.Equal(t, "compute", job["partition"])
+	assert.Equal(t, float64(4), job["cpus_per_task"])
+	assert.Equal(t, "gres/gpu:2", job["tres_per_node"])
+	assert.Equal(t, float64(90), job["time_limit"].(map[string]interface{})["number"])
+	assert.Equal(t, "0-15:4%2", job["array"])
+	assert.Equal(t, "afterok:12:13", job["dependency"])
+}
+
+func TestRestArrayJobs(t *testing.T) {
+	var deleted []string
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deleted = append(deleted, r.URL.Path)
+			io.WriteString(w, `{}`)
+			return
+		}
+		io.WriteString(w, `{"jobs": [
+			{"job_id": 21, "job_state": ["RUNNING"], "array_job_id": {"set": true, "number": 20}, "array_task_id": {"set": true, "number": 1}},
+			{"job_id": 20, "job_state": ["PENDING"], "array_job_id": {"set": true, "number": 20}, "array_task_id": {"set": false, "number": 0}, "array_task_string": "2-10"},
+			{"job_id": 30, "job_state": ["RUNNING"], "array_job_id": {"set": true, "number": 0}, "array_task_id": {"set": false, "number": 0}}]}`)
+	}, utils.SlurmRestd{Token: "token"})
+
+	arrayJobId := uint32(20)
+	jobs, err := b.ListQueueJobs(&backend.QueueFilter{ArrayJobId: &arrayJobId})
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 2)
+	assert.Equal(t, "1", jobs[0].ArrayTaskId)
+	assert.Equal(t, "2-10", jobs[1].ArrayTaskId)
+
+	// 和squeue -j一样, 作业数组id匹配数组的全部任务
+	jobs, err = b.ListQueueJobs(&backend.QueueFilter{JobIds: []uint32{20}})
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 2)
+
+	jobs, err = b.ListQueueJobs(&backend.QueueFilter{JobIds: []uint32{30}})
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, uint32(0), jobs[0].ArrayJobId)
+	assert.Equal(t, "", jobs[0].ArrayTaskId)
+
+	arrayTaskId := uint32(3)
+	assert.Nil(t, b.CancelJob("alice", 20, &arrayTaskId))
+	assert.Nil(t, b.CancelJob("alice", 20, nil))
+	assert.Equal(t, []string{"/slurm/v0.0.40/job/20_3", "/slurm/v0.0.40/job/20"}, deleted)
+}
+
+func TestRestSubmitJobUnsupportedOption(t *testing.T) {
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL.Path)
+	}, utils.SlurmRestd{Token: "token"})
+
+	_, err := b.SubmitJob("alice", "#!/bin/bash\n#SBATCH --licenses=matlab:1\nhostname\n")
+	assert.True(t, errors.Is(err, backend.ErrNotSupported))
+}
+
+func TestRestListQueueJobs(t *testing.T) {
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"jobs": [
+			{"job_id": 1, "name": "a", "account": "acct", "user_name": "alice", "partition": "compute", "job_state": ["RUNNING"],
+			 "state_reason": "None", "cpus": {"set": true, "number": 8}, "node_count": {"set": true, "number": 2},
+			 "time_limit": {"set": true, "infinite": false, "number": 60}, "tres_per_node": "gres/gpu:1"},
+			{"job_id": 2, "name": "b", "account": "acct", "user_name": "alice", "partition": "compute", "job_state": "PENDING",
+			 "state_reason": "Priority", "time_limit": {"set": true, "infinite": true, "number": 0},
+			 "start_time": {"set": true, "number": 1704200000}},
+			{"job_id": 3, "name": "c", "account": "acct", "user_name": "bob", "partition": "compute", "job_state": ["PENDING"]}]}`)
+	}, utils.SlurmRestd{Token: "token"})
+
+	jobs, err := b.ListQueueJobs(&backend.QueueFilter{Users: []string{"alice"}})
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 2)
+	assert.Equal(t, "", jobs[0].Reason)
+	assert.Equal(t, int32(8), jobs[0].Cpus)
+	assert.Equal(t, int32(1), jobs[0].GpusPerNode)
+	assert.Equal(t, int64(60), jobs[0].TimeLimitMinutes)
+	assert.Equal(t, "Priority", jobs[1].Reason)
+	assert.Equal(t, int64(0), jobs[1].TimeLimitMinutes)
+	assert.Equal(t, int64(1704200000), jobs[1].ScheduledStartTime)
+
+	jobs, err = b.ListQueueJobs(&backend.QueueFilter{States: []string{"PENDING"}, JobIds: []uint32{3}})
+	assert.Nil(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, "bob", jobs[0].User)
+	// 不设置时间限制时返回INVALID对应的-1
+	assert.Equal(t, int64(-1), jobs[0].TimeLimitMinutes)
+}
+
+func TestRestGetJob(t *testing.T) {
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slurmdb/v0.0.40/job/404" {
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errors": [{"description": "Job not found"}]}`)
+			return
+		}
+		io.WriteString(w, `{"jobs": [{"job_id": 7, "name": "test", "account": "acct", "user": "alice", "state": {"current": ["CANCELLED"]},
+			"time": {"submission": 100, "start": 200, "end": 500, "limit": {"set": true, "number": 30}},
+			"required": {"CPUs": 4, "memory_per_cpu": {"set": true, "number": 1024}},
+			"tres": {"allocated": [{"type": "cpu", "count": 4}, {"type": "mem", "count": 4096}, {"type": "gres", "name": "gpu", "count": 1}, {"type": "node", "count": 1}]},
+			"allocation_nodes": 1, "working_directory": "/home/alice", "stdout": "out-%j.log", "stderr": "",
+			"exit_code": {"status": ["SIGNALED"], "return_code": {"set": true, "number": 0}, "signal": {"id": {"set": true, "number": 9}, "name": "KILL"}},
+			"derived_exit_code": {"status": ["ERROR"], "return_code": {"set": true, "number": 137}, "signal": {"id": {"set": false, "number": 0}}}}]}`)
+	}, utils.SlurmRestd{Token: "token"})
+
+	job, err := b.GetJob(7)
+	assert.Nil(t, err)
+	assert.Equal(t, "CANCELED", job.State)
+	assert.Equal(t, int64(30), job.TimeLimitMinutes)
+	assert.Equal(t, int64(4096), job.MemReqMb)
+	assert.Equal(t, int32(4), job.CpusAlloc)
+	assert.Equal(t, int64(4096), job.MemAllocMb)
+	assert.Equal(t, int32(1), job.GpusAlloc)
+	assert.Equal(t, int32(1), job.NodesReq)
+	assert.Equal(t, int32(0), job.ExitCode)
+	assert.Equal(t, int32(9), job.ExitSignal)
+	assert.Equal(t, int32(137), job.DerivedExitCode)
+	assert.Equal(t, int32(0), job.DerivedExitSignal)
+	assert.Equal(t, "/home/alice/out-7.log", job.StdoutPath)
+	assert.Equal(t, "/home/alice/out-7.log", job.StderrPath)
+
+	_, err = b.GetJob(404)
+	assert.True(t, errors.Is(err, backend.ErrNotFound))
+}
+
+func TestRestBlockUserInAccount(t *testing.T) {
+	var posted map[string][]map[string]interface{}
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/slurmdb/v0.0.40/associations", r.URL.Path)
+		if r.Method == http.MethodGet {
+			assert.Equal(t, "alice", r.URL.Query().Get("user"))
+			assert.Equal(t, "acct", r.URL.Query().Get("account"))
+			assert.Equal(t, "cluster", r.URL.Query().Get("cluster"))
+			io.WriteString(w, `{"associations": [{"account": "acct", "user": "alice", "partition": "compute"}]}`)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&posted)
+		io.WriteString(w, `{}`)
+	}, utils.SlurmRestd{Token: "token"})
+
+	assert.Nil(t, b.BlockUserInAccount("alice", "acct"))
+	assocs := posted["associations"]
+	assert.Len(t, assocs, 1)
+	assert.Equal(t, "cluster", assocs[0]["cluster"])
+	per := assocs[0]["max"].(map[string]interface{})["jobs"].(map[string]interface{})["per"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"set": true, "infinite": false, "number": float64(0)}, per["submitted"])
+}
+
+func TestRestListAssociationsBlockStatus(t *testing.T) {
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"associations": [
+			{"account": "acct", "user": "alice", "max": {"jobs": {"per": {"submitted": {"set": true, "infinite": false, "number": 0}}}}},
+			{"account": "acct", "user": "bob", "max": {"jobs": {"per": {"submitted": {"set": true, "infinite": true, "number": 0}}}}},
+			{"account": "acct", "user": "carol"}]}`)
+	}, utils.SlurmRestd{Token: "token"})
+
+	assocs, err := b.ListAssociations(&backend.AssociationFilter{Account: "acct"})
+	assert.Nil(t, err)
+	assert.Len(t, assocs, 3)
+	assert.NotNil(t, assocs[0].MaxSubmitJobs)
+	assert.Equal(t, 0, *assocs[0].MaxSubmitJobs)
+	assert.Nil(t, assocs[1].MaxSubmitJobs)
+	assert.Nil(t, assocs[2].MaxSubmitJobs)
+}
+
+func TestRestAssociationsOfCluster(t *testing.T) {
+	var requests []string
+	var posted map[string][]map[string]interface{}
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		// 共享slurmdbd时只能修改本集群的关联关系
+		assert.Equal(t, "/slurmdb/v0.0.40/associations", r.URL.Path)
+		query := r.URL.Query()
+		requests = append(requests, r.Method+" "+query.Encode())
+		if r.Method == http.MethodGet {
+			assert.Equal(t, "cluster", query.Get("cluster"))
+			io.WriteString(w, `{"associations": [{"account": "acct", "user": "alice", "cluster": "cluster", "is_default": false}]}`)
+			return
+		}
+		if r.Method == http.MethodPost {
+			json.NewDecoder(r.Body).Decode(&posted)
+		}
+		io.WriteString(w, `{}`)
+	}, utils.SlurmRestd{Token: "token"})
+
+	_, err := b.ListAssociations(&backend.AssociationFilter{Account: "acct"})
+	assert.Nil(t, err)
+	assert.Nil(t, b.DeleteAccount("acct"))
+	assert.Nil(t, b.RemoveUserFromAccount("alice", "acct"))
+	assert.Nil(t, b.DeleteUser("alice"))
+	assert.Nil(t, b.SetUserDefaultAccount("alice", "acct"))
+	assert.Equal(t, []string{
+		"GET account=acct&cluster=cluster",
+		"DELETE account=acct&cluster=cluster",
+		"DELETE account=acct&cluster=cluster&user=alice",
+		"DELETE cluster=cluster&user=alice",
+		"GET account=acct&cluster=cluster&user=alice",
+		"POST ",
+	}, requests)
+	assocs := posted["associations"]
+	assert.Len(t, assocs, 1)
+	assert.Equal(t, "cluster", assocs[0]["cluster"])
+	assert.Equal(t, true, assocs[0]["is_default"])
+}
+
+func TestRestUnixSocket(t *testing.T) {
+	dir, err := os.MkdirTemp("", "restd")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	socketPath := filepath.Join(dir, "slurmrestd.sock")
+	listener, err := net.Listen("unix", socketPath)
+	assert.Nil(t, err)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/slurmdb/v0.0.40/user/alice", r.URL.Path)
+		io.WriteString(w, `{"users": [{"name": "alice"}]}`)
+	})}
+	go server.Serve(listener)
+	defer server.Close()
+
+	b := newRestBackend(t, nil, utils.SlurmRestd{Url: "unix://" + socketPath})
+	exists, err := b.UserExists("alice")
+	assert.Nil(t, err)
+	assert.True(t, exists)
+}
+
+func TestRestGetJobOutput(t *testing.T) {
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slurm/v0.0.40/job/21":
+			io.WriteString(w, `{"jobs": [{"job_id": 21, "name": "array", "user_name": "alice", "current_working_directory": "/home/alice/work",
+				"array_job_id": {"set": true, "number": 20}, "array_task_id": {"set": true, "number": 1}, "standard_output": "logs/%x-%A_%3a.out"}]}`)
+		case "/slurm/v0.0.40/job/7":
+			w.WriteHeader(http.StatusInternalServerError)
+			io.WriteString(w, `{"errors": [{"description": "Invalid job id specified"}]}`)
+		case "/slurmdb/v0.0.40/job/7":
+			io.WriteString(w, `{"jobs": [{"job_id": 7, "user": "bob", "working_directory": "/home/bob"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, utils.SlurmRestd{Token: "token"})
+
+	jobOutput, err := b.GetJobOutput(21)
+	assert.Nil(t, err)
+	assert.Equal(t, &backend.JobOutput{User: "alice", StdoutPath: "/home/alice/work/logs/array-20_001.out", StderrPath: "/home/alice/work/logs/array-20_001.out"}, jobOutput)
+
+	// 不在slurmctld中的作业使用默认的输出文件
+	jobOutput, err = b.GetJobOutput(7)
+	assert.Nil(t, err)
+	assert.Equal(t, &backend.JobOutput{User: "bob", StdoutPath: "/home/bob/slurm-7.out", StderrPath: "/home/bob/slurm-7.out"}, jobOutput)
+
+	_, err = b.GetJobOutput(8)
+	assert.True(t, errors.Is(err, backend.ErrNotFound))
+}
+
+func TestRestListJobSteps(t *testing.T) {
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/slurmdb/v0.0.40/job/7", r.URL.Path)
+		io.WriteString(w, `{"jobs": [{"job_id": 7, "steps": [
+			{"step": {"id": "7.batch", "name": "batch"}, "state": "COMPLETED", "nodes": {"count": 1, "range": "cn01"}, "tasks": {"count": 1},
+			 "time": {"start": {"set": true, "number": 100}, "end": {"set": true, "number": 160}, "elapsed": 60},
+			 "exit_code": {"return_code": {"set": true, "number": 0}, "signal": {"id": {"set": true, "number": 11}}},
+			 "tres": {"allocated": [{"type": "cpu", "count": 4}, {"type": "gres", "name": "gpu", "count": 1}],
+			          "requested": {"max": [{"type": "mem", "count": 1048576}], "average": [{"type": "mem", "count": 524288}]}}},
+			{"step": {"id": "7.0", "name": "mpi"}, "state": ["RUNNING"], "nodes": {"count": 2, "range": "cn[01-02]"}, "tasks": {"count": 8},
+			 "time": {"start": 110, "end": 0, "elapsed": 30}}]}]}`)
+	}, utils.SlurmRestd{Token: "token"})
+
+	steps, err := b.ListJobSteps(7)
+	assert.Nil(t, err)
+	assert.Len(t, steps, 2)
+	assert.Equal(t, "batch", steps[0].StepId)
+	assert.Equal(t, "COMPLETED", steps[0].State)
+	assert.Equal(t, int64(60), steps[0].ElapsedSeconds)
+	assert.Equal(t, int32(11), steps[0].ExitSignal)
+	assert.Equal(t, map[string]uint64{"cpu": 4, "gres/gpu": 1}, steps[0].TresAlloc)
+	assert.Equal(t, map[string]uint64{"mem": 1048576}, steps[0].TresUsageInMax)
+	assert.Equal(t, "0", steps[1].StepId)
+	assert.Equal(t, "RUNNING", steps[1].State)
+	assert.Equal(t, int32(8), steps[1].Tasks)
+	assert.Equal(t, int64(110), steps[1].StartTime)
+}
+
+func TestRestQueryJobSteps(t *testing.T) {
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/slurmdb/v0.0.40/jobs", r.URL.Path)
+		assert.Equal(t, "alice", r.URL.Query().Get("users"))
+		io.WriteString(w, `{"jobs": [
+			{"job_id": 7, "user": "alice", "steps": [
+				{"step": {"id": "7.batch", "name": "batch"}, "state": "COMPLETED",
+				 "time": {"elapsed": 60, "user": {"seconds": 50, "microseconds": 600000}, "system": {"seconds": 9, "microseconds": 500000}},
+				 "tres": {"requested": {"total": [{"type": "cpu", "count": 60000}, {"type": "mem", "count": 2097152}]}}}]},
+			{"job_id": 8, "user": "bob", "steps": [{"step": {"id": "8.batch", "name": "batch"}}]}]}`)
+	}, utils.SlurmRestd{Token: "token"})
+
+	steps, err := b.QueryJobSteps(&backend.JobQuery{Users: []string{"alice"}})
+	assert.Nil(t, err)
+	assert.Len(t, steps, 1)
+	assert.Len(t, steps[7], 1)
+	assert.Equal(t, int64(60), steps[7][0].CpuTimeSeconds)
+	assert.Equal(t, map[string]uint64{"cpu": 60000, "mem": 2097152}, steps[7][0].TresUsageInTot)
+}
+
+func TestRestControlJob(t *testing.T) {
+	var body map[string]interface{}
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/slurm/v0.0.40/job/20_3", r.URL.Path)
+		assert.Equal(t, "alice", r.Header.Get("X-SLURM-USER-NAME"))
+		json.NewDecoder(r.Body).Decode(&body)
+		io.WriteString(w, `{}`)
+	}, utils.SlurmRestd{Token: "token"})
+
+	arrayTaskId := uint32(3)
+	assert.Nil(t, b.ControlJob("alice", 20, &arrayTaskId, backend.JobActionRelease))
+	assert.Equal(t, map[string]interface{}{"hold": false}, body)
+	err := b.ControlJob("alice", 20, &arrayTaskId, backend.JobActionRequeue)
+	assert.True(t, errors.Is(err, backend.ErrNotSupported))
+}
+
+func TestRestCancelJobs(t *testing.T) {
+	var paths []string
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		paths = append(paths, r.URL.Path)
+		if strings.HasSuffix(r.URL.Path, "/13") {
+			w.WriteHeader(http.StatusInternalServerError)
+			io.WriteString(w, `{"errors": [{"description": "Job/step already completing or completed"}]}`)
+			return
+		}
+		io.WriteString(w, `{}`)
+	}, utils.SlurmRestd{Token: "token"})
+
+	results, err := b.CancelJobs("alice", []string{"12", "13", "20_[2-10]"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/slurm/v0.0.40/job/12", "/slurm/v0.0.40/job/13", "/slurm/v0.0.40/job/20_[2-10]"}, paths)
+	assert.Len(t, results, 1)
+	assert.Contains(t, results["13"].Error(), "already completing")
+}
+
+func TestRestQueryJobsAfter(t *testing.T) {
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"jobs": [{"job_id": 9, "state": {"current": ["COMPLETED"]}}, {"job_id": 7, "state": {"current": ["COMPLETED"]}},
+			{"job_id": 8, "state": {"current": ["FAILED"]}}, {"job_id": 10, "state": {"current": ["COMPLETED"]}}]}`)
+	}, utils.SlurmRestd{Token: "token"})
+
+	jobs, total, err := b.QueryJobs(&backend.JobQuery{Limit: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(4), total)
+	assert.Len(t, jobs, 2)
+	assert.Equal(t, "8", jobs[1].Cursor)
+
+	// 总数不受分页位置影响
+	jobs, total, err = b.QueryJobs(&backend.JobQuery{Limit: 2, After: jobs[1].Cursor})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(4), total)
+	assert.Equal(t, []uint32{9, 10}, []uint32{jobs[0].JobId, jobs[1].JobId})
+
+	jobs, _, err = b.QueryJobs(&backend.JobQuery{Order: "DESC", After: "9"})
+	assert.Nil(t, err)
+	assert.Equal(t, []uint32{8, 7}, []uint32{jobs[0].JobId, jobs[1].JobId})
+
+	_, _, err = b.QueryJobs(&backend.JobQuery{After: "bad"})
+	assert.ErrorIs(t, err, backend.ErrInvalidCursor)
+}
+
+func TestRestQueryJobsSort(t *testing.T) {
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"jobs": [{"job_id": 7, "user": "carol", "state": {"current": ["COMPLETED"]}, "time": {"start": 100, "end": 400}},
+			{"job_id": 8, "user": "alice", "state": {"current": ["FAILED"]}, "time": {"start": 100, "end": 200}},
+			{"job_id": 9, "user": "bob", "state": {"current": ["COMPLETED"]}, "time": {"start": 100, "end": 400}},
+			{"job_id": 10, "user": "alice", "state": {"current": ["PENDING"]}}]}`)
+	}, utils.SlurmRestd{Token: "token"})
+	jobIds := func(jobs []*backend.Job) []uint32 {
+		ids := []uint32{}
+		for _, job := range jobs {
+			ids = append(ids, job.JobId)
+		}
+		return ids
+	}
+
+	// 值相同的作业按作业id排序
+	jobs, _, err := b.QueryJobs(&backend.JobQuery{SortField: "user"})
+	assert.Nil(t, err)
+	assert.Equal(t, []uint32{8, 10, 9, 7}, jobIds(jobs))
+
+	jobs, _, err = b.QueryJobs(&backend.JobQuery{SortField: "elapsed_seconds", Order: "DESC", Limit: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []uint32{9, 7}, jobIds(jobs))
+	jobs, total, err := b.QueryJobs(&backend.JobQuery{SortField: "elapsed_seconds", Order: "DESC", Limit: 2, After: jobs[1].Cursor})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(4), total)
+	assert.Equal(t, []uint32{8, 10}, jobIds(jobs))
+
+	jobs, _, err = b.QueryJobs(&backend.JobQuery{SortField: "state", After: "8|FAILED"})
+	assert.Nil(t, err)
+	assert.Equal(t, []uint32{10}, jobIds(jobs))
+
+	_, _, err = b.QueryJobs(&backend.JobQuery{SortField: "elapsed_seconds", After: "8"})
+	assert.ErrorIs(t, err, backend.ErrInvalidCursor)
+}
+
+func TestIsJobSortField(t *testing.T) {
+	for _, field := range []string{"", "submit_time", "elapsed_seconds", "gpus_alloc", "user"} {
+		assert.True(t, backend.IsJobSortField(field), field)
+	}
+	for _, field := range []string{"SubmitTime", "name", "reason"} {
+		assert.False(t, backend.IsJobSortField(field), field)
+	}
+}
+
+func TestRestQueryUsage(t *testing.T) {
+	b := newRestBackend(t, func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"jobs": [{"job_id": 7, "user": "alice", "account": "acct", "state": {"current": ["COMPLETED"]}, "time": {"start": 1, "end": 7200},
+			 "tres": {"allocated": [{"type": "cpu", "count": 4}, {"type": "gres", "name": "gpu", "count": 1}]}},
+			{"job_id": 8, "user": "bob", "account": "acct", "state": {"current": ["PENDING"]}}]}`)
+	}, utils.SlurmRestd{Token: "token"})
+
+	// 跨越统计范围开始时间的作业只统计范围内的部分, 排队的作业不统计
+	summaries, err := b.QueryUsage(&backend.JobQuery{RunTimeStart: 3600, RunTimeEnd: 10800}, &backend.UsageOptions{Start: 3600, End: 10800, GroupByUser: true}, 10800)
+	assert.Nil(t, err)
+	assert.Len(t, summaries, 1)
+	assert.Equal(t, "alice", summaries[0].User)
+	assert.Equal(t, uint32(1), summaries[0].JobCount)
+	assert.Equal(t, 4.0, summaries[0].CpuHours())
+	assert.Equal(t, 1.0, summaries[0].GpuHours())
+}
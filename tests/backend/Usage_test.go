@@ -0,0 +1,83 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"scow-slurm-adapter/backend"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeUsage(t *testing.T) {
+	jobs := []*backend.Job{
+		// 跨越统计范围开始时间的作业只统计范围内的一小时
+		{JobId: 1, User: "alice", Account: "acct1", Partition: "compute", StartTime: 1, EndTime: 7201, CpusAlloc: 4, MemAllocMb: 2048},
+		{JobId: 2, User: "bob", Account: "acct1", Partition: "gpu", StartTime: 3601, EndTime: 7201, CpusAlloc: 8, GpusAlloc: 2, MemAllocMb: 1024},
+		// 还在运行的作业统计到now
+		{JobId: 3, User: "alice", Account: "acct2", Partition: "compute", StartTime: 5401, CpusAlloc: 2},
+		// 排队的作业没有资源使用
+		{JobId: 4, User: "alice", Account: "acct1", Partition: "compute"},
+	}
+	opts := &backend.UsageOptions{Start: 3601, End: 10801}
+	summaries := backend.SummarizeUsage(jobs, opts, 9001)
+	assert.Len(t, summaries, 1)
+	assert.Equal(t, uint32(3), summaries[0].JobCount)
+	assert.Equal(t, int64(4*3600+8*3600+2*3600), summaries[0].CpuSeconds)
+	assert.Equal(t, 14.0, summaries[0].CpuHours())
+	assert.Equal(t, 2.0, summaries[0].GpuHours())
+	assert.Equal(t, 3.0, summaries[0].MemGbHours())
+
+	opts.GroupByUser = true
+	opts.GroupByPartition = true
+	summaries = backend.SummarizeUsage(jobs, opts, 9001)
+	assert.Len(t, summaries, 2)
+	assert.Equal(t, backend.UsageKey{User: "alice", Partition: "compute", BucketStart: 3601}, summaries[0].UsageKey)
+	assert.Equal(t, uint32(2), summaries[0].JobCount)
+	assert.Equal(t, int64(4*3600+2*3600), summaries[0].CpuSeconds)
+	assert.Equal(t, backend.UsageKey{User: "bob", Partition: "gpu", BucketStart: 3601}, summaries[1].UsageKey)
+}
+
+func TestSummarizeUsageBuckets(t *testing.T) {
+	location := time.UTC
+	day := func(d int, h int) int64 { return time.Date(2024, 1, d, h, 0, 0, 0, location).Unix() }
+	jobs := []*backend.Job{
+		// 跨越两天的作业在每天分别统计
+		{JobId: 1, Account: "acct", StartTime: day(1, 20), EndTime: day(2, 4), CpusAlloc: 1},
+		{JobId: 2, Account: "acct", StartTime: day(3, 0), EndTime: day(3, 1), CpusAlloc: 1},
+	}
+	opts := &backend.UsageOptions{Start: day(1, 12), End: day(3, 12), Bucket: backend.UsageBucketDay, Location: location, GroupByAccount: true}
+	summaries := backend.SummarizeUsage(jobs, opts, day(4, 0))
+	assert.Len(t, summaries, 3)
+	// 第一个时间段从统计范围的开始时间算起
+	assert.Equal(t, day(1, 12), summaries[0].BucketStart)
+	assert.Equal(t, 4.0, summaries[0].CpuHours())
+	assert.Equal(t, day(2, 0), summaries[1].BucketStart)
+	assert.Equal(t, 4.0, summaries[1].CpuHours())
+	assert.Equal(t, uint32(1), summaries[1].JobCount)
+	assert.Equal(t, day(3, 0), summaries[2].BucketStart)
+	assert.Equal(t, 1.0, summaries[2].CpuHours())
+
+	// 2024-01-01是周一, 按周统计时都在第一周
+	opts.Bucket = backend.UsageBucketWeek
+	summaries = backend.SummarizeUsage(jobs, opts, day(4, 0))
+	assert.Len(t, summaries, 1)
+	assert.Equal(t, uint32(2), summaries[0].JobCount)
+	assert.Equal(t, 9.0, summaries[0].CpuHours())
+}
+
+func TestSummarizeUsageHetJob(t *testing.T) {
+	job := &backend.Job{JobId: 11, HetJobId: 11, StartTime: 100, EndTime: 3700, Partition: "compute,gpu", CpusAlloc: 10}
+	job.HetComponents = []*backend.Job{
+		{JobId: 11, HetJobId: 11, StartTime: 100, EndTime: 3700, Partition: "compute", CpusAlloc: 2},
+		{JobId: 12, HetJobId: 11, HetJobOffset: 1, StartTime: 100, EndTime: 3700, Partition: "gpu", CpusAlloc: 8, GpusAlloc: 1},
+	}
+	summaries := backend.SummarizeUsage([]*backend.Job{job}, &backend.UsageOptions{Start: 1, End: 10000, GroupByPartition: true}, 10000)
+	assert.Len(t, summaries, 2)
+	assert.Equal(t, "compute", summaries[0].Partition)
+	assert.Equal(t, uint32(1), summaries[0].JobCount)
+	assert.Equal(t, 2.0, summaries[0].CpuHours())
+	assert.Equal(t, "gpu", summaries[1].Partition)
+	assert.Equal(t, uint32(0), summaries[1].JobCount)
+	assert.Equal(t, 1.0, summaries[1].GpuHours())
+}
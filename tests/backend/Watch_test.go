@@ -0,0 +1,64 @@
+package main
+
+import (
+	"testing"
+
+	"scow-slurm-adapter/backend"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffQueueJobs(t *testing.T) {
+	previous := []*backend.QueueJob{
+		{JobId: 1, State: "PENDING", Reason: "Priority"},
+		{JobId: 2, State: "PENDING", Reason: "Resources"},
+		{JobId: 3, State: "RUNNING"},
+		{JobId: 4, State: "RUNNING"},
+		{JobId: 5, State: "RUNNING"},
+		{JobId: 6, State: "RUNNING"},
+		{JobId: 20, State: "PENDING", ArrayJobId: 20, ArrayTaskId: "1-10"},
+	}
+	current := []*backend.QueueJob{
+		{JobId: 1, State: "PENDING", Reason: "Resources"},
+		{JobId: 2, State: "RUNNING"},
+		{JobId: 3, State: "COMPLETED"},
+		{JobId: 4, State: "TIMEOUT"},
+		{JobId: 5, State: "COMPLETING"},
+		{JobId: 7, State: "PENDING", Reason: "None"},
+		{JobId: 20, State: "PENDING", ArrayJobId: 20, ArrayTaskId: "2-10"},
+		{JobId: 21, State: "RUNNING", ArrayJobId: 20, ArrayTaskId: "1"},
+	}
+
+	events, vanished := backend.DiffQueueJobs(previous, current)
+	var types []backend.JobEventType
+	var jobIds []uint32
+	for _, event := range events {
+		types = append(types, event.Type)
+		jobIds = append(jobIds, event.Job.JobId)
+	}
+	assert.Equal(t, []backend.JobEventType{backend.JobReasonChanged, backend.JobStarted, backend.JobCompleted, backend.JobFailed, backend.JobSubmitted, backend.JobStarted}, types)
+	assert.Equal(t, []uint32{1, 2, 3, 4, 7, 21}, jobIds)
+	assert.Equal(t, "Priority", events[0].PreviousReason)
+	assert.Equal(t, "RUNNING", events[3].PreviousState)
+	// 作业6已经离开slurmctld
+	assert.Len(t, vanished, 1)
+	assert.Equal(t, uint32(6), vanished[0].JobId)
+}
+
+func TestDiffQueueJobsNewJobs(t *testing.T) {
+	// 两次轮询之间提交并开始运行或者结束的作业
+	events, _ := backend.DiffQueueJobs(nil, []*backend.QueueJob{
+		{JobId: 1, State: "RUNNING"},
+		{JobId: 2, State: "CANCELLED"},
+	})
+	assert.Len(t, events, 4)
+	assert.Equal(t, backend.JobSubmitted, events[0].Type)
+	assert.Equal(t, backend.JobStarted, events[1].Type)
+	assert.Equal(t, backend.JobSubmitted, events[2].Type)
+	assert.Equal(t, backend.JobCancelled, events[3].Type)
+
+	// 挂起和恢复
+	events, _ = backend.DiffQueueJobs([]*backend.QueueJob{{JobId: 1, State: "RUNNING"}}, []*backend.QueueJob{{JobId: 1, State: "SUSPENDED"}})
+	assert.Len(t, events, 1)
+	assert.Equal(t, backend.JobStateChanged, events[0].Type)
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"scow-slurm-adapter/billing"
+	"scow-slurm-adapter/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newEngine(t *testing.T, multipliers ...utils.TimeMultiplier) *billing.Engine {
+	engine, err := billing.New(&utils.BillingConfig{
+		Prices: []utils.PriceRule{
+			{CpuCoreHour: 0.1, MemGbHour: 0.01},
+			{Qos: "low", CpuCoreHour: 0.05},
+			{Partition: "gpu", CpuCoreHour: 0.2, GpuHour: 2},
+			{Partition: "gpu", Qos: "low", GpuHour: 1},
+		},
+		Multipliers: multipliers,
+		Timezone:    "UTC",
+	})
+	assert.NoError(t, err)
+	return engine
+}
+
+func TestPrice(t *testing.T) {
+	engine := newEngine(t)
+	tests := []struct {
+		partition string
+		qos       string
+		want      utils.PriceRule
+	}{
+		{"compute", "normal", utils.PriceRule{CpuCoreHour: 0.1, MemGbHour: 0.01}},
+		{"compute", "low", utils.PriceRule{Qos: "low", CpuCoreHour: 0.05}},
+		{"gpu", "normal", utils.PriceRule{Partition: "gpu", CpuCoreHour: 0.2, GpuHour: 2}},
+		// 分区和QOS都匹配的规则优先
+		{"gpu", "low", utils.PriceRule{Partition: "gpu", Qos: "low", GpuHour: 1}},
+	}
+	for _, tt := range tests {
+		rule, ok := engine.Price(tt.partition, tt.qos)
+		assert.True(t, ok)
+		assert.Equal(t, tt.want, *rule, tt.partition+"/"+tt.qos)
+	}
+}
+
+func TestCost(t *testing.T) {
+	engine := newEngine(t)
+	cost := engine.Cost(&billing.Usage{Partition: "gpu", Qos: "normal", Cpus: 8, Gpus: 2, MemMb: 4096, StartTime: 3600, EndTime: 3 * 3600}, 0)
+	assert.True(t, cost.Priced)
+	assert.InDelta(t, 8*2*0.2, cost.CpuCost, 1e-9)
+	assert.InDelta(t, 2*2*2.0, cost.GpuCost, 1e-9)
+	assert.Equal(t, 0.0, cost.MemCost)
+	assert.InDelta(t, 11.2, cost.Total(), 1e-9)
+
+	// 未结束的作业计算到now
+	cost = engine.Cost(&billing.Usage{Partition: "compute", Cpus: 4, MemMb: 2048, StartTime: 3600}, 2*3600)
+	assert.InDelta(t, 0.4, cost.CpuCost, 1e-9)
+	assert.InDelta(t, 0.02, cost.MemCost, 1e-9)
+
+	// 排队的作业没有费用
+	cost = engine.Cost(&billing.Usage{Partition: "compute", Cpus: 4}, 2*3600)
+	assert.True(t, cost.Priced)
+	assert.Equal(t, 0.0, cost.Total())
+}
+
+func TestCostMultipliers(t *testing.T) {
+	engine := newEngine(t,
+		utils.TimeMultiplier{Start: "22:00", End: "08:00", Multiplier: 0.5},
+		utils.TimeMultiplier{Start: "12:00", End: "13:00", Multiplier: 2},
+	)
+	at := func(d int, h int) int64 { return time.Date(2024, 1, d, h, 0, 0, 0, time.UTC).Unix() }
+	tests := []struct {
+		name  string
+		start int64
+		end   int64
+		hours float64
+	}{
+		{"daytime", at(1, 9), at(1, 11), 2},
+		{"night across midnight", at(1, 23), at(2, 2), 1.5},
+		{"into the night", at(1, 20), at(1, 23), 2 + 0.5},
+		{"noon", at(1, 11), at(1, 14), 1 + 2 + 1},
+		// 8-22点中12-13点翻倍, 其余时间减半
+		{"whole day", at(1, 0), at(2, 0), 10*0.5 + 13 + 2},
+	}
+	for _, tt := range tests {
+		cost := engine.Cost(&billing.Usage{Partition: "compute", Cpus: 1, StartTime: tt.start, EndTime: tt.end}, 0)
+		assert.InDelta(t, tt.hours*0.1, cost.CpuCost, 1e-9, tt.name)
+	}
+}
+
+func TestNewBillingErrors(t *testing.T) {
+	_, err := billing.New(&utils.BillingConfig{})
+	assert.ErrorIs(t, err, billing.ErrNotConfigured)
+
+	_, err = billing.New(&utils.BillingConfig{
+		Prices:      []utils.PriceRule{{CpuCoreHour: 1}},
+		Multipliers: []utils.TimeMultiplier{{Start: "10pm", End: "08:00", Multiplier: 0.5}},
+	})
+	assert.Error(t, err)
+
+	_, err = billing.New(&utils.BillingConfig{Prices: []utils.PriceRule{{CpuCoreHour: 1}}, Timezone: "Nowhere/City"})
+	assert.Error(t, err)
+}
@@ -7,6 +7,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetClusterInfo(t *testing.T) {
@@ -28,3 +31,30 @@ func TestGetClusterInfo(t *testing.T) {
 	// Check the result
 	assert.IsType(t, []*pb.PartitionInfo{}, res.Partitions)
 }
+
+func TestGetClusterInfoWithCluster(t *testing.T) {
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewConfigServiceClient(conn)
+
+	// 不存在的集群
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "cluster", "cluster-not-exist")
+	_, err = client.GetClusterInfo(ctx, &pb.GetClusterInfoRequest{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// 指定默认集群和不指定集群的结果相同
+	res, err := client.GetClusterInfo(context.Background(), &pb.GetClusterInfoRequest{})
+	if err != nil {
+		t.Fatalf("GetClusterInfo failed: %v", err)
+	}
+	ctx = metadata.AppendToOutgoingContext(context.Background(), "cluster", res.ClusterName)
+	clusterRes, err := client.GetClusterInfo(ctx, &pb.GetClusterInfoRequest{})
+	if err != nil {
+		t.Fatalf("GetClusterInfo failed: %v", err)
+	}
+	assert.Equal(t, res.ClusterName, clusterRes.ClusterName)
+}
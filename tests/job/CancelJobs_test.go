@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	pb "scow-slurm-adapter/gen/go"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCancelJobsDryRun(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	jobName := "test"
+	req := &pb.CancelJobsRequest{
+		UserId: "test15",
+		Filter: &pb.CancelJobsRequest_Filter{
+			States:  []string{"PENDING"},
+			JobName: &jobName,
+		},
+		DryRun: true,
+	}
+	res, err := client.CancelJobs(context.Background(), req)
+	if err != nil {
+		t.Fatalf("CancelJobs failed: %v", err)
+	}
+
+	// Check the result, 只统计不取消
+	assert.Empty(t, res.Results)
+
+	// 没有user_id时必须明确以管理员身份取消
+	req.UserId = ""
+	_, err = client.CancelJobs(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	req.Admin = true
+	_, err = client.CancelJobs(context.Background(), req)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	pb "scow-slurm-adapter/gen/go"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestGetJobCost(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	res, err := client.GetJobCost(context.Background(), &pb.GetJobCostRequest{JobId: 1})
+	if err != nil {
+		t.Fatalf("GetJobCost failed: %v", err)
+	}
+	assert.Equal(t, uint32(1), res.Cost.JobId)
+}
+
+func TestGetJobsCost(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	req := &pb.GetJobsCostRequest{
+		Filter:   &pb.GetJobsRequest_Filter{Accounts: []string{"a_admin"}},
+		PageInfo: &pb.PageInfo{Page: 1, PageSize: 10},
+	}
+	res, err := client.GetJobsCost(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetJobsCost failed: %v", err)
+	}
+	assert.LessOrEqual(t, len(res.Costs), 10)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	pb "scow-slurm-adapter/gen/go"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetJobEfficiency(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	req := &pb.GetJobEfficiencyRequest{
+		JobId: 1274,
+	}
+	res, err := client.GetJobEfficiency(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetJobEfficiency failed: %v", err)
+	}
+
+	// Check the result, 通过判断错误为nil 来决定是否执行成功
+	assert.Equal(t, uint32(1274), res.Efficiency.JobId)
+}
+
+func TestGetEfficiencySummary(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	req := &pb.GetEfficiencySummaryRequest{
+		EndTime: &pb.TimeRange{
+			StartTime: &timestamppb.Timestamp{Seconds: 1682066342},
+			EndTime:   &timestamppb.Timestamp{Seconds: 1682586485},
+		},
+		GroupBy: pb.GetEfficiencySummaryRequest_ACCOUNT,
+	}
+	res, err := client.GetEfficiencySummary(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetEfficiencySummary failed: %v", err)
+	}
+
+	// Check the result, 通过判断错误为nil 来决定是否执行成功
+	assert.IsType(t, []*pb.EfficiencySummary{}, res.Summaries)
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "scow-slurm-adapter/gen/go"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestGetJobPriority(t *testing.T) {
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	res, err := client.GetJobPriority(context.Background(), &pb.GetJobPriorityRequest{JobId: 138549})
+	if err != nil {
+		t.Fatalf("GetJobPriority failed: %v", err)
+	}
+	for _, priority := range res.Priorities {
+		assert.NotZero(t, priority.Rank)
+		assert.LessOrEqual(t, priority.Rank, priority.PendingCount)
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	pb "scow-slurm-adapter/gen/go"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestGetJobSteps(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	req := &pb.GetJobStepsRequest{
+		JobId: 1274,
+	}
+	res, err := client.GetJobSteps(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetJobSteps failed: %v", err)
+	}
+
+	// Check the result, 通过判断错误为nil 来决定是否执行成功
+	assert.IsType(t, []*pb.JobStepInfo{}, res.Steps)
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "scow-slurm-adapter/gen/go"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestGetNextAvailableSlot(t *testing.T) {
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	timeLimit := uint32(60)
+	res, err := client.GetNextAvailableSlot(context.Background(), &pb.GetNextAvailableSlotRequest{
+		UserId:           "test15",
+		Account:          "a_admin",
+		Partition:        "compute",
+		NodeCount:        1,
+		CoreCount:        2,
+		TimeLimitMinutes: &timeLimit,
+	})
+	if err != nil {
+		t.Fatalf("GetNextAvailableSlot failed: %v", err)
+	}
+	// 作业没有真正提交
+	if res.Slot.Accepted {
+		assert.NotNil(t, res.Slot.EstimatedStartTime)
+		assert.Equal(t, "compute", res.Slot.Partition)
+	} else {
+		assert.NotEmpty(t, res.Slot.RejectionReason)
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	pb "scow-slurm-adapter/gen/go"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetUsageSummary(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	req := &pb.GetUsageSummaryRequest{
+		TimeRange: &pb.TimeRange{StartTime: timestamppb.New(time.Now().AddDate(0, -1, 0))},
+		GroupBy:   []pb.GetUsageSummaryRequest_GroupBy{pb.GetUsageSummaryRequest_ACCOUNT, pb.GetUsageSummaryRequest_PARTITION},
+		Bucket:    pb.GetUsageSummaryRequest_DAY,
+	}
+	res, err := client.GetUsageSummary(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetUsageSummary failed: %v", err)
+	}
+
+	// Check the result, 通过判断错误为nil 来决定是否执行成功
+	assert.IsType(t, []*pb.UsageSummary{}, res.Summaries)
+}
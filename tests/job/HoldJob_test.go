@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	pb "scow-slurm-adapter/gen/go"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHoldJob(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	req := &pb.HoldJobRequest{
+		UserId: "test03",
+		JobId:  1274,
+	}
+	res, err := client.HoldJob(context.Background(), req)
+	if err != nil {
+		t.Fatalf("HoldJob failed: %v", err)
+	}
+	assert.Equal(t, "PENDING", res.State)
+
+	releaseReq := &pb.ReleaseJobRequest{
+		UserId: "test03",
+		JobId:  1274,
+	}
+	_, err = client.ReleaseJob(context.Background(), releaseReq)
+
+	// Check the result, 通过判断错误为nil 来决定是否执行成功
+	assert.Nil(t, err)
+
+	// 空的user_id不表示管理员
+	_, err = client.HoldJob(context.Background(), &pb.HoldJobRequest{JobId: 1274})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "scow-slurm-adapter/gen/go"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestGetJobsPendingReason(t *testing.T) {
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	// 说明文字使用accept-language指定的语言
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "accept-language", "zh-CN")
+	res, err := client.GetJobs(ctx, &pb.GetJobsRequest{
+		Fields: []string{"job_id", "state", "reason", "pending_reason"},
+		Filter: &pb.GetJobsRequest_Filter{States: []string{"PENDING"}},
+	})
+	if err != nil {
+		t.Fatalf("GetJobs failed: %v", err)
+	}
+	for _, job := range res.Jobs {
+		if job.PendingReason == nil {
+			continue
+		}
+		assert.NotEmpty(t, job.PendingReason.Code)
+		assert.NotEmpty(t, job.PendingReason.Explanation)
+		assert.Contains(t, job.GetReason(), job.PendingReason.Code)
+	}
+}
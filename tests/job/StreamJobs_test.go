@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"io"
+	pb "scow-slurm-adapter/gen/go"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestStreamJobs(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	req := &pb.StreamJobsRequest{
+		Fields:    []string{"job_id", "state"},
+		Filter:    &pb.GetJobsRequest_Filter{Users: []string{"test03"}},
+		BatchSize: 100,
+	}
+	stream, err := client.StreamJobs(context.Background(), req)
+	if err != nil {
+		t.Fatalf("StreamJobs failed: %v", err)
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("StreamJobs failed: %v", err)
+		}
+		// Check the result, 每批不超过batch_size个作业
+		assert.LessOrEqual(t, len(res.Jobs), 100)
+	}
+}
+
+func TestGetJobsWithPageToken(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	req := &pb.GetJobsRequest{
+		Filter:         &pb.GetJobsRequest_Filter{Users: []string{"test03"}},
+		PageInfo:       &pb.PageInfo{Page: 1, PageSize: 10},
+		SkipTotalCount: true,
+	}
+	res, err := client.GetJobs(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetJobs failed: %v", err)
+	}
+	assert.Nil(t, res.TotalCount)
+	if res.NextPageToken != nil {
+		req.PageToken = res.NextPageToken
+		_, err = client.GetJobs(context.Background(), req)
+		assert.Nil(t, err)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	pb "scow-slurm-adapter/gen/go"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSubmitHetJob(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	timeLimitMinutes := uint32(10)
+	req := &pb.SubmitJobRequest{
+		UserId:           "test15",
+		JobName:          "het",
+		Account:          "a_admin",
+		Partition:        "compute",
+		TimeLimitMinutes: &timeLimitMinutes,
+		Script:           "srun --het-group=0,1 hostname",
+		WorkingDirectory: "het",
+		HetComponents: []*pb.HetJobComponent{
+			{NodeCount: 1, CoreCount: 1},
+			{Partition: "gpu", NodeCount: 1, CoreCount: 4, GpuCount: 1},
+		},
+	}
+	res, err := client.SubmitJob(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SubmitJob failed: %v", err)
+	}
+	assert.Contains(t, res.GeneratedScript, "#SBATCH hetjob\n")
+
+	// 组件0包含全部组件的资源
+	jobRes, err := client.GetJobById(context.Background(), &pb.GetJobByIdRequest{JobId: res.JobId})
+	if err != nil {
+		t.Fatalf("GetJobById failed: %v", err)
+	}
+	assert.Equal(t, res.JobId, jobRes.Job.GetHetJobId())
+	assert.Equal(t, uint32(0), jobRes.Job.GetHetJobOffset())
+	assert.Len(t, jobRes.Job.HetComponents, 2)
+
+	// 异构作业不能是作业数组
+	array := "1-2"
+	req.Array = &array
+	_, err = client.SubmitJob(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
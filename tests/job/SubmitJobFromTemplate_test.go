@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	pb "scow-slurm-adapter/gen/go"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSubmitJobFromTemplate(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	templates, err := client.ListJobTemplates(context.Background(), &pb.ListJobTemplatesRequest{})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	t.Logf("templates: %v", templates.Templates)
+
+	_, err = client.SubmitJobFromTemplate(context.Background(), &pb.SubmitJobFromTemplateRequest{
+		UserId:       "test15",
+		TemplateName: "template-not-exist",
+		JobName:      "template",
+		Account:      "a_admin",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	if len(templates.Templates) == 0 {
+		t.Skip("no job template configured")
+	}
+	template := templates.Templates[0]
+	variables := make(map[string]string)
+	for _, name := range template.RequiredVariables {
+		variables[name] = "test"
+	}
+	res, err := client.SubmitJobFromTemplate(context.Background(), &pb.SubmitJobFromTemplateRequest{
+		UserId:           "test15",
+		TemplateName:     template.Name,
+		JobName:          "template",
+		Account:          "a_admin",
+		WorkingDirectory: "template",
+		Variables:        variables,
+		DryRun:           true,
+	})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	assert.NotNil(t, res.DryRunResult)
+	assert.NotEmpty(t, res.GeneratedScript)
+}
@@ -50,3 +50,34 @@ func TestSubmitJob(t *testing.T) {
 	// assert.Empty(t, err)
 	assert.IsType(t, uint32(1), res.JobId)
 }
+
+func TestSubmitJobDryRun(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8999", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	req := &pb.SubmitJobRequest{
+		UserId:           "test15",
+		JobName:          "test",
+		Account:          "a_admin",
+		Partition:        "compute",
+		NodeCount:        1,
+		CoreCount:        1,
+		Script:           "sleep 100",
+		WorkingDirectory: "dffffeeee11",
+		DryRun:           true,
+	}
+	res, err := client.SubmitJob(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SubmitJob failed: %v", err)
+	}
+
+	// Check the result, 只检查不排队, 不返回作业id
+	assert.Equal(t, uint32(0), res.JobId)
+	assert.NotNil(t, res.DryRunResult)
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	pb "scow-slurm-adapter/gen/go"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestSubmitWorkflow(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	newJob := func(name string, script string) *pb.SubmitJobRequest {
+		return &pb.SubmitJobRequest{
+			JobName:          name,
+			Account:          "a_admin",
+			Partition:        "compute",
+			NodeCount:        1,
+			CoreCount:        1,
+			Script:           script,
+			WorkingDirectory: "workflow",
+		}
+	}
+	req := &pb.SubmitWorkflowRequest{
+		UserId: "test15",
+		Jobs: []*pb.WorkflowJob{
+			{
+				Name:      "post",
+				Job:       newJob("post", "echo post"),
+				DependsOn: []*pb.WorkflowDependency{{Name: "simulate", Type: pb.JobDependency_AFTER_OK}},
+			},
+			{
+				Name:      "simulate",
+				Job:       newJob("simulate", "sleep 10"),
+				DependsOn: []*pb.WorkflowDependency{{Name: "pre", Type: pb.JobDependency_AFTER_OK}},
+			},
+			{
+				Name: "pre",
+				Job:  newJob("pre", "echo pre"),
+			},
+		},
+	}
+	res, err := client.SubmitWorkflow(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SubmitWorkflow failed: %v", err)
+	}
+
+	// Check the result, 作业按依赖顺序提交
+	assert.Len(t, res.Jobs, 3)
+	assert.Equal(t, "pre", res.Jobs[0].Name)
+	assert.Equal(t, "simulate", res.Jobs[1].Name)
+	assert.Equal(t, "post", res.Jobs[2].Name)
+}
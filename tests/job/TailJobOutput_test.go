@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"io"
+	pb "scow-slurm-adapter/gen/go"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestTailJobOutput(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	maxBytes := uint64(4096)
+	req := &pb.TailJobOutputRequest{
+		UserId:     "test03",
+		JobId:      1274,
+		OutputType: pb.TailJobOutputRequest_STDOUT,
+		MaxBytes:   &maxBytes,
+	}
+	stream, err := client.TailJobOutput(context.Background(), req)
+	if err != nil {
+		t.Fatalf("TailJobOutput failed: %v", err)
+	}
+	// 结束的作业读完输出后服务端会关闭流
+	var received uint64
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("TailJobOutput failed: %v", err)
+		}
+		received += uint64(len(res.Data))
+		assert.Equal(t, req.Offset+received, res.Offset)
+	}
+	assert.LessOrEqual(t, received, maxBytes)
+}
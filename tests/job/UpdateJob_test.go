@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "scow-slurm-adapter/gen/go"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateJob(t *testing.T) {
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	name := "renamed"
+	comment := "moved by user"
+	res, err := client.UpdateJob(context.Background(), &pb.UpdateJobRequest{JobId: 138549, Name: &name, Comment: &comment})
+	if err != nil {
+		t.Fatalf("UpdateJob failed: %v", err)
+	}
+	assert.Empty(t, res.RejectedFields)
+}
+
+func TestUpdateJobNotFound(t *testing.T) {
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	name := "renamed"
+	_, err = client.UpdateJob(context.Background(), &pb.UpdateJobRequest{JobId: 999999999, Name: &name})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
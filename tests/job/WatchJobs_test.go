@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	pb "scow-slurm-adapter/gen/go"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestWatchJobs(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewJobServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	req := &pb.WatchJobsRequest{
+		Filter: &pb.WatchJobsRequest_Filter{
+			Users: []string{"test03"},
+		},
+		InitialSnapshot: true,
+	}
+	stream, err := client.WatchJobs(ctx, req)
+	if err != nil {
+		t.Fatalf("WatchJobs failed: %v", err)
+	}
+	res, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("WatchJobs failed: %v", err)
+	}
+
+	// Check the result, 第一批是当前的作业
+	assert.Equal(t, pb.JobEvent_INITIAL, res.Events[0].Type)
+}
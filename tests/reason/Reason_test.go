@@ -0,0 +1,165 @@
+package main
+
+import (
+	"testing"
+
+	"scow-slurm-adapter/reason"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	assert.Nil(t, reason.Classify(""))
+	assert.Nil(t, reason.Classify("None"))
+
+	r := reason.Classify("Priority")
+	assert.Equal(t, reason.CategoryPriority, r.Category)
+	assert.Equal(t, "Priority", r.Code)
+	assert.Nil(t, r.Params)
+
+	// 不可用的节点作为参数
+	r = reason.Classify("ReqNodeNotAvail, UnavailableNodes:cn[01-04]")
+	assert.Equal(t, reason.CategoryResources, r.Category)
+	assert.Equal(t, "ReqNodeNotAvail", r.Code)
+	assert.Equal(t, map[string]string{"unavailable_nodes": "cn[01-04]"}, r.Params)
+	r = reason.Classify("ReqNodeNotAvail, Reserved for maintenance")
+	assert.Equal(t, map[string]string{"detail": "Reserved for maintenance"}, r.Params)
+
+	// 关联和QOS的限制
+	r = reason.Classify("AssocGrpCPUMinutesLimit")
+	assert.Equal(t, reason.CategoryLimits, r.Category)
+	assert.Equal(t, map[string]string{"scope": "association", "limit": "GrpCPUMinutes"}, r.Params)
+	r = reason.Classify("AssocGrpGRES")
+	assert.Equal(t, map[string]string{"scope": "association", "limit": "GrpGRES"}, r.Params)
+	r = reason.Classify("QOSMaxCpuPerUserLimit")
+	assert.Equal(t, map[string]string{"scope": "qos", "limit": "MaxCpuPerUser"}, r.Params)
+	r = reason.Classify("PartitionTimeLimit")
+	assert.Equal(t, map[string]string{"scope": "partition", "limit": "Time"}, r.Params)
+
+	assert.Equal(t, reason.CategoryDependency, reason.Classify("DependencyNeverSatisfied").Category)
+	assert.Equal(t, reason.CategoryHeld, reason.Classify("JobHeldUser").Category)
+	assert.Equal(t, reason.CategoryPartitionDown, reason.Classify("PartitionDown").Category)
+	assert.Equal(t, reason.CategoryOther, reason.Classify("NewReasonOfLaterSlurm").Category)
+
+	// 账户没有分区权限时解析出分区和账户
+	r = reason.Classify("(Job's account not permitted to use this partition (compute allows a1,a2 not a3))")
+	assert.Equal(t, reason.CategoryAccountBlocked, r.Category)
+	assert.Equal(t, reason.AccountNotPermitted, r.Code)
+	assert.Equal(t, map[string]string{"partition": "compute", "allowed_accounts": "a1,a2", "account": "a3"}, r.Params)
+	// squeue的原因去掉括号时也去掉了最后的右括号
+	r = reason.Classify("Job's account not permitted to use this partition (compute allows a1,a2 not a3")
+	assert.Equal(t, "a3", r.Params["account"])
+}
+
+// slurm的job_state_reason中的所有原因代码, 见slurm_protocol_defs.c中的job_state_reason_string
+var slurmReasons = map[reason.Category][]string{
+	reason.CategoryResources: {
+		"WaitingForScheduling", "Resources", "ReqNodeNotAvail", "NodeDown", "BadConstraints", "Reservation",
+		"Licenses", "BurstBufferResources", "BurstBufferStageIn", "FrontEndDown", "PowerNotAvail", "PowerReserved",
+		"Prolog", "Cleaning",
+		"Nodes required for job are DOWN, DRAINED or reserved for jobs in higher priority partitions",
+	},
+	reason.CategoryPriority: {
+		"Priority",
+	},
+	reason.CategoryDependency: {
+		"Dependency", "DependencyNeverSatisfied",
+	},
+	reason.CategoryHeld: {
+		"JobHeldUser", "JobHeldAdmin", "JobHoldMaxRequeue", "launch failed requeued held",
+		"job requeued in held state",
+	},
+	reason.CategoryPartitionDown: {
+		"PartitionDown", "PartitionInactive",
+	},
+	reason.CategoryAccountBlocked: {
+		"InvalidAccount", "AccountNotAllowed",
+	},
+	reason.CategoryLimits: {
+		"PartitionNodeLimit", "PartitionTimeLimit", "JobArrayTaskLimit", "AccountingPolicy", "InvalidQOS",
+		"MaxMemPerLimit", "AssociationJobLimit", "AssociationResourceLimit", "AssociationTimeLimit",
+		"AssocGrpCpuLimit", "AssocGrpCPUMinutesLimit", "AssocGrpCPURunMinutesLimit", "AssocGrpJobsLimit",
+		"AssocGrpMemLimit", "AssocGrpNodeLimit", "AssocGrpSubmitJobsLimit", "AssocGrpWallLimit",
+		"AssocMaxJobsLimit", "AssocMaxCpuPerJobLimit", "AssocMaxCpuMinutesPerJobLimit", "AssocMaxNodePerJobLimit",
+		"AssocMaxWallDurationPerJobLimit", "AssocMaxSubmitJobLimit", "AssocGrpEnergy", "AssocGrpEnergyMinutes",
+		"AssocGrpEnergyRunMinutes", "AssocGrpGRES", "AssocGrpGRESMinutes", "AssocGrpGRESRunMinutes",
+		"AssocGrpLicense", "AssocGrpLicenseMinutes", "AssocGrpLicenseRunMinutes", "AssocGrpMemMinutes",
+		"AssocGrpMemRunMinutes", "AssocGrpNodeMinutes", "AssocGrpNodeRunMinutes", "AssocGrpBB",
+		"AssocGrpBBMinutes", "AssocGrpBBRunMinutes", "AssocGrpBilling", "AssocGrpBillingMinutes",
+		"AssocGrpBillingRunMinutes", "AssocMaxEnergyPerJob", "AssocMaxEnergyMinutesPerJob", "AssocMaxGRESPerJob",
+		"AssocMaxGRESMinutesPerJob", "AssocMaxLicensePerJob", "AssocMaxLicenseMinutesPerJob", "AssocMaxMemPerJob",
+		"AssocMaxMemMinutesPerJob", "AssocMaxNodeMinutesPerJob", "AssocMaxBBPerJob", "AssocMaxBBMinutesPerJob",
+		"AssocMaxBillingPerJob", "AssocMaxBillingMinutesPerJob", "QOSJobLimit", "QOSResourceLimit", "QOSTimeLimit",
+		"QOSUsageThreshold", "QOSGrpCpuLimit", "QOSGrpCPUMinutesLimit", "QOSGrpCPURunMinutesLimit",
+		"QOSGrpJobsLimit", "QOSGrpMemLimit", "QOSGrpNodeLimit", "QOSGrpSubmitJobsLimit", "QOSGrpWallLimit",
+		"QOSGrpEnergy", "QOSGrpEnergyMinutes", "QOSGrpEnergyRunMinutes", "QOSGrpGRES", "QOSGrpGRESMinutes",
+		"QOSGrpGRESRunMinutes", "QOSGrpLicense", "QOSGrpLicenseMinutes", "QOSGrpLicenseRunMinutes",
+		"QOSGrpMemoryMinutes", "QOSGrpMemoryRunMinutes", "QOSGrpNodeMinutes", "QOSGrpNodeRunMinutes", "QOSGrpBB",
+		"QOSGrpBBMinutes", "QOSGrpBBRunMinutes", "QOSGrpBilling", "QOSGrpBillingMinutes",
+		"QOSGrpBillingRunMinutes", "QOSMaxCpuPerJobLimit", "QOSMaxCpuMinutesPerJobLimit", "QOSMaxNodePerJobLimit",
+		"QOSMaxWallDurationPerJobLimit", "QOSMaxCpuPerUserLimit", "QOSMaxJobsPerUserLimit",
+		"QOSMaxNodePerUserLimit", "QOSMaxSubmitJobPerUserLimit", "QOSMinCpuNotSatisfied", "QOSMaxEnergyPerJob",
+		"QOSMaxEnergyMinutesPerJob", "QOSMaxEnergyPerNode", "QOSMaxEnergyPerUser", "QOSMaxGRESPerJob",
+		"QOSMaxGRESMinutesPerJob", "QOSMaxGRESPerNode", "QOSMaxGRESPerUser", "QOSMaxLicensePerJob",
+		"QOSMaxLicenseMinutesPerJob", "QOSMaxMemoryPerJob", "QOSMaxMemoryMinutesPerJob", "QOSMaxMemoryPerNode",
+		"QOSMaxMemoryPerUser", "QOSMaxNodeMinutesPerJob", "QOSMaxBBPerJob", "QOSMaxBBMinutesPerJob",
+		"QOSMaxBBPerNode", "QOSMaxBBPerUser", "QOSMaxBillingPerJob", "QOSMaxBillingMinutesPerJob",
+		"QOSMaxBillingPerNode", "QOSMaxBillingPerUser", "QOSMaxCpuPerNode", "QOSMinEnergy", "QOSMinGRES",
+		"QOSMinLicense", "QOSMinMemory", "QOSMinNode", "QOSMinBB", "QOSMinBilling", "QOSMaxBBPerAccount",
+		"QOSMaxBillingPerAccount", "QOSMaxCpuPerAccount", "QOSMaxEnergyPerAccount", "QOSMaxGRESPerAccount",
+		"QOSMaxNodePerAccount", "QOSMaxLicensePerAccount", "QOSMaxMemoryPerAccount", "QOSMaxUnknownPerAccount",
+		"QOSMaxJobsPerAccount", "QOSMaxSubmitJobsPerAccount",
+	},
+	reason.CategoryOther: {
+		"BeginTime", "PartitionConfig", "SchedDefer", "SystemFailure", "JobLaunchFailure", "NonZeroExitCode",
+		"RaisedSignal", "TimeLimit", "InactiveLimit", "BurstBufferOperation", "DeadLine", "FedJobLock",
+		"OutOfMemory", "ReservationDeleted",
+	},
+}
+
+func TestClassifySlurmReasons(t *testing.T) {
+	for category, codes := range slurmReasons {
+		for _, code := range codes {
+			r := reason.Classify(code)
+			if !assert.NotNil(t, r, code) {
+				continue
+			}
+			assert.Equal(t, code, r.Code)
+			assert.Equal(t, category, r.Category, code)
+			// 所有语言的说明中的参数都要替换掉
+			for _, locale := range []string{"en", "zh-CN"} {
+				explanation := r.Explain(locale)
+				assert.NotEmpty(t, explanation, code)
+				assert.NotContains(t, explanation, "{", code)
+			}
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	r := reason.Classify("AssocGrpCPUMinutesLimit")
+	assert.Equal(t, "The association limit GrpCPUMinutes has been reached, the job will start when usage drops below it.", r.Explain("en"))
+	assert.Equal(t, "达到了关联的GrpCPUMinutes限制, 用量降低后作业才会开始运行。", r.Explain("zh-CN"))
+	// 不支持的语言使用英文
+	assert.Equal(t, r.Explain("en"), r.Explain("fr"))
+
+	r = reason.Classify("ReqNodeNotAvail, UnavailableNodes:cn[01-04]")
+	assert.Equal(t, "The requested nodes are not available: cn[01-04]", r.Explain("en"))
+	assert.Equal(t, "作业请求的节点不可用: cn[01-04]", r.Explain("zh-CN"))
+
+	r = reason.Classify("Job's account not permitted to use this partition (compute allows a1 not a3)")
+	assert.Equal(t, "The account a3 is not allowed to use partition compute, it may have been blocked.", r.Explain("en"))
+	// 缺少参数时使用分类的说明
+	r = reason.Classify("Job's account not permitted to use this partition")
+	assert.Equal(t, "The account is not allowed to use the partition, it may have been blocked.", r.Explain("en"))
+
+	assert.Equal(t, "The job is pending (NewReasonOfLaterSlurm).", reason.Classify("NewReasonOfLaterSlurm").Explain("en"))
+}
+
+func TestMatchLocale(t *testing.T) {
+	assert.Equal(t, "en", reason.MatchLocale(""))
+	assert.Equal(t, "zh-CN", reason.MatchLocale("zh-CN,zh;q=0.9,en;q=0.8"))
+	assert.Equal(t, "zh-CN", reason.MatchLocale("zh_cn"))
+	assert.Equal(t, "en", reason.MatchLocale("fr-FR, en-US;q=0.5"))
+	assert.Equal(t, "en", reason.MatchLocale("fr"))
+}
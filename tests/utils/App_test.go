@@ -0,0 +1,37 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"scow-slurm-adapter/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAppConnectionInfo(t *testing.T) {
+	info, port, err := utils.ParseAppConnectionInfo([]byte(`{"HOST": "cn01", "PORT": 8888, "PASSWORD": "secret"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "cn01", info.Host)
+	assert.Equal(t, uint32(8888), port)
+	assert.Equal(t, "secret", info.Password)
+
+	// 端口可以是字符串
+	_, port, err = utils.ParseAppConnectionInfo([]byte(`{"port": "5901"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(5901), port)
+
+	// 文件为空、没写完或者没有端口时视为还没准备好
+	for _, data := range []string{"", "\n", `{"host": "cn01", "po`, `{"host": "cn01"}`, `{"port": 70000}`} {
+		_, _, err = utils.ParseAppConnectionInfo([]byte(data))
+		assert.True(t, errors.Is(err, utils.ErrConnectionInfoNotReady), data)
+	}
+}
+
+func TestFirstHost(t *testing.T) {
+	assert.Equal(t, "cn01", utils.FirstHost("cn01"))
+	assert.Equal(t, "cn01", utils.FirstHost("cn[01-02,05]"))
+	assert.Equal(t, "cn05", utils.FirstHost("cn[05,07-09],gpu01"))
+	assert.Equal(t, "gpu01", utils.FirstHost("gpu01,gpu02"))
+	assert.Equal(t, "", utils.FirstHost(""))
+}
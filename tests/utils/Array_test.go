@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+
+	"scow-slurm-adapter/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckArraySpec(t *testing.T) {
+	for _, spec := range []string{"1", "0-15", "0-15:4", "0-15:4%2", "1,3,5-7", "1-10%1"} {
+		assert.True(t, utils.CheckArraySpec(spec), spec)
+	}
+	for _, spec := range []string{"", "a-b", "1-", "1-10%", "1-10\n#SBATCH -A x", "1;hostname"} {
+		assert.False(t, utils.CheckArraySpec(spec), spec)
+	}
+}
+
+func TestArraySpecContains(t *testing.T) {
+	assert.True(t, utils.ArraySpecContains("3", 3))
+	assert.False(t, utils.ArraySpecContains("3", 4))
+	assert.True(t, utils.ArraySpecContains("[2-10%2]", 10))
+	assert.False(t, utils.ArraySpecContains("2-10%2", 1))
+	assert.True(t, utils.ArraySpecContains("0-15:4", 8))
+	assert.False(t, utils.ArraySpecContains("0-15:4", 9))
+	assert.True(t, utils.ArraySpecContains("1,3,5-7", 6))
+	assert.False(t, utils.ArraySpecContains("1,3,5-7", 4))
+	assert.False(t, utils.ArraySpecContains("", 0))
+}
+
+func TestArrayTaskIdFromBitmap(t *testing.T) {
+	assert.Equal(t, "1-10", utils.ArrayTaskIdFromBitmap("0x7FE"))
+	assert.Equal(t, "0,2,4-5", utils.ArrayTaskIdFromBitmap("0x35"))
+	assert.Equal(t, "", utils.ArrayTaskIdFromBitmap("0x0"))
+	assert.Equal(t, "1-3", utils.ArrayTaskIdFromBitmap("1-3"))
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	pb "scow-slurm-adapter/gen/go"
+	"scow-slurm-adapter/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDependencyOption(t *testing.T) {
+	dependencies := []*pb.JobDependency{
+		{Type: pb.JobDependency_AFTER_OK, JobIds: []uint32{12, 13}},
+		{Type: pb.JobDependency_AFTER_ANY, JobIds: []uint32{14}},
+		{Type: pb.JobDependency_SINGLETON},
+	}
+	option, err := utils.DependencyOption(dependencies, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "afterok:12:13,afterany:14,singleton", option)
+
+	option, err = utils.DependencyOption(dependencies[:2], true)
+	assert.Nil(t, err)
+	assert.Equal(t, "afterok:12:13?afterany:14", option)
+
+	option, err = utils.DependencyOption(nil, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "", option)
+
+	// 除singleton外的依赖必须指定作业
+	_, err = utils.DependencyOption([]*pb.JobDependency{{Type: pb.JobDependency_AFTER_NOT_OK}}, false)
+	assert.NotNil(t, err)
+	// 没有指定类型时不能当作after
+	_, err = utils.DependencyOption([]*pb.JobDependency{{JobIds: []uint32{12}}}, false)
+	assert.NotNil(t, err)
+}
+
+func TestTopologicalOrder(t *testing.T) {
+	// 0依赖2, 1依赖0和2, 3没有依赖
+	dependsOn := map[int][]int{0: {2}, 1: {0, 2}}
+	order, err := utils.TopologicalOrder(4, func(i int) []int { return dependsOn[i] })
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2, 0, 1, 3}, order)
+
+	dependsOn = map[int][]int{0: {1}, 1: {2}, 2: {0}}
+	_, err = utils.TopologicalOrder(3, func(i int) []int { return dependsOn[i] })
+	assert.True(t, errors.Is(err, utils.ErrDependencyCycle))
+}
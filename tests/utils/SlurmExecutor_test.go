@@ -0,0 +1,148 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"scow-slurm-adapter/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 假的执行器, 记录调用参数并返回预设的结果
+type fakeExecutor struct {
+	calls  [][]string
+	stdin  string
+	result map[string]*utils.CommandResult
+}
+
+func (f *fakeExecutor) Execute(name string, args ...string) (*utils.CommandResult, error) {
+	return f.ExecuteWithStdin("", name, args...)
+}
+
+func (f *fakeExecutor) ExecuteWithStdin(stdin string, name string, args ...string) (*utils.CommandResult, error) {
+	f.calls = append(f.calls, append([]string{name}, args...))
+	f.stdin = stdin
+	if result, ok := f.result[name]; ok {
+		return result, nil
+	}
+	return &utils.CommandResult{}, nil
+}
+
+func TestGetPartitionInfo(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"scontrol": {Stdout: "PartitionName=compute AllowAccounts=ALL TotalCPUs=96\nPartitionName=gpu AllowAccounts=a,b TotalCPUs=64\n"},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+
+	partitions, err := utils.GetPartitionInfo()
+	assert.Empty(t, err)
+	assert.Equal(t, []string{"compute", "gpu"}, partitions)
+	assert.Equal(t, []string{"scontrol", "show", "partition", "--oneliner"}, fake.calls[0])
+}
+
+func TestRunSlurmCommandKeepsArgv(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"squeue": {Stderr: "slurm_load_jobs error: Invalid job id specified\n", ExitCode: 1},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+
+	// 含有shell元字符的参数作为单个参数传递, 不会被shell解析
+	jobName := "job; rm -rf ~"
+	_, err := utils.RunSlurmCommand("squeue", "--noheader", "-n", jobName)
+	assert.Equal(t, []string{"squeue", "--noheader", "-n", jobName}, fake.calls[0])
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "Invalid job id"))
+}
+
+func TestLocalSubmitJobUsesStdin(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"su": {Stdout: "Submitted batch job 123\n"},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+	// LocalSubmitJob需要读取项目根目录下的配置文件
+	wd, _ := os.Getwd()
+	os.Chdir("../..")
+	defer os.Chdir(wd)
+
+	script := "#!/bin/bash\necho 'hello'\n"
+	output, err := utils.LocalSubmitJob(script, "test01", "")
+	assert.Empty(t, err)
+	assert.Equal(t, "Submitted batch job 123\n", output)
+	assert.Equal(t, script, fake.stdin)
+	assert.Equal(t, []string{"su", "-", "test01", "-c"}, fake.calls[0][:4])
+}
+
+func TestParseHelpers(t *testing.T) {
+	assert.Equal(t, "cn01", utils.GetFirstNodeName("cn[01-04],gpu01"))
+	assert.Equal(t, "cn05", utils.GetFirstNodeName("cn[05,07-09]"))
+	assert.Equal(t, "node1", utils.GetFirstNodeName("node1,node2"))
+	assert.Equal(t, 4, utils.GetGpusFromGres("gpu:a100:4(S:0-1)"))
+	assert.Equal(t, 0, utils.GetGpusFromGres("(null)"))
+	assert.Equal(t, "375G", utils.ParseTres("cpu=96,mem=375G,node=2")["mem"])
+}
+
+func TestReadFileAsUser(t *testing.T) {
+	fake := &fakeExecutor{result: map[string]*utils.CommandResult{
+		"su": {Stdout: "hello\n"},
+	}}
+	utils.Executor = fake
+	defer func() { utils.Executor = &utils.LocalExecutor{} }()
+
+	data, err := utils.ReadFileAsUser("alice", "/home/alice/slurm-1.out", 10, 100)
+	assert.Empty(t, err)
+	assert.Equal(t, []byte("hello\n"), data)
+	assert.Equal(t, []string{"su", "-", "alice", "-c", "[ -e '/home/alice/slurm-1.out' ] || exit 2; exec dd if='/home/alice/slurm-1.out' iflag=skip_bytes,count_bytes skip=10 count=100 bs=64K status=none"}, fake.calls[0])
+
+	// 一次最多读取ReadFileMaxSize字节
+	_, err = utils.ReadFileAsUser("alice", "/home/alice/slurm-1.out", 0, 1<<40)
+	assert.Empty(t, err)
+	assert.Contains(t, fake.calls[len(fake.calls)-1][4], "count=1048576 ")
+
+	fake.result["su"] = &utils.CommandResult{ExitCode: 2}
+	_, err = utils.ReadFileAsUser("alice", "/home/alice/slurm-1.out", 0, 100)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	fake.result["su"] = &utils.CommandResult{ExitCode: 1, Stderr: "dd: failed to open '/home/alice/slurm-1.out': Permission denied"}
+	_, err = utils.ReadFileAsUser("alice", "/home/alice/slurm-1.out", 0, 100)
+	assert.Contains(t, err.Error(), "Permission denied")
+
+	// 退出码为0时标准错误中的输出不影响结果, 例如登录shell的提示信息
+	fake.result["su"] = &utils.CommandResult{Stdout: "hello\n", Stderr: "tail: bash profile noise"}
+	data, err = utils.ReadFileAsUser("alice", "/home/alice/slurm-1.out", 0, 100)
+	assert.Empty(t, err)
+	assert.Equal(t, []byte("hello\n"), data)
+}
+
+func TestDecodeExitStatus(t *testing.T) {
+	exitCode, signal := utils.DecodeExitStatus(0)
+	assert.Equal(t, []int32{0, 0}, []int32{exitCode, signal})
+	// exit 1
+	exitCode, signal = utils.DecodeExitStatus(256)
+	assert.Equal(t, []int32{1, 0}, []int32{exitCode, signal})
+	// 被SIGKILL终止
+	exitCode, signal = utils.DecodeExitStatus(9)
+	assert.Equal(t, []int32{0, 9}, []int32{exitCode, signal})
+	// SIGSEGV并产生core dump
+	exitCode, signal = utils.DecodeExitStatus(0x8b)
+	assert.Equal(t, []int32{0, 11}, []int32{exitCode, signal})
+	exitCode, signal = utils.DecodeExitStatus(0xfffffffe)
+	assert.Equal(t, []int32{0, 0}, []int32{exitCode, signal})
+}
+
+func TestParseTresUsage(t *testing.T) {
+	usage := utils.ParseTresUsage("cpu=1-00:01:02,energy=0,fs/disk=5229,mem=1052K,vmem=2G,cpu2=01:30.500")
+	assert.Equal(t, map[string]uint64{
+		"cpu":     (24*3600 + 62) * 1000,
+		"energy":  0,
+		"fs/disk": 5229,
+		"mem":     1052 * 1024,
+		"vmem":    2 * 1024 * 1024 * 1024,
+		"cpu2":    90500,
+	}, usage)
+	assert.Len(t, utils.ParseTresUsage(""), 0)
+}
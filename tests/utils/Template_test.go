@@ -0,0 +1,41 @@
+package main
+
+import (
+	"testing"
+
+	"scow-slurm-adapter/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobTemplate(t *testing.T) {
+	template := utils.JobTemplate{
+		Name:      "vasp",
+		Script:    "module load vasp/{{VERSION}}\nmpirun vasp_std > {{ OUTPUT }} 2> {{OUTPUT}}.err\necho ${HOME}",
+		Variables: map[string]string{"VERSION": "6.4.2"},
+	}
+	assert.Equal(t, []string{"OUTPUT"}, template.RequiredVariables())
+
+	script, err := template.RenderScript(map[string]string{"OUTPUT": "out.log"})
+	assert.Nil(t, err)
+	assert.Equal(t, "module load vasp/6.4.2\nmpirun vasp_std > out.log 2> out.log.err\necho ${HOME}", script)
+
+	// 指定的值覆盖默认值
+	script, err = template.RenderScript(map[string]string{"OUTPUT": "out.log", "VERSION": "5.4.4"})
+	assert.Nil(t, err)
+	assert.Contains(t, script, "vasp/5.4.4")
+
+	_, err = template.RenderScript(nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "[OUTPUT]")
+}
+
+func TestFindJobTemplate(t *testing.T) {
+	templates := []utils.JobTemplate{{Name: "vasp"}, {Name: "gromacs"}}
+	template, ok := utils.FindJobTemplate(templates, "gromacs")
+	assert.True(t, ok)
+	assert.Same(t, &templates[1], template)
+
+	_, ok = utils.FindJobTemplate(templates, "lammps")
+	assert.False(t, ok)
+}
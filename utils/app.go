@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// 没有配置时交互式应用连接信息文件的文件名
+const DefaultConnectionInfoFile = "server_session_info.json"
+
+// 连接信息文件为空、不完整或者还没有写入端口
+var ErrConnectionInfoNotReady = errors.New("connection info is not ready")
+
+// 交互式应用启动脚本写入的连接信息, 字段名不区分大小写, 如{"HOST": "cn01", "PORT": 8888, "PASSWORD": "xxx"}
+type AppConnectionInfo struct {
+	Host     string      `json:"host"`
+	Port     json.Number `json:"port"`
+	Password string      `json:"password"`
+}
+
+// 解析连接信息文件, 返回的端口一定有效
+func ParseAppConnectionInfo(data []byte) (*AppConnectionInfo, uint32, error) {
+	if strings.TrimSpace(string(data)) == "" {
+		return nil, 0, ErrConnectionInfoNotReady
+	}
+	info := &AppConnectionInfo{}
+	if err := json.Unmarshal(data, info); err != nil {
+		// 启动脚本可能还没有写完
+		return nil, 0, ErrConnectionInfoNotReady
+	}
+	port, err := strconv.ParseUint(info.Port.String(), 10, 16)
+	if err != nil || port == 0 {
+		return nil, 0, ErrConnectionInfoNotReady
+	}
+	return info, uint32(port), nil
+}
+
+// 节点列表中的第一个节点, 如cn[01-02,05],gpu01中的cn01
+var hostListRegexp = regexp.MustCompile(`^([^,\[]*)(?:\[([^\]-]+))?`)
+
+func FirstHost(nodeList string) string {
+	match := hostListRegexp.FindStringSubmatch(nodeList)
+	if match == nil {
+		return ""
+	}
+	return match[1] + strings.Split(match[2], ",")[0]
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// sbatch --array的取值, 如0-15:4%2、1,3,5-7
+var arraySpecRegexp = regexp.MustCompile(`^\d+(-\d+(:\d+)?)?(,\d+(-\d+(:\d+)?)?)*(%\d+)?$`)
+
+// 判断字符串是否为合法的作业数组下标
+func CheckArraySpec(s string) bool {
+	return arraySpecRegexp.MatchString(s)
+}
+
+// 判断作业数组下标中是否包含指定的任务, squeue中排队任务的下标带有方括号
+func ArraySpecContains(spec string, taskId uint32) bool {
+	spec = strings.Trim(spec, "[]")
+	if index := strings.Index(spec, "%"); index != -1 {
+		spec = spec[:index]
+	}
+	if !CheckArraySpec(spec) {
+		return false
+	}
+	for _, item := range strings.Split(spec, ",") {
+		step := uint64(1)
+		if index := strings.Index(item, ":"); index != -1 {
+			step, _ = strconv.ParseUint(item[index+1:], 10, 32)
+			item = item[:index]
+		}
+		startStr, endStr, isRange := strings.Cut(item, "-")
+		start, _ := strconv.ParseUint(startStr, 10, 32)
+		end := start
+		if isRange {
+			end, _ = strconv.ParseUint(endStr, 10, 32)
+		}
+		task := uint64(taskId)
+		if step != 0 && task >= start && task <= end && (task-start)%step == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// 记账数据库中array_task_str以十六进制位图保存还未拆分的任务, 如0x7FE, 转换为1-10这样的下标
+func ArrayTaskIdFromBitmap(bitmap string) string {
+	hex := strings.TrimPrefix(strings.TrimPrefix(bitmap, "0x"), "0X")
+	bits, ok := new(big.Int).SetString(hex, 16)
+	if !ok {
+		// 不是位图时原样返回
+		return bitmap
+	}
+	var ranges []string
+	for i := 0; i < bits.BitLen(); i++ {
+		if bits.Bit(i) == 0 {
+			continue
+		}
+		start := i
+		for i+1 < bits.BitLen() && bits.Bit(i+1) == 1 {
+			i++
+		}
+		if start == i {
+			ranges = append(ranges, strconv.Itoa(start))
+		} else {
+			ranges = append(ranges, strconv.Itoa(start)+"-"+strconv.Itoa(i))
+		}
+	}
+	return strings.Join(ranges, ",")
+}
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "scow-slurm-adapter/gen/go"
+)
+
+// 依赖类型对应的sbatch --dependency关键字
+var dependencyTypeNames = map[pb.JobDependency_Type]string{
+	pb.JobDependency_AFTER:        "after",
+	pb.JobDependency_AFTER_ANY:    "afterany",
+	pb.JobDependency_AFTER_OK:     "afterok",
+	pb.JobDependency_AFTER_NOT_OK: "afternotok",
+	pb.JobDependency_AFTER_CORR:   "aftercorr",
+	pb.JobDependency_SINGLETON:    "singleton",
+}
+
+// 依赖类型对应的关键字, 没有指定类型时返回错误, 避免默认值被当作after
+func DependencyTypeName(dependencyType pb.JobDependency_Type) (string, error) {
+	if dependencyType == pb.JobDependency_DEPENDENCY_TYPE_UNSPECIFIED {
+		return "", errors.New("dependency type is not specified")
+	}
+	name, ok := dependencyTypeNames[dependencyType]
+	if !ok {
+		return "", fmt.Errorf("unknown dependency type %v", dependencyType)
+	}
+	return name, nil
+}
+
+// 转换为sbatch --dependency的取值, 如afterok:12:13,singleton, anyOf为true时用?分隔
+func DependencyOption(dependencies []*pb.JobDependency, anyOf bool) (string, error) {
+	var items []string
+	for _, dependency := range dependencies {
+		name, err := DependencyTypeName(dependency.Type)
+		if err != nil {
+			return "", err
+		}
+		if dependency.Type == pb.JobDependency_SINGLETON {
+			items = append(items, name)
+			continue
+		}
+		if len(dependency.JobIds) == 0 {
+			return "", fmt.Errorf("dependency %s has no job ids", name)
+		}
+		item := name
+		for _, jobId := range dependency.JobIds {
+			item += ":" + strconv.Itoa(int(jobId))
+		}
+		items = append(items, item)
+	}
+	if anyOf {
+		return strings.Join(items, "?"), nil
+	}
+	return strings.Join(items, ","), nil
+}
+
+// 工作流中的依赖有环
+var ErrDependencyCycle = errors.New("dependency cycle")
+
+// 按依赖关系对节点拓扑排序, 没有依赖关系的节点保持原来的顺序, 返回节点的下标
+func TopologicalOrder(count int, dependsOn func(i int) []int) ([]int, error) {
+	inDegree := make([]int, count)
+	dependents := make([][]int, count)
+	for i := 0; i < count; i++ {
+		for _, j := range dependsOn(i) {
+			inDegree[i]++
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+	var order []int
+	done := make([]bool, count)
+	for len(order) < count {
+		// 每次取下标最小的就绪节点, 保证结果稳定
+		next := -1
+		for i := 0; i < count; i++ {
+			if !done[i] && inDegree[i] == 0 {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			return nil, ErrDependencyCycle
+		}
+		done[next] = true
+		order = append(order, next)
+		for _, i := range dependents[next] {
+			inDegree[i]--
+		}
+	}
+	return order, nil
+}
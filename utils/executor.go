@@ -0,0 +1,277 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// 命令执行结果, 标准输出、标准错误和返回码分开保存
+type CommandResult struct {
+	Stdout   string
+	Stderr   string
+	ExitCode int
+}
+
+// slurm命令执行器, 以程序名加参数列表的方式执行命令, 不经过shell解析
+type SlurmExecutor interface {
+	// 执行命令, 只有命令无法启动时才返回error, 非0返回码通过ExitCode返回
+	Execute(name string, args ...string) (*CommandResult, error)
+	// 执行命令并将stdin作为命令的标准输入
+	ExecuteWithStdin(stdin string, name string, args ...string) (*CommandResult, error)
+}
+
+// 本地执行器, 直接调用本机上的slurm命令
+type LocalExecutor struct{}
+
+// 全局使用的执行器, 测试时可以替换成假的执行器
+var Executor SlurmExecutor = &LocalExecutor{}
+
+func (e *LocalExecutor) Execute(name string, args ...string) (*CommandResult, error) {
+	return e.run(nil, name, args...)
+}
+
+func (e *LocalExecutor) ExecuteWithStdin(stdin string, name string, args ...string) (*CommandResult, error) {
+	return e.run(strings.NewReader(stdin), name, args...)
+}
+
+func (e *LocalExecutor) run(stdin io.Reader, name string, args ...string) (*CommandResult, error) {
+	var (
+		stdout bytes.Buffer
+		stderr bytes.Buffer
+	)
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if stdin != nil {
+		cmd.Stdin = stdin
+	}
+	err := cmd.Run()
+	result := &CommandResult{Stdout: stdout.String(), Stderr: stderr.String()}
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			result.ExitCode = exitErr.ExitCode()
+			return result, nil
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// 命令返回码非0时的错误信息
+type CommandError struct {
+	Name     string
+	ExitCode int
+	Stderr   string
+}
+
+func (e *CommandError) Error() string {
+	if e.Stderr == "" {
+		return fmt.Sprintf("%s exited with code %d", e.Name, e.ExitCode)
+	}
+	return e.Stderr
+}
+
+// 执行slurm命令, 返回去除首尾空白的标准输出, 返回码非0时返回CommandError
+func RunSlurmCommand(name string, args ...string) (string, error) {
+	result, err := Executor.Execute(name, args...)
+	if err != nil {
+		return "", err
+	}
+	if result.ExitCode != 0 {
+		return strings.TrimSpace(result.Stdout), &CommandError{Name: name, ExitCode: result.ExitCode, Stderr: strings.TrimSpace(result.Stderr)}
+	}
+	return strings.TrimSpace(result.Stdout), nil
+}
+
+// 以指定用户的身份执行slurm命令, command中的参数需要调用者保证是安全的
+func RunSlurmCommandAsUser(username string, stdin string, command string) (*CommandResult, error) {
+	return Executor.ExecuteWithStdin(stdin, "su", "-", username, "-c", command)
+}
+
+// 将字符串转义成单个shell参数
+func ShellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// 按行切分命令输出, 去掉空行
+func SplitLines(output string) []string {
+	var lines []string
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// 解析scontrol输出中的key=value字段, 同名字段只保留第一个
+func ParseKeyValues(output string) map[string]string {
+	m := make(map[string]string)
+	for _, field := range strings.Fields(output) {
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		if _, ok := m[kv[0]]; !ok {
+			m[kv[0]] = kv[1]
+		}
+	}
+	return m
+}
+
+// 解析cpu=96,mem=375G,node=2这种格式的tres字符串
+func ParseTres(tres string) map[string]string {
+	m := make(map[string]string)
+	for _, item := range strings.Split(tres, ",") {
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) == 2 {
+			m[kv[0]] = kv[1]
+		}
+	}
+	return m
+}
+
+// 解析sstat输出的tres使用量, 如cpu=00:01:02,mem=1052K, cpu时间转换为毫秒, 内存等转换为字节
+func ParseTresUsage(tres string) map[string]uint64 {
+	usage := make(map[string]uint64)
+	for name, value := range ParseTres(tres) {
+		if value == "" {
+			continue
+		}
+		if strings.Contains(value, ":") {
+			usage[name] = parseUsageTime(value)
+			continue
+		}
+		multiplier := uint64(1)
+		switch value[len(value)-1] {
+		case 'K':
+			multiplier = 1 << 10
+		case 'M':
+			multiplier = 1 << 20
+		case 'G':
+			multiplier = 1 << 30
+		case 'T':
+			multiplier = 1 << 40
+		}
+		if multiplier != 1 {
+			value = value[:len(value)-1]
+		}
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			continue
+		}
+		usage[name] = uint64(number * float64(multiplier))
+	}
+	return usage
+}
+
+// 解析[D-]HH:MM:SS[.mmm]或MM:SS[.mmm]格式的时间, 返回毫秒数
+func parseUsageTime(value string) uint64 {
+	var days uint64
+	if d, rest, ok := strings.Cut(value, "-"); ok {
+		days, _ = strconv.ParseUint(d, 10, 64)
+		value = rest
+	}
+	var seconds float64
+	for _, part := range strings.Split(value, ":") {
+		number, _ := strconv.ParseFloat(part, 64)
+		seconds = seconds*60 + number
+	}
+	return days*24*3600*1000 + uint64(seconds*1000+0.5)
+}
+
+// 获取计算分区的配置信息
+func GetPartitionConfig(partition string) (map[string]string, error) {
+	output, err := RunSlurmCommand("scontrol", "show", "partition", partition, "--oneliner")
+	if err != nil {
+		return nil, err
+	}
+	return ParseKeyValues(output), nil
+}
+
+// 获取计算节点的配置信息
+func GetNodeConfig(node string) (map[string]string, error) {
+	output, err := RunSlurmCommand("scontrol", "show", "node", node, "--oneliner")
+	if err != nil {
+		return nil, err
+	}
+	return ParseKeyValues(output), nil
+}
+
+// 获取slurm配置项的值
+func GetSlurmConfigValue(key string) (string, error) {
+	output, err := RunSlurmCommand("scontrol", "show", "config")
+	if err != nil {
+		return "", err
+	}
+	return ParseSlurmConfigValue(output, key), nil
+}
+
+// 从scontrol show config的输出中取配置项的值
+func ParseSlurmConfigValue(output string, key string) string {
+	for _, line := range SplitLines(output) {
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) == 2 && strings.TrimSpace(kv[0]) == key {
+			return strings.TrimSpace(kv[1])
+		}
+	}
+	return ""
+}
+
+// 获取SelectType插件的值, 如select/cons_tres返回cons_tres
+func GetSelectTypePlugin() (string, error) {
+	selectType, err := GetSlurmConfigValue("SelectType")
+	if err != nil {
+		return "", err
+	}
+	return SelectTypePluginName(selectType), nil
+}
+
+// 去掉SelectType的select/前缀
+func SelectTypePluginName(selectType string) string {
+	if index := strings.Index(selectType, "/"); index != -1 {
+		return selectType[index+1:]
+	}
+	return selectType
+}
+
+// 取节点列表中的第一个节点名, 如cn[01-04],gpu01返回cn01
+func GetFirstNodeName(nodeList string) string {
+	bracket := strings.Index(nodeList, "[")
+	comma := strings.Index(nodeList, ",")
+	if bracket == -1 || (comma != -1 && comma < bracket) {
+		return strings.Split(nodeList, ",")[0]
+	}
+	ranges := strings.FieldsFunc(nodeList[bracket+1:], func(r rune) bool {
+		return r == ',' || r == '-' || r == ']'
+	})
+	if len(ranges) == 0 {
+		return nodeList[:bracket]
+	}
+	return nodeList[:bracket] + ranges[0]
+}
+
+// 从节点的Gres字段中解析gpu卡数, 如gpu:a100:4(S:0-1)返回4
+func GetGpusFromGres(gres string) int {
+	if gres == "" || gres == "(null)" {
+		return 0
+	}
+	gres = strings.Split(gres, "(")[0]
+	fields := strings.Split(gres, ":")
+	gpus, _ := strconv.Atoi(fields[len(fields)-1])
+	return gpus
+}
+
+// 获取计算分区AllowAccounts的值
+func GetPartitionAllowAccounts(partition string) (string, error) {
+	partitionConfig, err := GetPartitionConfig(partition)
+	if err != nil {
+		return "", err
+	}
+	return partitionConfig["AllowAccounts"], nil
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+)
+
+// 作业模板, 提交时用户可以覆盖除脚本以外的默认值
+type JobTemplate struct {
+	Name             string            `yaml:"name"`
+	Desc             string            `yaml:"desc,omitempty"`
+	Partition        string            `yaml:"partition"`
+	Qos              string            `yaml:"qos,omitempty"`
+	NodeCount        uint32            `yaml:"nodecount,omitempty"` // 默认1
+	CoreCount        uint32            `yaml:"corecount,omitempty"` // 默认1
+	GpuCount         uint32            `yaml:"gpucount,omitempty"`
+	TimeLimitMinutes uint32            `yaml:"timelimitminutes,omitempty"` // 0表示使用分区的默认值
+	ExtraOptions     []string          `yaml:"extraoptions,omitempty"`     // 额外的#SBATCH选项, 如--exclusive
+	Script           string            `yaml:"script"`                     // 脚本中的{{NAME}}在提交时替换为变量的值
+	Variables        map[string]string `yaml:"variables,omitempty"`        // 变量的默认值, 没有默认值的变量必须在提交时指定
+}
+
+// 模板脚本中的变量, 用{{NAME}}而不是${NAME}, 避免和shell变量冲突
+var templateVariableRegexp = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// 根据名称查找作业模板
+func FindJobTemplate(templates []JobTemplate, name string) (*JobTemplate, bool) {
+	for i := range templates {
+		if templates[i].Name == name {
+			return &templates[i], true
+		}
+	}
+	return nil, false
+}
+
+// 脚本中没有默认值的变量, 按名称排序
+func (t *JobTemplate) RequiredVariables() []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, match := range templateVariableRegexp.FindAllStringSubmatch(t.Script, -1) {
+		name := match[1]
+		if _, ok := t.Variables[name]; ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// 用变量的值替换脚本中的变量, 没有指定的变量使用默认值
+func (t *JobTemplate) RenderScript(variables map[string]string) (string, error) {
+	var missing []string
+	script := templateVariableRegexp.ReplaceAllStringFunc(t.Script, func(match string) string {
+		name := templateVariableRegexp.FindStringSubmatch(match)[1]
+		if value, ok := variables[name]; ok {
+			return value
+		}
+		if value, ok := t.Variables[name]; ok {
+			return value
+		}
+		if !slices.Contains(missing, name) {
+			missing = append(missing, name)
+		}
+		return match
+	})
+	if len(missing) != 0 {
+		return "", fmt.Errorf("variables %v of template %s are not set", missing, t.Name)
+	}
+	return script, nil
+}
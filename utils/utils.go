@@ -1,21 +1,16 @@
 package utils
 
 import (
-	"bytes"
 	"fmt"
 	"io/ioutil"
 	"log"
-	"reflect"
+	"os"
 	"regexp"
-	"sort"
 	"strconv"
 
-	"os/exec"
 	"os/user"
 
-	pb "scow-slurm-adapter/gen/go"
 	"strings"
-	"syscall"
 
 	"gopkg.in/yaml.v3"
 )
@@ -35,18 +30,66 @@ type MySQLConfig struct {
 }
 
 type Service struct {
-	Addr string `yaml:"addr"`
+	Addr           string `yaml:"addr"`
+	MaxMessageSize int    `yaml:"maxmessagesize,omitempty"` // 单个gRPC消息的最大MB数, 默认1024
 }
 
 type Slurm struct {
 	DefaultQOS string `yaml:"defaultqos"`
 	Slurmpath  string `yaml:"slurmpath,omitempty"`
+	Backend    string `yaml:"backend,omitempty"` // cli(默认)或rest
+}
+
+// slurmrestd配置, backend为rest时使用
+type SlurmRestd struct {
+	Url           string `yaml:"url"`                     // http://host:6820 或 unix:///run/slurmrestd.sock
+	ApiVersion    string `yaml:"apiversion,omitempty"`    // 默认v0.0.40
+	User          string `yaml:"user,omitempty"`          // 访问slurmrestd的用户, 一般为root或SlurmUser
+	Token         string `yaml:"token,omitempty"`         // 固定的JWT token
+	TokenFile     string `yaml:"tokenfile,omitempty"`     // 保存JWT token的文件, 每次请求时读取
+	JwtKeyFile    string `yaml:"jwtkeyfile,omitempty"`    // slurm的jwt_hs256.key, 配置后由适配器自行签发token
+	TokenLifespan int    `yaml:"tokenlifespan,omitempty"` // 自行签发token的有效期(秒), 默认1800
+	Timeout       int    `yaml:"timeout,omitempty"`       // 请求超时时间(秒), 默认30
+}
+
+// 交互式应用配置
+type App struct {
+	ConnectionInfoFile string `yaml:"connectioninfofile,omitempty"` // 应用启动脚本写入连接信息的文件, 相对于作业工作目录, 默认server_session_info.json
 }
 
 type Modulepath struct {
 	Path string `yaml:"path"`
 }
 
+// 同一个slurmdbd下的其他集群
+type ClusterConfig struct {
+	Name       string      `yaml:"name"`                 // slurm中的集群名, 也是请求metadata中cluster的值
+	SlurmRestd *SlurmRestd `yaml:"slurmrestd,omitempty"` // backend为rest时该集群的slurmrestd配置
+}
+
+// 计费配置, 作业的费用为各资源的用量乘以单价, 再按运行时段乘以倍率
+type BillingConfig struct {
+	Prices      []PriceRule      `yaml:"prices"`
+	Multipliers []TimeMultiplier `yaml:"multipliers,omitempty"`
+	Timezone    string           `yaml:"timezone,omitempty"` // 倍率时段的时区, 默认为服务器的时区
+}
+
+// 单价规则, 分区和QOS都匹配的规则优先, 其次是只匹配分区的规则, 然后是只匹配QOS的规则
+type PriceRule struct {
+	Partition   string  `yaml:"partition,omitempty"` // 为空时匹配所有分区
+	Qos         string  `yaml:"qos,omitempty"`       // 为空时匹配所有QOS
+	CpuCoreHour float64 `yaml:"cpucorehour,omitempty"`
+	GpuHour     float64 `yaml:"gpuhour,omitempty"`
+	MemGbHour   float64 `yaml:"memgbhour,omitempty"`
+}
+
+// 一天中某个时段的价格倍率, 多个时段重叠时使用第一个
+type TimeMultiplier struct {
+	Start      string  `yaml:"start"` // 如22:00
+	End        string  `yaml:"end"`   // 不晚于Start时跨过0点, 如08:00
+	Multiplier float64 `yaml:"multiplier"`
+}
+
 type PartitionDesc struct {
 	Name string `yaml:"name"`
 	Desc string `yaml:"desc"`
@@ -57,8 +100,13 @@ type Config struct {
 	MySQLConfig   MySQLConfig     `yaml:"mysql"`
 	Service       Service         `yaml:"service"`
 	Slurm         Slurm           `yaml:"slurm"`
+	SlurmRestd    SlurmRestd      `yaml:"slurmrestd"`
 	Modulepath    Modulepath      `yaml:"modulepath"`
 	PartitionDesc []PartitionDesc `yaml:"partitiondesc"`
+	App           App             `yaml:"app"`
+	Templates     []JobTemplate   `yaml:"templates"`
+	Clusters      []ClusterConfig `yaml:"clusters"`
+	Billing       BillingConfig   `yaml:"billing"`
 }
 
 var (
@@ -80,46 +128,6 @@ func ParseConfig(configFilePath string) *Config {
 	return config
 }
 
-// 带返回码的shell命令执行函数
-func ExecuteShellCommand(command string) int {
-	var (
-		res int
-	)
-	cmd := exec.Command("bash", "-c", command)
-	stdout, _ := cmd.StdoutPipe()
-	defer stdout.Close()
-	if err := cmd.Start(); err != nil {
-		panic(err)
-	}
-	if err := cmd.Wait(); err != nil {
-		if ex, ok := err.(*exec.ExitError); ok {
-			res = ex.Sys().(syscall.WaitStatus).ExitStatus()
-		}
-	}
-	return res
-}
-
-// 简单执行shell命令函数
-func RunCommand(command string) (string, error) {
-	var (
-		output bytes.Buffer
-	)
-	cmd := exec.Command("bash", "-c", command)
-
-	// 创建一个 bytes.Buffer 用于捕获输出
-	cmd.Stdout = &output
-	cmd.Stderr = &output
-
-	// 执行命令
-	err := cmd.Run()
-
-	if err != nil {
-		return output.String(), err
-	}
-
-	return strings.TrimSpace(output.String()), nil
-}
-
 // 数据库配置信息
 func DatabaseConfig() string {
 	config := ParseConfig(DefaultConfigPath)
@@ -136,24 +144,17 @@ func DatabaseConfig() string {
 
 // 获取全系统计算分区信息
 func GetPartitionInfo() ([]string, error) {
-	var (
-		output bytes.Buffer
-	)
-	shellCmd := "scontrol show partition| grep PartitionName=| awk -F'=' '{print $2}'| tr '\n' ','"
-	cmd := exec.Command("bash", "-c", shellCmd)
-
-	// 创建一个 bytes.Buffer 用于捕获输出
-	cmd.Stdout = &output
-	cmd.Stderr = &output
-
-	// 执行命令
-	err := cmd.Run()
+	output, err := RunSlurmCommand("scontrol", "show", "partition", "--oneliner")
 	if err != nil {
 		return nil, err
 	}
-	resOutput := strings.Split(strings.TrimSpace(output.String()), ",")
-	resOutput = resOutput[:len(resOutput)-1]
-	return resOutput, nil
+	var partitions []string
+	for _, line := range SplitLines(output) {
+		if partition, ok := ParseKeyValues(line)["PartitionName"]; ok {
+			partitions = append(partitions, partition)
+		}
+	}
+	return partitions, nil
 }
 
 func DeleteSlice(data []string, word string) []string {
@@ -194,6 +195,21 @@ func ChangeState(stateInit int) string {
 	return stateString
 }
 
+// 解析作业表中exit_code、derived_ec保存的wait状态, 返回退出码和终止信号
+func DecodeExitStatus(status int64) (int32, int32) {
+	// 没有运行过的作业为NO_VAL
+	if status < 0 || status >= 0xfffffffe {
+		return 0, 0
+	}
+	exitCode := int32((status >> 8) & 0xff)
+	signal := int32(status & 0x7f)
+	if signal == 0x7f {
+		// 被信号暂停而不是终止
+		signal = 0
+	}
+	return exitCode, signal
+}
+
 // 作业状态码转换
 func GetStateId(stateString string) int {
 	var (
@@ -251,28 +267,6 @@ func GetTimeLimit(timeLimit string) int64 {
 	}
 }
 
-func GetGpuAllocsFromGpuId(matchCmd string, gpuId int, tresAlloc string) int32 {
-	var (
-		gpusAlloc int32
-	)
-	res := ExecuteShellCommand(matchCmd)
-	if res == 0 {
-		resAllocList := strings.Split(tresAlloc, ",")
-		for _, v := range resAllocList {
-			vList := strings.Split(v, "=")
-			id := vList[0]
-			number := vList[1]
-			idInt, _ := strconv.Atoi(id)
-			numberInt, _ := strconv.Atoi(number)
-			if idInt == gpuId {
-				gpusAlloc = int32(numberInt)
-				return gpusAlloc
-			}
-		}
-	}
-	return 0
-}
-
 func GetGpuAllocsFromGpuIdList(tresAlloc string, gpuId []int) int32 {
 	var (
 		gpusAlloc int32
@@ -350,62 +344,69 @@ func CheckAccountOrUserStrings(s string) bool {
 	}
 }
 
-// 本地提交作业函数
-func LocalSubmitJob(scriptString string, username string) (string, error) {
-	var (
-		output bytes.Buffer
-	)
-	// 提交作业命令行
+// 获取slurm命令所在路径
+func getSlurmPath() string {
 	config := ParseConfig(DefaultConfigPath)
 	slurmpath := config.Slurm.Slurmpath
 	if slurmpath == "" {
 		// 如果未定义，则将其设置为默认值 "/usr"
 		slurmpath = "/usr"
 	}
-	cmdLine := fmt.Sprintf("su - %s -c '%s/bin/sbatch'", username, slurmpath)
-	// cmdLine := fmt.Sprintf("su - %s -c '/usr/bin/sbatch'", username)
-	cmd := exec.Command("bash", "-c", cmdLine)
-
-	// 创建一个 bytes.Buffer 用于捕获输出
-	cmd.Stdout = &output
-	cmd.Stderr = &output
+	return slurmpath
+}
 
-	// 将脚本作为命令的输入
-	cmd.Stdin = bytes.NewBufferString(scriptString)
+// 多集群时slurm命令的-M参数, cluster为空时使用本机所在的集群
+func clusterOption(cluster string) string {
+	if cluster == "" {
+		return ""
+	}
+	return " -M " + ShellQuote(cluster)
+}
 
-	// 执行命令
-	err := cmd.Run()
+// 以用户身份执行sbatch, 脚本作为sbatch的标准输入
+func localSbatch(scriptString string, username string, cluster string, option string) (*CommandResult, error) {
+	command := fmt.Sprintf("%s/bin/sbatch%s%s", getSlurmPath(), clusterOption(cluster), option)
+	result, err := RunSlurmCommandAsUser(username, scriptString, command)
 	if err != nil {
-		return output.String(), err
+		return nil, err
 	}
-
-	return output.String(), nil
+	if result.ExitCode != 0 {
+		return result, &CommandError{Name: "sbatch", ExitCode: result.ExitCode, Stderr: strings.TrimSpace(result.Stderr)}
+	}
+	return result, nil
 }
 
-func LocalFileSubmitJob(filePath string, username string) (string, error) {
-	var (
-		output bytes.Buffer
-	)
-	config := ParseConfig(DefaultConfigPath)
-	slurmpath := config.Slurm.Slurmpath
-	if slurmpath == "" {
-		// 如果未定义，则将其设置为默认值 "/usr"
-		slurmpath = "/usr"
+// 本地提交作业函数
+func LocalSubmitJob(scriptString string, username string, cluster string) (string, error) {
+	result, err := localSbatch(scriptString, username, cluster, "")
+	if err != nil {
+		if result != nil {
+			return result.Stdout + result.Stderr, err
+		}
+		return "", err
 	}
-	cmdLine := fmt.Sprintf("su - %s -c '%s/bin/sbatch %s'", username, slurmpath, filePath)
-	cmd := exec.Command("bash", "-c", cmdLine)
-	cmd.Stdout = &output
-	cmd.Stderr = &output
-
-	// 将脚本作为命令的输入
+	return result.Stdout, nil
+}
 
-	// 执行命令
-	err := cmd.Run()
+// 只检查作业能否提交并估计开始时间, 不会真正排队, sbatch --test-only的结果输出在标准错误中
+func LocalTestSubmitJob(scriptString string, username string, cluster string) (string, error) {
+	result, err := localSbatch(scriptString, username, cluster, " --test-only")
 	if err != nil {
-		return output.String(), err
+		return "", err
 	}
+	return result.Stderr, nil
+}
 
-	return output.String(), nil
+func LocalFileSubmitJob(filePath string, username string) (string, error) {
+	command := fmt.Sprintf("%s/bin/sbatch %s", getSlurmPath(), ShellQuote(filePath))
+	result, err := RunSlurmCommandAsUser(username, "", command)
+	if err != nil {
+		return "", err
+	}
+	if result.ExitCode != 0 {
+		return result.Stdout + result.Stderr, &CommandError{Name: "sbatch", ExitCode: result.ExitCode, Stderr: strings.TrimSpace(result.Stderr)}
+	}
+	return result.Stdout, nil
 }
 
 func GetUserHomedir(username string) (string, error) {
@@ -420,40 +421,63 @@ func GetUserHomedir(username string) (string, error) {
 	return homeDir, nil
 }
 
-// 取消作业函数
-func LocalCancelJob(username string, jobId int) (string, error) {
-	var (
-		output bytes.Buffer
-	)
-	config := ParseConfig(DefaultConfigPath)
-	slurmpath := config.Slurm.Slurmpath
-	if slurmpath == "" {
-		// 如果未定义，则将其设置为默认值 "/usr"
-		slurmpath = "/usr"
+// 取消作业函数, jobSpec为作业id或者123_4这样的作业数组任务, 可以一次取消多个
+func LocalCancelJob(username string, cluster string, jobSpecs ...string) (string, error) {
+	command := fmt.Sprintf("%s/bin/scancel%s", getSlurmPath(), clusterOption(cluster))
+	for _, jobSpec := range jobSpecs {
+		command += " " + ShellQuote(jobSpec)
+	}
+	result, err := RunSlurmCommandAsUser(username, "", command)
+	if err != nil {
+		return "", err
+	}
+	if result.ExitCode != 0 {
+		return result.Stdout + result.Stderr, &CommandError{Name: "scancel", ExitCode: result.ExitCode, Stderr: strings.TrimSpace(result.Stderr)}
 	}
-	cmdLine := fmt.Sprintf("su - %s -c '%s/bin/scancel %d'", username, slurmpath, jobId)
-	// cmdLine := fmt.Sprintf("su - %s -c 'scancel %d'", username, jobId)
-	cmd := exec.Command("bash", "-c", cmdLine)
-	// 创建一个 bytes.Buffer 用于捕获输出
-	cmd.Stdout = &output
-	cmd.Stderr = &output
+	return result.Stdout, nil
+}
 
-	// 执行命令
-	err := cmd.Run()
+// 以用户身份执行scontrol hold、release等作业控制命令
+func LocalControlJob(username string, cluster string, action string, jobSpec string) (string, error) {
+	command := fmt.Sprintf("%s/bin/scontrol%s %s %s", getSlurmPath(), clusterOption(cluster), ShellQuote(action), ShellQuote(jobSpec))
+	result, err := RunSlurmCommandAsUser(username, "", command)
 	if err != nil {
-		return output.String(), err
+		return "", err
 	}
+	if result.ExitCode != 0 {
+		return result.Stdout + result.Stderr, &CommandError{Name: "scontrol", ExitCode: result.ExitCode, Stderr: strings.TrimSpace(result.Stderr)}
+	}
+	return result.Stdout, nil
+}
+
+// ReadFileAsUser一次最多读取的字节数, 读取的内容都在内存中, 更大的范围由调用方分多次读取
+const ReadFileMaxSize = 1024 * 1024
 
-	return output.String(), nil
+// 以用户身份读取文件从offset开始的最多size字节, size超过ReadFileMaxSize时只读取ReadFileMaxSize字节.
+// 文件不存在时返回os.ErrNotExist, 整个范围由一个dd进程读取, 只根据退出码判断是否出错
+func ReadFileAsUser(username string, path string, offset uint64, size uint64) ([]byte, error) {
+	size = min(size, ReadFileMaxSize)
+	command := fmt.Sprintf("[ -e %s ] || exit 2; exec dd if=%s iflag=skip_bytes,count_bytes skip=%d count=%d bs=64K status=none", ShellQuote(path), ShellQuote(path), offset, size)
+	result, err := RunSlurmCommandAsUser(username, "", command)
+	if err != nil {
+		return nil, err
+	}
+	if result.ExitCode == 2 {
+		return nil, os.ErrNotExist
+	}
+	if result.ExitCode != 0 {
+		return nil, &CommandError{Name: "dd", ExitCode: result.ExitCode, Stderr: strings.TrimSpace(result.Stderr)}
+	}
+	return []byte(result.Stdout), nil
 }
 
 // 获取map信息
 func GetMapInfo(pendingString string) map[int]string {
 	m := make(map[int]string)
 
-	pairs := strings.Split(pendingString, ",")
+	pairs := SplitLines(pendingString)
 	for _, pair := range pairs {
-		kv := strings.Split(pair, " ")
+		kv := strings.SplitN(pair, " ", 2)
 		if len(kv) != 2 {
 			continue
 		}
@@ -471,9 +495,9 @@ func GetMapInfo(pendingString string) map[int]string {
 func GetPendingMapInfo(pendingString string) map[int]string {
 	m := make(map[int]string)
 
-	pairs := strings.Split(pendingString, ";")
+	pairs := SplitLines(pendingString)
 	for _, pair := range pairs {
-		kv := strings.Split(pair, "=")
+		kv := strings.SplitN(pair, "=", 2)
 		if len(kv) != 2 {
 			continue
 		}
@@ -533,50 +557,6 @@ func GetRunningElapsedSeconds(timeString string) int64 {
 	}
 }
 
-func sortByKey(list []*pb.JobInfo, fieldName string, sortOrder string) bool {
-	if sortOrder == "ASC" {
-		sort.Slice(list, func(i, j int) bool {
-			fieldValueI := reflect.ValueOf(list[i]).Elem().FieldByName(fieldName)
-			fieldValueJ := reflect.ValueOf(list[j]).Elem().FieldByName(fieldName)
-			switch fieldValueI.Kind() {
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				return fieldValueI.Int() < fieldValueJ.Int()
-			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-				return fieldValueI.Uint() > fieldValueJ.Uint()
-			case reflect.Float32, reflect.Float64:
-				return fieldValueI.Float() < fieldValueJ.Float()
-			case reflect.String:
-				return fieldValueI.String() < fieldValueJ.String()
-			default:
-				return false
-			}
-		})
-	} else if sortOrder == "DESC" {
-		sort.Slice(list, func(i, j int) bool {
-			fieldValueI := reflect.ValueOf(list[i]).Elem().FieldByName(fieldName)
-			fieldValueJ := reflect.ValueOf(list[j]).Elem().FieldByName(fieldName)
-			switch fieldValueI.Kind() {
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				return fieldValueI.Int() > fieldValueJ.Int()
-			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-				return fieldValueI.Uint() > fieldValueJ.Uint()
-			case reflect.Float32, reflect.Float64:
-				return fieldValueI.Float() > fieldValueJ.Float()
-			case reflect.String:
-				return fieldValueI.String() > fieldValueJ.String()
-			default:
-				return false
-			}
-		})
-	}
-	return true
-}
-
-func SortJobInfo(sortKey string, sortOrder string, jobInfo []*pb.JobInfo) []*pb.JobInfo {
-	sortByKey(jobInfo, sortKey, sortOrder)
-	return jobInfo
-}
-
 func CheckSlurmStatus(result string) bool {
 	subStr := "Unable to contact slurm controller"
 	if strings.Contains(result, subStr) {